@@ -0,0 +1,274 @@
+package cufinder
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PostalAddress is a structured postal address. It is shared by NAA results
+// and the company locations of ENC, FCL, CSE, LBS and CLO responses.
+type PostalAddress struct {
+	Line1      string `json:"line1,omitempty"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city,omitempty"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	// Country is the ISO 3166-1 alpha-2 code when the country is recognized,
+	// and the name as given otherwise
+	Country   string  `json:"country,omitempty"`
+	Latitude  float64 `json:"latitude,omitempty"`
+	Longitude float64 `json:"longitude,omitempty"`
+}
+
+// IsZero reports whether the address has no fields set
+func (a PostalAddress) IsZero() bool {
+	return a == PostalAddress{}
+}
+
+// HasCoordinates reports whether the address has a latitude and longitude.
+// (0, 0) is treated as unknown.
+func (a PostalAddress) HasCoordinates() bool {
+	return a.Latitude != 0 || a.Longitude != 0
+}
+
+// PostalAddress returns the structured form of a company's main location
+func (l MainLocation) PostalAddress() PostalAddress {
+	a := PostalAddress{
+		Line1:      strings.TrimSpace(l.Address),
+		City:       strings.TrimSpace(l.City),
+		Region:     strings.TrimSpace(l.State),
+		PostalCode: strings.TrimSpace(l.PostalCode),
+		Country:    countryField(l.Country),
+	}
+	a.Latitude, a.Longitude, _ = parseGeo(l.Geo)
+	return a
+}
+
+// PostalAddress returns the structured form of a CLO location
+func (l CloLocation) PostalAddress() PostalAddress {
+	a := PostalAddress{
+		Line1:      strings.TrimSpace(l.Line1),
+		Line2:      strings.TrimSpace(l.Line2),
+		City:       strings.TrimSpace(l.City),
+		Region:     strings.TrimSpace(l.State),
+		PostalCode: strings.TrimSpace(l.PostalCode),
+		Country:    countryField(l.Country),
+	}
+	a.Latitude, a.Longitude, _ = parseCoordinates(l.Latitude, l.Longitude)
+	return a
+}
+
+// PostalAddress returns the structured address of a CSE or LBS company,
+// preferring its main location and filling gaps from the flat fields
+func (c Company) PostalAddress() PostalAddress {
+	a := c.MainLocation.PostalAddress()
+	if a.Line1 == "" {
+		a.Line1 = strings.TrimSpace(c.Address)
+	}
+	if a.City == "" {
+		a.City = strings.TrimSpace(c.City)
+	}
+	if a.Region == "" {
+		a.Region = strings.TrimSpace(c.State)
+	}
+	if a.PostalCode == "" {
+		a.PostalCode = strings.TrimSpace(c.ZipCode)
+	}
+	if a.Country == "" {
+		a.Country = countryField(c.Country)
+	}
+	return a
+}
+
+// PostalAddress returns the structured address of an ENC company
+func (c EncCompany) PostalAddress() PostalAddress {
+	return PostalAddress{
+		Line1:   strings.TrimSpace(c.Address),
+		City:    strings.TrimSpace(c.City),
+		Region:  strings.TrimSpace(c.State),
+		Country: countryField(c.Country),
+	}
+}
+
+// PostalAddress returns the structured address of an FCL company
+func (c FclCompany) PostalAddress() PostalAddress {
+	return PostalAddress{
+		Line1:   strings.TrimSpace(c.Address),
+		City:    strings.TrimSpace(c.City),
+		Region:  strings.TrimSpace(c.State),
+		Country: countryField(c.Country),
+	}
+}
+
+// countryField returns the ISO code of a country, or the trimmed value when
+// it is not recognized
+func countryField(country string) string {
+	if code, ok := LookupCountry(country); ok {
+		return code
+	}
+	return strings.TrimSpace(country)
+}
+
+// parseGeo parses "lat,lng" or "lat lng"
+func parseGeo(geo string) (float64, float64, bool) {
+	fields := strings.FieldsFunc(geo, func(r rune) bool { return r == ',' || r == ' ' || r == ';' })
+	if len(fields) != 2 {
+		return 0, 0, false
+	}
+	return parseCoordinates(fields[0], fields[1])
+}
+
+// parseCoordinates parses a latitude and longitude, rejecting values out of
+// range
+func parseCoordinates(latitude, longitude string) (float64, float64, bool) {
+	lat, err := strconv.ParseFloat(strings.TrimSpace(latitude), 64)
+	if err != nil || lat < -90 || lat > 90 {
+		return 0, 0, false
+	}
+	lng, err := strconv.ParseFloat(strings.TrimSpace(longitude), 64)
+	if err != nil || lng < -180 || lng > 180 {
+		return 0, 0, false
+	}
+	return lat, lng, true
+}
+
+var (
+	// "CA 94105", "NY 10001-1234", "ON M5V 2T6"
+	northAmericanTail = regexp.MustCompile(`^([A-Za-z][A-Za-z .]*?)\s+(\d{5}(?:-\d{4})?|[A-Za-z]\d[A-Za-z] ?\d[A-Za-z]\d)$`)
+	// "10115 Berlin", "75008 Paris", "D-10115 Berlin"
+	postalFirst = regexp.MustCompile(`^(?:[A-Z]{1,2}-)?(\d{4,5}(?:-\d{3})?)\s+(\D.*)$`)
+	// "EC1A 1BB", "SW1A 2AA"
+	ukPostcode     = regexp.MustCompile(`(?i)^[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}$`)
+	ukPostcodeTail = regexp.MustCompile(`(?i)^(.*\D)\s+([A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2})$`)
+	usZip          = regexp.MustCompile(`^\d{5}(-\d{4})?$`)
+)
+
+// ParsePostalAddress parses a one-line address such as an NAA result, as in
+// "1 Market St, Suite 300, San Francisco, CA 94105, United States". Parsing is
+// heuristic: parts it cannot place end up in Line1 and Line2.
+func ParsePostalAddress(address string) PostalAddress {
+	var parts []string
+	for _, p := range strings.FieldsFunc(address, func(r rune) bool { return r == ',' || r == '\n' }) {
+		if p = strings.TrimSpace(p); p != "" {
+			parts = append(parts, p)
+		}
+	}
+
+	var a PostalAddress
+	if n := len(parts); n > 1 {
+		if code, ok := countryIndex[strings.ToLower(parts[n-1])]; ok {
+			a.Country = code
+			parts = parts[:n-1]
+		} else if strings.EqualFold(parts[n-1], "US") {
+			a.Country = "US"
+			parts = parts[:n-1]
+		}
+	}
+
+	if n := len(parts); n > 1 {
+		last := parts[n-1]
+		switch {
+		case northAmericanTail.MatchString(last):
+			m := northAmericanTail.FindStringSubmatch(last)
+			a.Region, a.PostalCode = m[1], strings.ToUpper(m[2])
+			parts = parts[:n-1]
+			if a.Country == "" && usZip.MatchString(a.PostalCode) {
+				a.Country = "US"
+			} else if a.Country == "" {
+				a.Country = "CA"
+			}
+		case ukPostcode.MatchString(last):
+			a.PostalCode = strings.ToUpper(last)
+			parts = parts[:n-1]
+			if a.Country == "" {
+				a.Country = "GB"
+			}
+		case ukPostcodeTail.MatchString(last) && (a.Country == "GB" || a.Country == ""):
+			m := ukPostcodeTail.FindStringSubmatch(last)
+			a.City, a.PostalCode = m[1], strings.ToUpper(m[2])
+			parts = parts[:n-1]
+			if a.Country == "" {
+				a.Country = "GB"
+			}
+		case postalFirst.MatchString(last):
+			m := postalFirst.FindStringSubmatch(last)
+			a.PostalCode, a.City = m[1], m[2]
+			parts = parts[:n-1]
+		}
+	}
+
+	if n := len(parts); a.City == "" && n > 1 {
+		a.City = parts[n-1]
+		parts = parts[:n-1]
+	}
+	if len(parts) > 0 {
+		a.Line1 = parts[0]
+	}
+	if len(parts) > 1 {
+		a.Line2 = strings.Join(parts[1:], ", ")
+	}
+	return a
+}
+
+// Address conventions for the locality line
+var (
+	// cityRegionPostal countries write "City, Region PostalCode"
+	cityRegionPostal = map[string]bool{"US": true, "CA": true}
+	// cityPostalLines countries write the postal code on its own line
+	cityPostalLines = map[string]bool{"GB": true, "IE": true, "IM": true, "JE": true, "GG": true}
+	// postalCity countries write "PostalCode City"
+	postalCity = map[string]bool{
+		"DE": true, "FR": true, "ES": true, "IT": true, "NL": true, "BE": true, "CH": true,
+		"AT": true, "SE": true, "NO": true, "DK": true, "FI": true, "PT": true, "PL": true,
+		"CZ": true, "SK": true, "GR": true, "HU": true, "RO": true, "TR": true, "IL": true,
+		"LU": true, "IS": true, "HR": true, "SI": true, "BG": true, "RS": true, "MX": true,
+		"AR": true, "CL": true, "BR": true,
+	}
+)
+
+// Format returns the address as lines following the convention of its
+// country, such as "City, Region PostalCode" in the United States and
+// "PostalCode City" in Germany. The country name is the last line.
+func (a PostalAddress) Format() string {
+	lines := []string{a.Line1, a.Line2}
+
+	switch {
+	case cityRegionPostal[a.Country]:
+		locality := a.City
+		if a.Region != "" {
+			locality = joinNonEmpty(", ", a.City, a.Region)
+		}
+		lines = append(lines, joinNonEmpty(" ", locality, a.PostalCode))
+	case cityPostalLines[a.Country]:
+		lines = append(lines, a.City, a.Region, a.PostalCode)
+	case postalCity[a.Country]:
+		lines = append(lines, joinNonEmpty(" ", a.PostalCode, a.City), a.Region)
+	case a.Country == "AU":
+		lines = append(lines, joinNonEmpty(" ", a.City, a.Region, a.PostalCode))
+	default:
+		lines = append(lines, joinNonEmpty(" ", joinNonEmpty(", ", a.City, a.Region), a.PostalCode))
+	}
+
+	if name, ok := CountryName(a.Country); ok {
+		lines = append(lines, name)
+	} else {
+		lines = append(lines, a.Country)
+	}
+	return joinNonEmpty("\n", lines...)
+}
+
+// String returns the formatted address on one line
+func (a PostalAddress) String() string {
+	return strings.ReplaceAll(a.Format(), "\n", ", ")
+}
+
+func joinNonEmpty(sep string, values ...string) string {
+	var parts []string
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			parts = append(parts, v)
+		}
+	}
+	return strings.Join(parts, sep)
+}
@@ -0,0 +1,113 @@
+package cufinder
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePostalAddress(t *testing.T) {
+	tests := map[string]PostalAddress{
+		"1 Market St, Suite 300, San Francisco, CA 94105, United States": {
+			Line1: "1 Market St", Line2: "Suite 300", City: "San Francisco", Region: "CA", PostalCode: "94105", Country: "US",
+		},
+		"350 Fifth Avenue, New York, NY 10118": {
+			Line1: "350 Fifth Avenue", City: "New York", Region: "NY", PostalCode: "10118", Country: "US",
+		},
+		"290 Bremner Blvd, Toronto, ON M5V 3L9, Canada": {
+			Line1: "290 Bremner Blvd", City: "Toronto", Region: "ON", PostalCode: "M5V 3L9", Country: "CA",
+		},
+		"10 Downing Street, London SW1A 2AA, UK": {
+			Line1: "10 Downing Street", City: "London", PostalCode: "SW1A 2AA", Country: "GB",
+		},
+		"Unter den Linden 1, 10117 Berlin, Germany": {
+			Line1: "Unter den Linden 1", City: "Berlin", PostalCode: "10117", Country: "DE",
+		},
+		"Somewhere Road, Springfield": {
+			Line1: "Somewhere Road", City: "Springfield",
+		},
+	}
+	for raw, want := range tests {
+		assert.Equal(t, want, ParsePostalAddress(raw), raw)
+	}
+}
+
+func TestPostalAddressFormat(t *testing.T) {
+	us := PostalAddress{Line1: "1 Market St", Line2: "Suite 300", City: "San Francisco", Region: "CA", PostalCode: "94105", Country: "US"}
+	assert.Equal(t, "1 Market St\nSuite 300\nSan Francisco, CA 94105\nUnited States", us.Format())
+	assert.Equal(t, "1 Market St, Suite 300, San Francisco, CA 94105, United States", us.String())
+
+	de := PostalAddress{Line1: "Unter den Linden 1", City: "Berlin", PostalCode: "10117", Country: "DE"}
+	assert.Equal(t, "Unter den Linden 1\n10117 Berlin\nGermany", de.Format())
+
+	gb := PostalAddress{Line1: "10 Downing Street", City: "London", PostalCode: "SW1A 2AA", Country: "GB"}
+	assert.Equal(t, "10 Downing Street\nLondon\nSW1A 2AA\nUnited Kingdom", gb.Format())
+
+	other := PostalAddress{City: "Atlantis", Country: "Oceania"}
+	assert.Equal(t, "Atlantis\nOceania", other.Format())
+}
+
+func TestResponsePostalAddresses(t *testing.T) {
+	company := Company{
+		MainLocation: MainLocation{Address: "1 Market St", City: "San Francisco", Country: "united states", Geo: "37.7936, -122.3958"},
+		State:        "CA",
+		ZipCode:      "94105",
+	}
+	a := company.PostalAddress()
+	assert.Equal(t, "US", a.Country)
+	assert.Equal(t, "CA", a.Region)
+	assert.Equal(t, "94105", a.PostalCode)
+	assert.True(t, a.HasCoordinates())
+	assert.InDelta(t, -122.3958, a.Longitude, 1e-9)
+
+	loc := CloLocation{Line1: "Unter den Linden 1", City: "Berlin", PostalCode: "10117", Country: "Deutschland", Latitude: "52.517", Longitude: "bad"}
+	a = loc.PostalAddress()
+	assert.Equal(t, "DE", a.Country)
+	assert.False(t, a.HasCoordinates())
+
+	enc := EncCompany{City: "Paris", Country: "Atlantis"}
+	assert.Equal(t, "Atlantis", enc.PostalAddress().Country)
+	assert.True(t, PostalAddress{}.IsZero())
+}
+
+func TestCountries(t *testing.T) {
+	for code := range countryNames {
+		assert.Regexp(t, `^[A-Z]{2}$`, code)
+	}
+
+	for _, name := range []string{"Germany", "deutschland", "de", " DE "} {
+		code, ok := LookupCountry(name)
+		assert.True(t, ok, name)
+		assert.Equal(t, "DE", code, name)
+	}
+	_, ok := LookupCountry("Atlantis")
+	assert.False(t, ok)
+
+	name, ok := CountryName("gb")
+	assert.True(t, ok)
+	assert.Equal(t, "United Kingdom", name)
+}
+
+func TestStructuredAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"address": "350 Fifth Avenue, New York, NY 10118, USA"})
+	}))
+	defer server.Close()
+
+	sdk := NewSDKWithConfig(ClientConfig{APIKey: "test-api-key", BaseURL: server.URL})
+	result, err := sdk.NAA("350 5th ave new york")
+	require.NoError(t, err)
+	assert.Nil(t, result.PostalAddress)
+
+	sdk = NewSDKWithConfig(ClientConfig{APIKey: "test-api-key", BaseURL: server.URL, StructuredAddresses: true})
+	result, err = sdk.NAA("350 5th ave new york")
+	require.NoError(t, err)
+	require.NotNil(t, result.PostalAddress)
+	assert.Equal(t, "10118", result.PostalAddress.PostalCode)
+	assert.Equal(t, "US", result.PostalAddress.Country)
+}
@@ -0,0 +1,165 @@
+// Package canon canonicalizes domains, websites and URLs offline so the same
+// company is always written the same way, whether it is passed to an API
+// call, returned in a response or used as a cache or store key.
+//
+// Scheme, credentials, port, path, query and fragment are stripped,
+// internationalized names are converted to punycode and subdomains are
+// handled by a policy. Registrable domains are found with the public suffix
+// list of golang.org/x/net/publicsuffix, so "shop.example.co.uk" reduces to
+// "example.co.uk":
+//
+//	canon.Domain("HTTPS://www.Example.co.uk/about") // "example.co.uk"
+//	canon.Registrable("https://shop.example.co.uk") // "example.co.uk"
+//	canon.Domain("bücher.de")                      // "xn--bcher-kva.de"
+//
+// The SDK uses it for cufinder.CanonicalDomain. Its only dependency is
+// golang.org/x/net, for IDNA and the public suffix list.
+package canon
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	// ErrEmpty is returned for an empty input
+	ErrEmpty = errors.New("empty domain")
+	// ErrInvalidHost is returned when the input has no valid DNS host name
+	ErrInvalidHost = errors.New("invalid host name")
+	// ErrPublicSuffix is returned when the host is a public suffix such as
+	// "co.uk", which no single organization owns
+	ErrPublicSuffix = errors.New("host is a public suffix")
+)
+
+// SubdomainPolicy decides which subdomains are kept
+type SubdomainPolicy int
+
+const (
+	// StripWWW removes a leading "www." and keeps other subdomains
+	StripWWW SubdomainPolicy = iota
+	// KeepAll keeps the host unchanged
+	KeepAll
+	// RegistrableOnly reduces the host to its registrable domain, the public
+	// suffix plus one label
+	RegistrableOnly
+)
+
+// Options configures canonicalization. The zero value strips "www." and
+// returns ASCII names.
+type Options struct {
+	Subdomains SubdomainPolicy
+	// Unicode returns internationalized names in Unicode instead of punycode
+	Unicode bool
+	// ICANNOnly ignores the private section of the public suffix list, so
+	// "user.github.io" reduces to "github.io" rather than staying whole
+	ICANNOnly bool
+}
+
+var hostLabel = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// dots are the ideographic and full-width full stops IDNA treats as "."
+var dots = strings.NewReplacer("。", ".", "．", ".", "｡", ".")
+
+// Host extracts the lower-case ASCII host name from a domain, website or
+// URL. It rejects IP addresses, single-label names and invalid labels.
+func Host(raw string) (string, error) {
+	s := strings.TrimSpace(raw)
+	if s == "" {
+		return "", ErrEmpty
+	}
+	s = dots.Replace(s)
+	if !strings.Contains(s, "://") {
+		s = "http://" + strings.TrimPrefix(s, "//")
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", fmt.Errorf("%w: %q", ErrInvalidHost, raw)
+	}
+	host, err := ToASCII(strings.TrimSuffix(u.Hostname(), "."))
+	if err != nil {
+		return "", err
+	}
+	if !isHostname(host) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidHost, raw)
+	}
+	return host, nil
+}
+
+// isHostname reports whether host is a dotted DNS name with a letter TLD
+func isHostname(host string) bool {
+	if len(host) == 0 || len(host) > 253 {
+		return false
+	}
+
+	labels := strings.Split(host, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if !hostLabel.MatchString(label) {
+			return false
+		}
+	}
+
+	tld := labels[len(labels)-1]
+	return strings.Trim(tld, "0123456789") != ""
+}
+
+// Domain canonicalizes raw with the options
+func (o Options) Domain(raw string) (string, error) {
+	host, err := Host(raw)
+	if err != nil {
+		return "", err
+	}
+
+	suffix, _ := lookup(host, o.ICANNOnly)
+	if host == suffix {
+		return "", fmt.Errorf("%w: %q", ErrPublicSuffix, host)
+	}
+
+	switch o.Subdomains {
+	case StripWWW:
+		if rest := strings.TrimPrefix(host, "www."); rest != host && rest != suffix {
+			host = rest
+		}
+	case RegistrableOnly:
+		host = registrable(host, suffix)
+	}
+
+	if o.Unicode {
+		return ToUnicode(host)
+	}
+	return host, nil
+}
+
+// Domain canonicalizes raw with the default options: "www." is stripped,
+// other subdomains are kept and the name is returned in ASCII
+func Domain(raw string) (string, error) {
+	return Options{}.Domain(raw)
+}
+
+// Registrable reduces raw to its registrable domain, so
+// "https://shop.example.co.uk/cart" becomes "example.co.uk"
+func Registrable(raw string) (string, error) {
+	return Options{Subdomains: RegistrableOnly}.Domain(raw)
+}
+
+// PublicSuffix returns the public suffix of an ASCII host name and whether
+// it is managed by ICANN rather than a private operator such as
+// "github.io". Hosts no rule matches fall back to their top-level domain.
+func PublicSuffix(host string) (string, bool) {
+	return lookup(strings.TrimSuffix(strings.ToLower(host), "."), false)
+}
+
+// registrable returns the public suffix of host plus one label
+func registrable(host, suffix string) string {
+	rest := strings.TrimSuffix(host, "."+suffix)
+	if dot := strings.LastIndexByte(rest, '.'); dot >= 0 {
+		rest = rest[dot+1:]
+	}
+	return rest + "." + suffix
+}
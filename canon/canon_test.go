@@ -0,0 +1,145 @@
+package canon
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDomain(t *testing.T) {
+	tests := map[string]string{
+		"techcorp.com":                           "techcorp.com",
+		"HTTPS://www.TechCorp.com:443/about?x=1": "techcorp.com",
+		"techcorp.com/contact#team":              "techcorp.com",
+		"//www.techcorp.com":                     "techcorp.com",
+		"user:pass@techcorp.com.":                "techcorp.com",
+		"shop.techcorp.co.uk":                    "shop.techcorp.co.uk",
+		"https://www.co.uk/":                     "www.co.uk",
+		"www.co.uk.example.com":                  "co.uk.example.com",
+		"bücher.de":                              "xn--bcher-kva.de",
+		"https://www.Bücher.de/katalog":          "xn--bcher-kva.de",
+		"b%C3%BCcher.de":                         "xn--bcher-kva.de",
+		"例え。テスト":                                 "xn--r8jz45g.xn--zckzah",
+	}
+	for raw, want := range tests {
+		got, err := Domain(raw)
+		require.NoError(t, err, raw)
+		assert.Equal(t, want, got, raw)
+	}
+
+	for raw, want := range map[string]error{
+		"":                    ErrEmpty,
+		"   ":                 ErrEmpty,
+		"localhost":           ErrInvalidHost,
+		"192.168.0.1":         ErrInvalidHost,
+		"http://[::1]:8080/":  ErrInvalidHost,
+		"under_score.com":     ErrInvalidHost,
+		"xn--zz.techcorp.com": ErrInvalidHost,
+		"co.uk":               ErrPublicSuffix,
+		"github.io":           ErrPublicSuffix,
+		"xn--bcher-kva.de":    nil,
+	} {
+		_, err := Domain(raw)
+		if want == nil {
+			assert.NoError(t, err, raw)
+		} else {
+			assert.True(t, errors.Is(err, want), "%q: %v", raw, err)
+		}
+	}
+}
+
+func TestPolicies(t *testing.T) {
+	raw := "https://www.shop.techcorp.co.uk/cart"
+
+	got, err := Options{Subdomains: KeepAll}.Domain(raw)
+	require.NoError(t, err)
+	assert.Equal(t, "www.shop.techcorp.co.uk", got)
+
+	got, err = Domain(raw)
+	require.NoError(t, err)
+	assert.Equal(t, "shop.techcorp.co.uk", got)
+
+	got, err = Registrable(raw)
+	require.NoError(t, err)
+	assert.Equal(t, "techcorp.co.uk", got)
+
+	got, err = Options{Subdomains: RegistrableOnly, Unicode: true}.Domain("shop.xn--bcher-kva.de")
+	require.NoError(t, err)
+	assert.Equal(t, "bücher.de", got)
+
+	// Private suffixes keep hosted sites apart unless ICANNOnly is set
+	got, err = Registrable("blog.acme.github.io")
+	require.NoError(t, err)
+	assert.Equal(t, "acme.github.io", got)
+
+	got, err = Options{Subdomains: RegistrableOnly, ICANNOnly: true}.Domain("blog.acme.github.io")
+	require.NoError(t, err)
+	assert.Equal(t, "github.io", got)
+
+	_, err = Options{ICANNOnly: true}.Domain("github.io")
+	assert.NoError(t, err)
+}
+
+func TestPublicSuffix(t *testing.T) {
+	tests := []struct {
+		host   string
+		suffix string
+		icann  bool
+	}{
+		{"techcorp.com", "com", true},
+		{"shop.techcorp.co.uk", "co.uk", true},
+		{"acme.github.io", "github.io", false},
+		{"a.b.ck", "b.ck", true},
+		{"www.ck", "ck", true},
+		{"shop.www.ck", "ck", true},
+		{"example.xn--fiqs8s", "xn--fiqs8s", true},
+		{"example.unknowntld", "unknowntld", false},
+		{"Techcorp.COM.", "com", true},
+	}
+	for _, tt := range tests {
+		suffix, icann := PublicSuffix(tt.host)
+		assert.Equal(t, tt.suffix, suffix, tt.host)
+		assert.Equal(t, tt.icann, icann, tt.host)
+	}
+}
+
+func TestPunycode(t *testing.T) {
+	// Samples from RFC 3492 section 7.1 and common IDN TLDs
+	tests := map[string]string{
+		"bücher":    "xn--bcher-kva",
+		"münchen":   "xn--mnchen-3ya",
+		"中国":        "xn--fiqs8s",
+		"他们为什么不说中文": "xn--ihqwcrb4cv8a8dqg056pqjye",
+		"ελ":        "xn--qxam",
+		"ascii":     "ascii",
+	}
+	for unicode, ascii := range tests {
+		got, err := ToASCII(unicode)
+		require.NoError(t, err, unicode)
+		assert.Equal(t, ascii, got, unicode)
+
+		got, err = ToUnicode(ascii)
+		require.NoError(t, err, ascii)
+		assert.Equal(t, unicode, got, ascii)
+	}
+
+	got, err := ToASCII("Bücher.DE")
+	require.NoError(t, err)
+	assert.Equal(t, "xn--bcher-kva.de", got)
+
+	// NFD input ("u" followed by a combining diaeresis) is normalized to NFC
+	nfd, err := ToASCII("Bu\u0308cher.de")
+	require.NoError(t, err)
+	assert.Equal(t, got, nfd)
+
+	key, err := Options{}.Domain("https://www.bu\u0308cher.de/")
+	require.NoError(t, err)
+	assert.Equal(t, "xn--bcher-kva.de", key)
+
+	for _, bad := range []string{"xn--bcher-kv!", "xn--99999999999", "xn--ü-"} {
+		_, err := ToUnicode(bad)
+		assert.True(t, errors.Is(err, ErrInvalidHost), bad)
+	}
+}
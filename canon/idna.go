@@ -0,0 +1,50 @@
+package canon
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// acePrefix marks a punycode-encoded label
+const acePrefix = "xn--"
+
+// ToASCII converts a host name with Unicode labels to its ASCII form, so
+// "bücher.de" becomes "xn--bcher-kva.de". Labels are mapped and normalized
+// with the IDNA lookup profile first, so case, width and NFC/NFD variants of
+// a name convert to the same host.
+func ToASCII(host string) (string, error) {
+	ascii, err := idna.Lookup.ToASCII(host)
+	if err != nil {
+		return "", fmt.Errorf("%w: %q: %v", ErrInvalidHost, host, err)
+	}
+	return ascii, nil
+}
+
+// ToUnicode converts the punycode labels of a host name back to Unicode, so
+// "xn--bcher-kva.de" becomes "bücher.de"
+func ToUnicode(host string) (string, error) {
+	host = strings.ToLower(host)
+	for _, label := range strings.Split(host, ".") {
+		// The lookup profile maps a Unicode label before decoding, so an
+		// encoded label with non-ASCII characters would otherwise pass
+		if strings.HasPrefix(label, acePrefix) && !isASCII(label) {
+			return "", fmt.Errorf("%w: %q", ErrInvalidHost, host)
+		}
+	}
+	unicode, err := idna.Lookup.ToUnicode(host)
+	if err != nil {
+		return "", fmt.Errorf("%w: %q: %v", ErrInvalidHost, host, err)
+	}
+	return unicode, nil
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
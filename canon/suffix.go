@@ -0,0 +1,24 @@
+package canon
+
+import (
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+)
+
+// lookup returns the public suffix of an ASCII host name and whether it
+// comes from the ICANN section. Hosts no rule matches fall back to their
+// last label. With icannOnly, private rules such as "github.io" are ignored.
+func lookup(host string, icannOnly bool) (string, bool) {
+	suffix, icann := publicsuffix.PublicSuffix(host)
+	// The ICANN suffix under a private one is found by dropping its labels
+	// until an ICANN rule matches
+	for icannOnly && !icann {
+		dot := strings.IndexByte(suffix, '.')
+		if dot < 0 {
+			break
+		}
+		suffix, icann = publicsuffix.PublicSuffix(suffix[dot+1:])
+	}
+	return suffix, icann
+}
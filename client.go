@@ -1,22 +1,31 @@
 package cufinder
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-	"net/url"
-	"reflect"
-	"strconv"
-	"strings"
 	"time"
+
+	"github.com/cufinder/cufinder-go/emailclass"
 )
 
 // Client represents the CUFinder API client
 type Client struct {
-	apiKey     string
-	baseURL    string
-	httpClient *http.Client
+	apiKey            string
+	baseURL           string
+	httpClient        *http.Client
+	encoder           RequestEncoder
+	disableValidation bool
+	disableCoalescing bool
+	disableNormalize  bool
+	store             ResponseStore
+	storeMaxAge       time.Duration
+	onStoreError      func(endpoint string, err error)
+	emailFilter       emailFilter
+	phones            phoneParser
+	structuredAddr    bool
 }
 
 // ClientConfig holds configuration for the client
@@ -25,6 +34,57 @@ type ClientConfig struct {
 	BaseURL    string
 	Timeout    time.Duration
 	MaxRetries int
+
+	// Encoder controls how request parameters are sent. Defaults to
+	// FormEncoder{}; use JSONEncoder{} to send a JSON body instead
+	Encoder RequestEncoder
+
+	// DisableValidation skips local syntax checks and canonicalization of
+	// parameters (emails, LinkedIn URLs, country codes, domains) before requests
+	DisableValidation bool
+
+	// DisableResponseNormalization returns response fields exactly as the API
+	// sent them instead of canonicalizing LinkedIn URLs and phone numbers
+	DisableResponseNormalization bool
+
+	// PhoneRegion is the ISO 3166-1 alpha-2 region assumed for phone numbers
+	// written without a calling code, in NAO inputs and in NTP and company
+	// phone fields. OfflinePhones answers NAO locally, without spending a
+	// credit, for numbers the phone package parses cleanly
+	PhoneRegion   string
+	OfflinePhones bool
+
+	// StructuredAddresses parses NAA results into NaaResponse.PostalAddress
+	StructuredAddresses bool
+
+	// DisableCoalescing sends every call to the API, even when an identical
+	// request is already in flight from another goroutine
+	DisableCoalescing bool
+
+	// Store persists every API response. When StoreMaxAge is positive,
+	// responses stored within that age are returned without calling the API
+	Store       ResponseStore
+	StoreMaxAge time.Duration
+	// OnStoreError is called when a response cannot be saved. The call
+	// still returns the response, as its credit has been spent.
+	OnStoreError func(endpoint string, err error)
+
+	// RELFilter classifies REL addresses offline before sending them. With
+	// RELFilterRefuse, addresses in RELFilterCategories (role, free-mail and
+	// disposable by default) fail without spending a credit. EmailClassifier
+	// defaults to emailclass.Default
+	RELFilter           RELFilter
+	RELFilterCategories []emailclass.Category
+	EmailClassifier     *emailclass.Classifier
+}
+
+// ResponseStore persists raw API responses keyed by endpoint and parameters
+type ResponseStore interface {
+	// Load returns the latest response for the request if it was fetched
+	// within maxAge
+	Load(endpoint string, params interface{}, maxAge time.Duration) (map[string]interface{}, bool, error)
+	// Save records a response just fetched from the API
+	Save(endpoint string, params interface{}, response map[string]interface{}) error
 }
 
 // NewClient creates a new CUFinder client
@@ -38,6 +98,15 @@ func NewClient(config ClientConfig) *Client {
 	if config.MaxRetries == 0 {
 		config.MaxRetries = 3
 	}
+	if config.Encoder == nil {
+		config.Encoder = FormEncoder{}
+	}
+	if config.RELFilterCategories == nil {
+		config.RELFilterCategories = defaultRELFilterCategories
+	}
+	if config.EmailClassifier == nil {
+		config.EmailClassifier = emailclass.Default
+	}
 
 	return &Client{
 		apiKey:  config.APIKey,
@@ -45,6 +114,23 @@ func NewClient(config ClientConfig) *Client {
 		httpClient: &http.Client{
 			Timeout: config.Timeout,
 		},
+		encoder:           config.Encoder,
+		disableValidation: config.DisableValidation,
+		disableCoalescing: config.DisableCoalescing,
+		disableNormalize:  config.DisableResponseNormalization,
+		store:             config.Store,
+		storeMaxAge:       config.StoreMaxAge,
+		onStoreError:      config.OnStoreError,
+		emailFilter: emailFilter{
+			mode:       config.RELFilter,
+			categories: config.RELFilterCategories,
+			classifier: config.EmailClassifier,
+		},
+		phones: phoneParser{
+			region:  config.PhoneRegion,
+			offline: config.OfflinePhones,
+		},
+		structuredAddr: config.StructuredAddresses,
 	}
 }
 
@@ -52,18 +138,18 @@ func NewClient(config ClientConfig) *Client {
 func (c *Client) Post(endpoint string, data interface{}) (map[string]interface{}, error) {
 	url := c.baseURL + endpoint
 
-	// Convert data to form-encoded format
-	formData, err := c.StructToFormData(data)
+	// Encode data with the configured request encoder
+	body, err := c.encoder.Encode(data)
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert data to form format: %w", err)
+		return nil, fmt.Errorf("failed to encode request data: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", url, strings.NewReader(formData))
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Content-Type", c.encoder.ContentType())
 	req.Header.Set("x-api-key", c.apiKey)
 	req.Header.Set("User-Agent", "cufinder-go/1.1.0")
 
@@ -73,17 +159,17 @@ func (c *Client) Post(endpoint string, data interface{}) (map[string]interface{}
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("API error: status %d, body: %s", resp.StatusCode, string(body))
+		return nil, fmt.Errorf("API error: status %d, body: %s", resp.StatusCode, string(respBody))
 	}
 
 	var result map[string]interface{}
-	if err := json.Unmarshal(body, &result); err != nil {
+	if err := json.Unmarshal(respBody, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -92,106 +178,9 @@ func (c *Client) Post(endpoint string, data interface{}) (map[string]interface{}
 
 // StructToFormData converts a struct to form-encoded data
 func (c *Client) StructToFormData(data interface{}) (string, error) {
-	v := reflect.ValueOf(data)
-	if v.Kind() == reflect.Ptr {
-		v = v.Elem()
-	}
-
-	if v.Kind() != reflect.Struct {
-		return "", fmt.Errorf("data must be a struct")
-	}
-
-	t := v.Type()
-	values := url.Values{}
-
-	for i := 0; i < v.NumField(); i++ {
-		field := t.Field(i)
-		fieldValue := v.Field(i)
-
-		// Skip unexported fields
-		if !fieldValue.CanInterface() {
-			continue
-		}
-
-		// Get JSON tag name
-		jsonTag := field.Tag.Get("json")
-		if jsonTag == "" || jsonTag == "-" {
-			continue
-		}
-
-		// Remove omitempty and other options
-		jsonTag = strings.Split(jsonTag, ",")[0]
-
-		// Handle different field types
-		if fieldValue.Kind() == reflect.Slice {
-			// Handle slices (like []string)
-			if fieldValue.Len() > 0 {
-				var sliceValues []string
-				for i := 0; i < fieldValue.Len(); i++ {
-					item := fieldValue.Index(i)
-					if item.Kind() == reflect.String {
-						sliceValues = append(sliceValues, item.String())
-					} else {
-						sliceValues = append(sliceValues, fmt.Sprintf("%v", item.Interface()))
-					}
-				}
-				// Join slice values with commas
-				values.Set(jsonTag, strings.Join(sliceValues, ","))
-			}
-			continue
-		}
-
-		// Convert field value to string
-		var strValue string
-		switch fieldValue.Kind() {
-		case reflect.String:
-			strValue = fieldValue.String()
-		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			strValue = strconv.FormatInt(fieldValue.Int(), 10)
-		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-			strValue = strconv.FormatUint(fieldValue.Uint(), 10)
-		case reflect.Float32, reflect.Float64:
-			strValue = strconv.FormatFloat(fieldValue.Float(), 'f', -1, 64)
-		case reflect.Bool:
-			strValue = strconv.FormatBool(fieldValue.Bool())
-		default:
-			// For other types, try to convert to string
-			strValue = fmt.Sprintf("%v", fieldValue.Interface())
-		}
-
-		// Add non-empty values, but handle different types appropriately
-		if fieldValue.Kind() == reflect.String {
-			// For strings, only add if not empty
-			if strValue != "" {
-				values.Set(jsonTag, strValue)
-			}
-		} else if fieldValue.Kind() == reflect.Bool {
-			// For booleans, always add the value (true/false)
-			if strValue != "false" {
-				values.Set(jsonTag, strconv.FormatBool(fieldValue.Bool()))
-			}
-		} else if fieldValue.Kind() >= reflect.Int && fieldValue.Kind() <= reflect.Int64 {
-			// For integers, only add if not zero
-			if fieldValue.Int() != 0 {
-				values.Set(jsonTag, strValue)
-			}
-		} else if fieldValue.Kind() >= reflect.Uint && fieldValue.Kind() <= reflect.Uint64 {
-			// For unsigned integers, only add if not zero
-			if fieldValue.Uint() != 0 {
-				values.Set(jsonTag, strValue)
-			}
-		} else if fieldValue.Kind() >= reflect.Float32 && fieldValue.Kind() <= reflect.Float64 {
-			// For floats, only add if not zero
-			if fieldValue.Float() != 0 {
-				values.Set(jsonTag, strValue)
-			}
-		} else {
-			// For other types, only add if not empty string representation
-			if strValue != "" {
-				values.Set(jsonTag, strValue)
-			}
-		}
+	values, err := FormEncoder{}.Values(data)
+	if err != nil {
+		return "", err
 	}
-
 	return values.Encode(), nil
 }
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/cufinder/cufinder-go"
+	"github.com/cufinder/cufinder-go/export"
+)
+
+// maxLine bounds the size of one input line
+const maxLine = 1 << 20
+
+// sink writes exported rows
+type sink interface {
+	Write(row interface{}) error
+	Close() error
+}
+
+// mappings are the bundled CSV formats
+var mappings = map[string]*export.Mapping{
+	"hubspot-companies":   export.HubSpotCompanies,
+	"hubspot-contacts":    export.HubSpotContacts,
+	"salesforce-accounts": export.SalesforceAccounts,
+	"salesforce-contacts": export.SalesforceContacts,
+	"salesforce-leads":    export.SalesforceLeads,
+}
+
+// formats lists every -format value
+func formats() []string {
+	names := []string{"json", "csv", "vcard", "jsonld", "parquet", "arrow"}
+	for name := range mappings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// newSink creates the writer of a format. rowType is the type of the rows,
+// for the columnar formats.
+func newSink(format, mappingPath string, w io.Writer, rowType reflect.Type) (sink, error) {
+	if mappingPath != "" && format != "csv" {
+		return nil, fmt.Errorf("-mapping requires -format csv")
+	}
+	switch format {
+	case "json":
+		return jsonSink{json.NewEncoder(w)}, nil
+	case "csv":
+		var mapping *export.Mapping
+		if mappingPath != "" {
+			m, err := export.LoadMapping(mappingPath)
+			if err != nil {
+				return nil, err
+			}
+			mapping = m
+		}
+		return csvSink{export.NewWriter(w, mapping)}, nil
+	case "vcard":
+		return &contactSink{w: w, write: export.WriteVCards}, nil
+	case "jsonld":
+		return &contactSink{w: w, write: export.WriteJSONLD}, nil
+	case "parquet":
+		return export.NewParquetWriterFor(w, rowType, export.ParquetOptions{})
+	case "arrow":
+		return export.NewArrowWriterFor(w, rowType, export.ArrowOptions{})
+	}
+	if mapping, ok := mappings[format]; ok {
+		return csvSink{export.NewWriter(w, mapping)}, nil
+	}
+	return nil, fmt.Errorf("unknown format %q, want one of %s", format, strings.Join(formats(), ", "))
+}
+
+type jsonSink struct{ enc *json.Encoder }
+
+func (s jsonSink) Write(row interface{}) error { return s.enc.Encode(row) }
+func (s jsonSink) Close() error                { return nil }
+
+type csvSink struct{ w *export.Writer }
+
+func (s csvSink) Write(row interface{}) error { return s.w.Write(row) }
+func (s csvSink) Close() error                { return s.w.Flush() }
+
+// contactSink collects person rows as contacts and writes them on Close
+type contactSink struct {
+	w        io.Writer
+	write    func(io.Writer, []export.Contact) error
+	contacts []export.Contact
+}
+
+func (s *contactSink) Write(row interface{}) error {
+	c, err := export.NewContact(row)
+	if err != nil {
+		return err
+	}
+	s.contacts = append(s.contacts, c)
+	return nil
+}
+
+func (s *contactSink) Close() error { return s.write(s.w, s.contacts) }
+
+// exportAll calls op for every line of in and writes the rows of each
+// response. It returns the number of failed requests; errors writing the
+// output stop the export.
+func exportAll(op cufinder.Operation, service *cufinder.Service, in io.Reader, s sink, logger *slog.Logger) (int, error) {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), maxLine)
+
+	failed := 0
+	for line := 1; scanner.Scan(); line++ {
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		params := op.Params()
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(params); err != nil {
+			logger.Warn("invalid request", "line", line, "error", err)
+			failed++
+			continue
+		}
+
+		response, err := op.Call(service, params)
+		if err != nil {
+			logger.Warn("request failed", "line", line, "operation", op.Name, "error", err)
+			failed++
+			continue
+		}
+		for _, row := range rows(response) {
+			if err := s.Write(row); err != nil {
+				return failed, fmt.Errorf("line %d: %w", line, err)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return failed, fmt.Errorf("failed to read requests: %w", err)
+	}
+	return failed, nil
+}
+
+// resultField returns the index of the result field of a response type:
+// its first list of structs or struct field. The embedded BaseResponse is
+// not a result.
+func resultField(t reflect.Type) (int, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous || !field.IsExported() || field.Tag.Get("json") == "-" {
+			continue
+		}
+		switch {
+		case field.Type.Kind() == reflect.Struct:
+			return i, true
+		case field.Type.Kind() == reflect.Slice && field.Type.Elem().Kind() == reflect.Struct:
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// rowType returns the type of the rows of a response type
+func rowType(response reflect.Type) reflect.Type {
+	i, ok := resultField(response)
+	if !ok {
+		return response
+	}
+	if t := response.Field(i).Type; t.Kind() == reflect.Slice {
+		return t.Elem()
+	}
+	return response.Field(i).Type
+}
+
+// rows returns the results of a response, or the response itself when it
+// has no result field
+func rows(response interface{}) []interface{} {
+	v := reflect.ValueOf(response)
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return []interface{}{response}
+	}
+	i, ok := resultField(v.Type())
+	if !ok {
+		return []interface{}{response}
+	}
+
+	f := v.Field(i)
+	if f.Kind() == reflect.Struct {
+		return []interface{}{ref(f)}
+	}
+	items := make([]interface{}, f.Len())
+	for j := range items {
+		items[j] = ref(f.Index(j))
+	}
+	return items
+}
+
+// ref returns a pointer to v where possible, as the export writers take
+func ref(v reflect.Value) interface{} {
+	if v.CanAddr() {
+		return v.Addr().Interface()
+	}
+	return v.Interface()
+}
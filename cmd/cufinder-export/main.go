@@ -0,0 +1,129 @@
+// Command cufinder-export runs a CUFinder operation for every line of a
+// JSON-lines file and writes the results in an export format.
+//
+// Each input line holds the parameters of one request, with the JSON keys
+// of the REST API:
+//
+//	{"job_title_role": "finance", "company_country": "germany", "page": 1}
+//	{"job_title_role": "finance", "company_country": "germany", "page": 2}
+//
+// The rows of a response are its result list, such as the companies of CSE
+// or the people of PSE, or its single result, such as the person of TEP.
+// Responses without one are written whole. Rows of every response go to
+// one output, so a search can be exported page by page:
+//
+//	cufinder-export -op PSE -format vcard < pages.jsonl > people.vcf
+//
+// -format selects the output:
+//
+//	json                 one JSON row per line (default)
+//	csv                  one column per field, or the columns of -mapping
+//	hubspot-companies    HubSpot company import CSV
+//	hubspot-contacts     HubSpot contact import CSV
+//	salesforce-accounts  Salesforce Account import CSV
+//	salesforce-contacts  Salesforce Contact import CSV
+//	salesforce-leads     Salesforce Lead import CSV
+//	vcard                vCard 4.0, for person results
+//	jsonld               schema.org JSON-LD, for person results
+//	parquet              Parquet, with nested structs and lists kept
+//	arrow                Arrow IPC file (Feather v2)
+//
+// Failed requests are logged to stderr and skipped; the command then exits
+// with status 1 after writing the other rows.
+//
+// The CUFinder API key is read from CUFINDER_API_KEY.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+// config holds the command-line flags
+type config struct {
+	op      string
+	format  string
+	mapping string
+	baseURL string
+}
+
+func main() {
+	var cfg config
+	flag.StringVar(&cfg.op, "op", "", "operation to run, as in CSE or TEP")
+	flag.StringVar(&cfg.format, "format", "json", "output format")
+	flag.StringVar(&cfg.mapping, "mapping", "", "YAML or JSON column mapping for -format csv")
+	flag.StringVar(&cfg.baseURL, "base-url", "", "CUFinder API base URL")
+	in := flag.String("in", "", "input file, stdin if empty")
+	out := flag.String("out", "", "output file, stdout if empty")
+	flag.Parse()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	if err := open(cfg, *in, *out, logger); err != nil {
+		logger.Error("export failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+// open runs the export between the named files
+func open(cfg config, inPath, outPath string, logger *slog.Logger) (err error) {
+	var in io.Reader = os.Stdin
+	if inPath != "" {
+		f, err := os.Open(inPath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	var out io.Writer = os.Stdout
+	if outPath != "" {
+		f, err := os.Create(outPath)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+		}()
+		out = f
+	}
+
+	apiKey := os.Getenv("CUFINDER_API_KEY")
+	if apiKey == "" {
+		return errors.New("CUFINDER_API_KEY is not set")
+	}
+	client := cufinder.NewClient(cufinder.ClientConfig{APIKey: apiKey, BaseURL: cfg.baseURL})
+	return run(cfg, cufinder.NewService(client), in, out, logger)
+}
+
+// run exports the results of every request read from in
+func run(cfg config, service *cufinder.Service, in io.Reader, out io.Writer, logger *slog.Logger) error {
+	op, ok := cufinder.LookupOperation(cfg.op)
+	if !ok {
+		return fmt.Errorf("unknown operation %q", cfg.op)
+	}
+	s, err := newSink(cfg.format, cfg.mapping, out, rowType(op.ResponseType()))
+	if err != nil {
+		return err
+	}
+
+	failed, err := exportAll(op, service, in, s, logger)
+	if err != nil {
+		return err
+	}
+	if err := s.Close(); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d requests failed", failed)
+	}
+	return nil
+}
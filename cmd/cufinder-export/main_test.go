@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+func newTestService(t *testing.T) *cufinder.Service {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		body := map[string]interface{}{"credit_count": 1}
+		switch r.URL.Path {
+		case "/pse":
+			if r.PostForm.Get("page") == "3" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			page := r.PostForm.Get("page")
+			body["peoples"] = []map[string]interface{}{
+				{"full_name": "Jane Doe " + page, "current_job": map[string]interface{}{"title": "CFO"}},
+				{"full_name": "John Roe " + page},
+			}
+		case "/enc":
+			body["company"] = map[string]interface{}{"name": "Acme", "domain": r.PostForm.Get("query")}
+		case "/cuf":
+			body["domain"] = "acme.com"
+		}
+		json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(api.Close)
+
+	client := cufinder.NewClient(cufinder.ClientConfig{APIKey: "api-key", BaseURL: api.URL})
+	return cufinder.NewService(client)
+}
+
+func runExport(t *testing.T, cfg config, input string) (string, error) {
+	var out bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	err := run(cfg, newTestService(t), strings.NewReader(input), &out, logger)
+	return out.String(), err
+}
+
+func TestExportFormats(t *testing.T) {
+	pages := `{"job_title_role": "finance", "page": 1}` + "\n\n" + `{"job_title_role": "finance", "page": 2}` + "\n"
+
+	out, err := runExport(t, config{op: "PSE", format: "json"}, pages)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 4)
+	assert.Contains(t, lines[2], `"full_name":"Jane Doe 2"`)
+
+	out, err = runExport(t, config{op: "PSE", format: "vcard"}, pages)
+	require.NoError(t, err)
+	assert.Equal(t, 4, strings.Count(out, "BEGIN:VCARD"))
+	assert.Contains(t, out, "TITLE:CFO")
+
+	out, err = runExport(t, config{op: "PSE", format: "jsonld"}, pages)
+	require.NoError(t, err)
+	assert.Contains(t, out, `"@type"`)
+
+	out, err = runExport(t, config{op: "ENC", format: "hubspot-companies"}, `{"query": "acme.com"}`)
+	require.NoError(t, err)
+	assert.Len(t, strings.Split(strings.TrimSpace(out), "\n"), 2)
+	assert.Contains(t, out, "Acme")
+
+	out, err = runExport(t, config{op: "PSE", format: "parquet"}, pages)
+	require.NoError(t, err)
+	r, err := file.NewParquetReader(bytes.NewReader([]byte(out)))
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), r.NumRows())
+
+	out, err = runExport(t, config{op: "PSE", format: "arrow"}, pages)
+	require.NoError(t, err)
+	ar, err := ipc.NewFileReader(bytes.NewReader([]byte(out)))
+	require.NoError(t, err)
+	rec, err := ar.RecordBatch(0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), rec.NumRows())
+	ar.Close()
+
+	// Responses without a result struct are written whole
+	out, err = runExport(t, config{op: "CUF", format: "csv"}, `{"company_name": "Acme", "country_code": "US"}`)
+	require.NoError(t, err)
+	assert.Contains(t, out, "acme.com")
+}
+
+func TestExportErrors(t *testing.T) {
+	_, err := runExport(t, config{op: "XYZ", format: "json"}, "")
+	assert.ErrorContains(t, err, "unknown operation")
+
+	_, err = runExport(t, config{op: "PSE", format: "xml"}, "")
+	assert.ErrorContains(t, err, "unknown format")
+
+	_, err = runExport(t, config{op: "PSE", format: "json", mapping: "m.yaml"}, "")
+	assert.ErrorContains(t, err, "-mapping requires -format csv")
+
+	_, err = runExport(t, config{op: "ENC", format: "vcard"}, `{"query": "acme.com"}`)
+	assert.Error(t, err)
+
+	// Failed requests are skipped and counted
+	input := `{"job_title_role": "finance", "page": 1}` + "\n" + `{"job_title_role": "finance", "page": 3}` + "\n" + `{"bogus": 1}` + "\n"
+	out, err := runExport(t, config{op: "PSE", format: "json"}, input)
+	assert.EqualError(t, err, "2 requests failed")
+	assert.Len(t, strings.Split(strings.TrimSpace(out), "\n"), 2)
+}
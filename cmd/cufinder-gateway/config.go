@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// config is the gateway configuration file:
+//
+//	base_url: https://api.cufinder.io/v2
+//	cache_ttl: 1h
+//	cache_size: 10000
+//	budget_window: 24h
+//	clients:
+//	  - name: billing
+//	    token: 3f1c...
+//	    budget: 500   # credits per budget window, 0 for no limit
+//	    rate: 5       # requests per second, 0 for no limit
+//	    burst: 10
+type config struct {
+	BaseURL      string         `yaml:"base_url"`
+	CacheTTL     time.Duration  `yaml:"cache_ttl"`
+	CacheSize    int            `yaml:"cache_size"`
+	BudgetWindow time.Duration  `yaml:"budget_window"`
+	Clients      []clientConfig `yaml:"clients"`
+}
+
+// clientConfig is one caller of the gateway, identified by a static token
+type clientConfig struct {
+	Name   string  `yaml:"name"`
+	Token  string  `yaml:"token"`
+	Budget int     `yaml:"budget"`
+	Rate   float64 `yaml:"rate"`
+	Burst  int     `yaml:"burst"`
+}
+
+func loadConfig(path string) (*config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+	return parseConfig(data)
+}
+
+func parseConfig(data []byte) (*config, error) {
+	cfg := &config{}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+	if cfg.CacheSize == 0 {
+		cfg.CacheSize = 10000
+	}
+	if cfg.BudgetWindow == 0 {
+		cfg.BudgetWindow = 24 * time.Hour
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (c *config) validate() error {
+	if len(c.Clients) == 0 {
+		return fmt.Errorf("config: at least one client is required")
+	}
+	if c.CacheTTL < 0 || c.CacheSize < 0 || c.BudgetWindow < 0 {
+		return fmt.Errorf("config: cache_ttl, cache_size and budget_window must not be negative")
+	}
+
+	names := make(map[string]bool)
+	tokens := make(map[string]bool)
+	for i, client := range c.Clients {
+		switch {
+		case client.Name == "":
+			return fmt.Errorf("config: client %d: name is required", i+1)
+		case len(client.Token) < 16:
+			return fmt.Errorf("config: client %s: token must be at least 16 characters", client.Name)
+		case names[client.Name]:
+			return fmt.Errorf("config: client %s is listed twice", client.Name)
+		case tokens[client.Token]:
+			return fmt.Errorf("config: client %s reuses another client's token", client.Name)
+		case client.Budget < 0 || client.Rate < 0 || client.Burst < 0:
+			return fmt.Errorf("config: client %s: budget, rate and burst must not be negative", client.Name)
+		}
+		names[client.Name] = true
+		tokens[client.Token] = true
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"container/list"
+	"math"
+	"sync"
+	"time"
+)
+
+// bucket is a token bucket refilled at rate tokens per second
+type bucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newBucket(rate float64, burst int) *bucket {
+	if burst < 1 {
+		burst = int(math.Max(1, math.Ceil(rate)))
+	}
+	return &bucket{rate: rate, burst: float64(burst), tokens: float64(burst)}
+}
+
+// take removes a token, or returns how long until one is available
+func (b *bucket) take(now time.Time) (bool, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.last.IsZero() {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+// budget counts the credits a client spends in fixed windows
+type budget struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	start  time.Time
+	spent  int
+}
+
+type budgetStatus struct {
+	Limit     int       `json:"limit"`
+	Spent     int       `json:"spent"`
+	Remaining int       `json:"remaining"`
+	ResetsAt  time.Time `json:"resets_at"`
+}
+
+func (b *budget) roll(now time.Time) {
+	if b.start.IsZero() || now.Sub(b.start) >= b.window {
+		b.start, b.spent = now, 0
+	}
+}
+
+// allowed reports whether the client has credits left. Calls in flight are
+// not reserved, so concurrent calls may overshoot the limit by their cost.
+func (b *budget) allowed(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.roll(now)
+	return b.spent < b.limit
+}
+
+func (b *budget) spend(now time.Time, credits int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.roll(now)
+	b.spent += credits
+}
+
+func (b *budget) status(now time.Time) budgetStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.roll(now)
+	return budgetStatus{
+		Limit:     b.limit,
+		Spent:     b.spent,
+		Remaining: max(0, b.limit-b.spent),
+		ResetsAt:  b.start.Add(b.window),
+	}
+}
+
+// cache keeps encoded responses for a TTL, evicting the least recently
+// used entry when full. It is shared by every client.
+type cache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+type cacheEntry struct {
+	key     string
+	body    []byte
+	expires time.Time
+}
+
+func newCache(ttl time.Duration, size int) *cache {
+	return &cache{ttl: ttl, size: size, entries: make(map[string]*list.Element), order: list.New()}
+}
+
+func (c *cache) get(key string, now time.Time) ([]byte, bool) {
+	if c.ttl <= 0 {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := e.Value.(*cacheEntry)
+	if now.After(entry.expires) {
+		c.order.Remove(e)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.order.MoveToFront(e)
+	return entry.body, true
+}
+
+func (c *cache) put(key string, body []byte, now time.Time) {
+	if c.ttl <= 0 || c.size <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[key]; ok {
+		e.Value = &cacheEntry{key: key, body: body, expires: now.Add(c.ttl)}
+		c.order.MoveToFront(e)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, body: body, expires: now.Add(c.ttl)})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+func (c *cache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
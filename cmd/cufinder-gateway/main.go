@@ -0,0 +1,85 @@
+// Command cufinder-gateway serves the CUFinder API as an internal JSON REST
+// service, so other services can use CUFinder without holding the API key.
+//
+// Every operation is served at POST /v1/{operation}, as in /v1/cuf or
+// /v1/enc, with the parameters as a JSON object and the response type as
+// the JSON result:
+//
+//	curl -H "Authorization: Bearer $TOKEN" -d '{"query":"cufinder.io"}' localhost:8080/v1/enc
+//
+// Callers authenticate with static bearer tokens from the configuration
+// file (see config). Per token, the gateway enforces a request rate and a
+// credit budget per window. Responses are cached for all callers; cache
+// hits cost no credits, and a failed upstream call is charged one credit
+// since the API may bill it. The X-Cache, X-Credits-Used and
+// X-Credits-Remaining headers report what a call cost.
+//
+// Other endpoints:
+//
+//	GET /v1/operations  operations with their required parameters
+//	GET /v1/budget      the caller's credit budget
+//	GET /healthz        liveness check, without authentication
+//	GET /metrics        Prometheus metrics, without authentication
+//
+// The CUFinder API key is read from CUFINDER_API_KEY.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "listen address")
+	configPath := flag.String("config", "gateway.yaml", "configuration file")
+	flag.Parse()
+
+	logger := slog.New(slog.NewJSONHandler(os.Stderr, nil))
+	if err := run(*addr, *configPath, logger); err != nil {
+		logger.Error("gateway stopped", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run(addr, configPath string, logger *slog.Logger) error {
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+	apiKey := os.Getenv("CUFINDER_API_KEY")
+	if apiKey == "" {
+		return fmt.Errorf("CUFINDER_API_KEY is not set")
+	}
+
+	client := cufinder.NewClient(cufinder.ClientConfig{APIKey: apiKey, BaseURL: cfg.BaseURL})
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           newServer(cufinder.NewService(client), cfg, logger).routes(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdown, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		srv.Shutdown(shutdown)
+	}()
+
+	logger.Info("gateway listening", "addr", addr, "clients", len(cfg.Clients))
+	if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
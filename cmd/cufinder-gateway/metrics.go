@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// metrics holds counters exposed in the Prometheus text format
+type metrics struct {
+	mu       sync.Mutex
+	counters map[string]float64
+	help     map[string]string
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		counters: make(map[string]float64),
+		help: map[string]string{
+			"cufinder_gateway_requests_total":           "Requests by operation and HTTP status.",
+			"cufinder_gateway_request_duration_seconds": "Time spent serving requests by operation.",
+			"cufinder_gateway_cache_hits_total":         "Responses served from the shared cache.",
+			"cufinder_gateway_cache_misses_total":       "Requests sent to the CUFinder API.",
+			"cufinder_gateway_credits_used_total":       "Credits spent by client.",
+			"cufinder_gateway_upstream_errors_total":    "Failed CUFinder API calls by operation.",
+			"cufinder_gateway_rejected_total":           "Requests refused by client and reason.",
+		},
+	}
+}
+
+// add increments a counter. labels are name, value pairs.
+func (m *metrics) add(name string, value float64, labels ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counters[series(name, labels)] += value
+}
+
+func series(name string, labels []string) string {
+	if len(labels) == 0 {
+		return name
+	}
+	pairs := make([]string, 0, len(labels)/2)
+	for i := 0; i+1 < len(labels); i += 2 {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", labels[i], labels[i+1]))
+	}
+	return name + "{" + strings.Join(pairs, ",") + "}"
+}
+
+// write renders the counters, plus the given gauges, sorted by series
+func (m *metrics) write(w io.Writer, gauges map[string]float64) error {
+	m.mu.Lock()
+	lines := make(map[string]float64, len(m.counters)+len(gauges))
+	for k, v := range m.counters {
+		lines[k] = v
+	}
+	m.mu.Unlock()
+	for k, v := range gauges {
+		lines[k] = v
+	}
+
+	keys := make([]string, 0, len(lines))
+	for k := range lines {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	described := make(map[string]bool)
+	for _, k := range keys {
+		name, _, _ := strings.Cut(k, "{")
+		family := strings.TrimSuffix(strings.TrimSuffix(name, "_sum"), "_count")
+		if help, ok := m.help[family]; ok && !described[family] {
+			kind := "counter"
+			if family != name {
+				kind = "summary"
+			}
+			if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", family, help, family, kind); err != nil {
+				return err
+			}
+			described[family] = true
+		}
+		if _, err := fmt.Fprintf(w, "%s %g\n", k, lines[k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
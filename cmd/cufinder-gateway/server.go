@@ -0,0 +1,289 @@
+package main
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+const maxBodySize = 1 << 20
+
+// server exposes every CUFinder operation as POST /v1/{operation}
+type server struct {
+	service *cufinder.Service
+	clients []*client
+	cache   *cache
+	metrics *metrics
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+// client is the runtime state of a configured caller
+type client struct {
+	name    string
+	token   []byte
+	limiter *bucket
+	budget  *budget
+}
+
+func newServer(service *cufinder.Service, cfg *config, logger *slog.Logger) *server {
+	s := &server{
+		service: service,
+		cache:   newCache(cfg.CacheTTL, cfg.CacheSize),
+		metrics: newMetrics(),
+		logger:  logger,
+		now:     time.Now,
+	}
+	for _, c := range cfg.Clients {
+		state := &client{name: c.Name, token: []byte(c.Token)}
+		if c.Rate > 0 {
+			state.limiter = newBucket(c.Rate, c.Burst)
+		}
+		if c.Budget > 0 {
+			state.budget = &budget{limit: c.Budget, window: cfg.BudgetWindow}
+		}
+		s.clients = append(s.clients, state)
+	}
+	return s
+}
+
+func (s *server) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", s.handleHealth)
+	mux.HandleFunc("/metrics", s.handleMetrics)
+	mux.HandleFunc("/v1/operations", s.authenticated(s.handleOperations))
+	mux.HandleFunc("/v1/budget", s.authenticated(s.handleBudget))
+	mux.HandleFunc("/v1/", s.authenticated(s.handleCall))
+	return s.logged(mux)
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// logged writes one log line per request
+func (s *server) logged(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := s.now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		s.logger.Info("request",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", rec.status,
+			"client", rec.Header().Get("X-Gateway-Client"),
+			"cache", rec.Header().Get("X-Cache"),
+			"credits", rec.Header().Get("X-Credits-Used"),
+			"duration", s.now().Sub(start),
+		)
+	})
+}
+
+// authenticated checks the bearer token and passes the client on
+func (s *server) authenticated(next func(http.ResponseWriter, *http.Request, *client)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if c := s.lookup(token); ok && c != nil {
+			w.Header().Set("X-Gateway-Client", c.name)
+			next(w, r, c)
+			return
+		}
+		s.metrics.add("cufinder_gateway_rejected_total", 1, "client", "", "reason", "unauthorized")
+		w.Header().Set("WWW-Authenticate", `Bearer realm="cufinder-gateway"`)
+		writeError(w, http.StatusUnauthorized, "missing or invalid token")
+	}
+}
+
+func (s *server) lookup(token string) *client {
+	var found *client
+	for _, c := range s.clients {
+		// Compare with every token so timing does not reveal which matched
+		if subtle.ConstantTimeCompare(c.token, []byte(token)) == 1 {
+			found = c
+		}
+	}
+	return found
+}
+
+func (s *server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func (s *server) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	stats := s.service.CoalesceStats()
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	s.metrics.write(w, map[string]float64{
+		"cufinder_gateway_cache_entries":     float64(s.cache.len()),
+		"cufinder_gateway_upstream_requests": float64(stats.Requests),
+		"cufinder_gateway_coalesced_calls":   float64(stats.Coalesced),
+	})
+}
+
+type operationInfo struct {
+	Name        string   `json:"name"`
+	Path        string   `json:"path"`
+	Description string   `json:"description"`
+	Required    []string `json:"required,omitempty"`
+}
+
+func (s *server) handleOperations(w http.ResponseWriter, r *http.Request, c *client) {
+	infos := make([]operationInfo, 0, len(cufinder.Operations))
+	for _, o := range cufinder.Operations {
+		infos = append(infos, operationInfo{
+			Name:        o.Name,
+			Path:        "/v1" + o.Endpoint,
+			Description: o.Description,
+			Required:    o.Required(),
+		})
+	}
+	writeJSON(w, http.StatusOK, infos)
+}
+
+func (s *server) handleBudget(w http.ResponseWriter, r *http.Request, c *client) {
+	if c.budget == nil {
+		writeJSON(w, http.StatusOK, map[string]interface{}{"client": c.name, "limit": nil})
+		return
+	}
+	writeJSON(w, http.StatusOK, struct {
+		Client string `json:"client"`
+		budgetStatus
+	}{c.name, c.budget.status(s.now())})
+}
+
+func (s *server) handleCall(w http.ResponseWriter, r *http.Request, c *client) {
+	op, ok := cufinder.LookupOperation(strings.TrimPrefix(r.URL.Path, "/v1/"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "unknown operation")
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeError(w, http.StatusMethodNotAllowed, "use POST with a JSON body")
+		return
+	}
+
+	start := s.now()
+	status := s.call(w, r, c, op)
+	s.metrics.add("cufinder_gateway_requests_total", 1, "operation", op.Name, "status", strconv.Itoa(status))
+	s.metrics.add("cufinder_gateway_request_duration_seconds_sum", s.now().Sub(start).Seconds(), "operation", op.Name)
+	s.metrics.add("cufinder_gateway_request_duration_seconds_count", 1, "operation", op.Name)
+}
+
+// call serves one operation and returns the response status
+func (s *server) call(w http.ResponseWriter, r *http.Request, c *client, op cufinder.Operation) int {
+	if c.limiter != nil {
+		if ok, wait := c.limiter.take(s.now()); !ok {
+			s.metrics.add("cufinder_gateway_rejected_total", 1, "client", c.name, "reason", "rate_limit")
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			return writeError(w, http.StatusTooManyRequests, "rate limit exceeded")
+		}
+	}
+
+	params := op.Params()
+	if err := decodeParams(http.MaxBytesReader(w, r.Body, maxBodySize), params); err != nil {
+		return writeError(w, http.StatusBadRequest, err.Error())
+	}
+	if missing := op.Missing(params); len(missing) > 0 {
+		return writeError(w, http.StatusBadRequest, strings.Join(missing, ", ")+" required")
+	}
+
+	// Keyed after canonicalization, so spellings of the same request share
+	// a cache entry
+	cacheKey, err := op.Key(s.service, params)
+	if err != nil {
+		var validation *cufinder.ValidationError
+		if errors.As(err, &validation) {
+			return writeError(w, http.StatusUnprocessableEntity, err.Error())
+		}
+		return writeError(w, http.StatusBadRequest, err.Error())
+	}
+	if body, ok := s.cache.get(cacheKey, s.now()); ok {
+		s.metrics.add("cufinder_gateway_cache_hits_total", 1, "operation", op.Name)
+		w.Header().Set("X-Cache", "HIT")
+		w.Header().Set("X-Credits-Used", "0")
+		return writeBody(w, http.StatusOK, body)
+	}
+
+	if c.budget != nil && !c.budget.allowed(s.now()) {
+		s.metrics.add("cufinder_gateway_rejected_total", 1, "client", c.name, "reason", "budget")
+		return writeError(w, http.StatusPaymentRequired, "credit budget exhausted")
+	}
+
+	s.metrics.add("cufinder_gateway_cache_misses_total", 1, "operation", op.Name)
+	result, err := op.Call(s.service, params)
+	// Failed calls are charged too, as the API may bill them
+	credits := cufinder.CallCredits(result, err)
+	s.metrics.add("cufinder_gateway_credits_used_total", float64(credits), "client", c.name)
+	if c.budget != nil {
+		c.budget.spend(s.now(), credits)
+		w.Header().Set("X-Credits-Remaining", strconv.Itoa(c.budget.status(s.now()).Remaining))
+	}
+	w.Header().Set("X-Credits-Used", strconv.Itoa(credits))
+	if err != nil {
+		var validation *cufinder.ValidationError
+		var filtered *cufinder.EmailFilterError
+		if errors.As(err, &validation) || errors.As(err, &filtered) {
+			return writeError(w, http.StatusUnprocessableEntity, err.Error())
+		}
+		s.metrics.add("cufinder_gateway_upstream_errors_total", 1, "operation", op.Name)
+		s.logger.Error("upstream error", "operation", op.Name, "client", c.name, "error", err)
+		return writeError(w, http.StatusBadGateway, "CUFinder API request failed")
+	}
+
+	body, err := json.Marshal(result)
+	if err != nil {
+		return writeError(w, http.StatusInternalServerError, "failed to encode response")
+	}
+	s.cache.put(cacheKey, body, s.now())
+	w.Header().Set("X-Cache", "MISS")
+	return writeBody(w, http.StatusOK, body)
+}
+
+// decodeParams reads a JSON object into params, rejecting unknown fields.
+// An empty body leaves params empty.
+func decodeParams(body io.Reader, params interface{}) error {
+	dec := json.NewDecoder(body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(params); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("invalid request body: %w", err)
+	}
+	if dec.More() {
+		return fmt.Errorf("invalid request body: trailing data")
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) int {
+	body, err := json.Marshal(v)
+	if err != nil {
+		status, body = http.StatusInternalServerError, []byte(`{"error":"failed to encode response"}`)
+	}
+	return writeBody(w, status, body)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) int {
+	return writeJSON(w, status, map[string]string{"error": message})
+}
+
+func writeBody(w http.ResponseWriter, status int, body []byte) int {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(append(body, '\n'))
+	return status
+}
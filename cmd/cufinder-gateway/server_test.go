@@ -0,0 +1,272 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cufinder/cufinder-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	teamToken    = "team-token-0123456789"
+	limitedToken = "limited-token-0123456789"
+)
+
+type gatewayTest struct {
+	t        *testing.T
+	gateway  *httptest.Server
+	server   *server
+	upstream atomic.Int64
+	now      time.Time
+}
+
+func newGatewayTest(t *testing.T, configYAML string) *gatewayTest {
+	g := &gatewayTest{t: t, now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.upstream.Add(1)
+		assert.Equal(t, "api-key", r.Header.Get("x-api-key"))
+		require.NoError(t, r.ParseForm())
+		body := map[string]interface{}{"credit_count": 2}
+		switch r.URL.Path {
+		case "/enc":
+			if r.PostForm.Get("query") == "broken.io" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			body["company"] = map[string]interface{}{"name": "Acme", "domain": r.PostForm.Get("query")}
+		case "/cuf":
+			body["domain"] = "acme.com"
+		}
+		json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(api.Close)
+
+	cfg, err := parseConfig([]byte(configYAML))
+	require.NoError(t, err)
+	cfg.BaseURL = api.URL
+	client := cufinder.NewClient(cufinder.ClientConfig{APIKey: "api-key", BaseURL: cfg.BaseURL})
+
+	g.server = newServer(cufinder.NewService(client), cfg, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	g.server.now = func() time.Time { return g.now }
+	g.gateway = httptest.NewServer(g.server.routes())
+	t.Cleanup(g.gateway.Close)
+	return g
+}
+
+func (g *gatewayTest) do(method, path, token, body string) (*http.Response, map[string]interface{}) {
+	req, err := http.NewRequest(method, g.gateway.URL+path, strings.NewReader(body))
+	require.NoError(g.t, err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(g.t, err)
+	defer resp.Body.Close()
+
+	var decoded map[string]interface{}
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(g.t, err)
+	json.Unmarshal(data, &decoded)
+	return resp, decoded
+}
+
+const testConfig = `
+cache_ttl: 1h
+budget_window: 24h
+clients:
+  - name: team
+    token: team-token-0123456789
+  - name: limited
+    token: limited-token-0123456789
+    budget: 3
+    rate: 1
+    burst: 2
+`
+
+func TestGatewayCall(t *testing.T) {
+	g := newGatewayTest(t, testConfig)
+
+	resp, body := g.do("POST", "/v1/enc", teamToken, `{"query":"acme.com"}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "Acme", body["company"].(map[string]interface{})["name"])
+	assert.Equal(t, "MISS", resp.Header.Get("X-Cache"))
+	assert.Equal(t, "2", resp.Header.Get("X-Credits-Used"))
+
+	// Cached for every client, free of charge
+	resp, body = g.do("POST", "/v1/ENC", limitedToken, `{"query":"acme.com"}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "HIT", resp.Header.Get("X-Cache"))
+	assert.Equal(t, "0", resp.Header.Get("X-Credits-Used"))
+	assert.Equal(t, "Acme", body["company"].(map[string]interface{})["name"])
+	assert.Equal(t, int64(1), g.upstream.Load())
+
+	resp, _ = g.do("POST", "/v1/cuf", teamToken, `{"company_name":"Acme","country_code":"us"}`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int64(2), g.upstream.Load())
+
+	// Requests are cached by their canonical parameters
+	resp, _ = g.do("POST", "/v1/cuf", teamToken, `{"company_name":"Acme","country_code":"US"}`)
+	assert.Equal(t, "HIT", resp.Header.Get("X-Cache"))
+	resp, _ = g.do("POST", "/v1/dtc", teamToken, `{"company_website":"https://www.techcorp.com/"}`)
+	assert.Equal(t, "MISS", resp.Header.Get("X-Cache"))
+	resp, _ = g.do("POST", "/v1/dtc", teamToken, `{"company_website":"techcorp.com"}`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "HIT", resp.Header.Get("X-Cache"))
+	assert.Equal(t, int64(3), g.upstream.Load())
+}
+
+func TestGatewayErrors(t *testing.T) {
+	g := newGatewayTest(t, testConfig)
+
+	cases := []struct {
+		method, path, token, body string
+		status                    int
+	}{
+		{"POST", "/v1/enc", "", `{"query":"acme.com"}`, http.StatusUnauthorized},
+		{"POST", "/v1/enc", "wrong-token-0123456789", `{"query":"acme.com"}`, http.StatusUnauthorized},
+		{"POST", "/v1/nope", teamToken, `{}`, http.StatusNotFound},
+		{"GET", "/v1/enc", teamToken, ``, http.StatusMethodNotAllowed},
+		{"POST", "/v1/enc", teamToken, `{"query":`, http.StatusBadRequest},
+		{"POST", "/v1/enc", teamToken, `{"q":"acme.com"}`, http.StatusBadRequest},
+		{"POST", "/v1/cuf", teamToken, `{"company_name":"Acme"}`, http.StatusBadRequest},
+		{"POST", "/v1/cuf", teamToken, `{"company_name":"Acme","country_code":"Narnia"}`, http.StatusUnprocessableEntity},
+		{"POST", "/v1/enc", teamToken, `{"query":"broken.io"}`, http.StatusBadGateway},
+	}
+	for _, c := range cases {
+		resp, body := g.do(c.method, c.path, c.token, c.body)
+		assert.Equal(t, c.status, resp.StatusCode, "%s %s %s", c.method, c.path, c.body)
+		assert.NotEmpty(t, body["error"], "%s %s %s", c.method, c.path, c.body)
+	}
+}
+
+func TestGatewayLimits(t *testing.T) {
+	g := newGatewayTest(t, testConfig)
+
+	// Burst of two, then one request per second
+	resp, _ := g.do("POST", "/v1/enc", limitedToken, `{"query":"a.com"}`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "1", resp.Header.Get("X-Credits-Remaining"))
+	resp, _ = g.do("POST", "/v1/enc", limitedToken, `{"query":"b.com"}`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp, _ = g.do("POST", "/v1/enc", limitedToken, `{"query":"c.com"}`)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, "1", resp.Header.Get("Retry-After"))
+
+	// Four of three credits are spent, so only cached answers are left
+	g.now = g.now.Add(time.Second)
+	resp, _ = g.do("POST", "/v1/enc", limitedToken, `{"query":"c.com"}`)
+	assert.Equal(t, http.StatusPaymentRequired, resp.StatusCode)
+	g.now = g.now.Add(time.Second)
+	resp, _ = g.do("POST", "/v1/enc", limitedToken, `{"query":"a.com"}`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_, body := g.do("GET", "/v1/budget", limitedToken, "")
+	assert.Equal(t, float64(4), body["spent"])
+	assert.Equal(t, float64(0), body["remaining"])
+
+	// The budget resets with the window
+	g.now = g.now.Add(24 * time.Hour)
+	resp, _ = g.do("POST", "/v1/enc", limitedToken, `{"query":"c.com"}`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// Other clients are not affected
+	resp, _ = g.do("POST", "/v1/enc", teamToken, `{"query":"d.com"}`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	_, body = g.do("GET", "/v1/budget", teamToken, "")
+	assert.Nil(t, body["limit"])
+}
+
+func TestGatewayChargesFailures(t *testing.T) {
+	g := newGatewayTest(t, testConfig)
+
+	// Failed upstream calls may be billed, so they count against the budget
+	for i, remaining := range []string{"2", "1", "0"} {
+		g.now = g.now.Add(time.Second)
+		resp, _ := g.do("POST", "/v1/enc", limitedToken, `{"query":"broken.io"}`)
+		assert.Equal(t, http.StatusBadGateway, resp.StatusCode, i)
+		assert.Equal(t, "1", resp.Header.Get("X-Credits-Used"), i)
+		assert.Equal(t, remaining, resp.Header.Get("X-Credits-Remaining"), i)
+	}
+	g.now = g.now.Add(time.Second)
+	resp, _ := g.do("POST", "/v1/enc", limitedToken, `{"query":"a.com"}`)
+	assert.Equal(t, http.StatusPaymentRequired, resp.StatusCode)
+}
+
+func TestGatewayEndpoints(t *testing.T) {
+	g := newGatewayTest(t, testConfig)
+
+	resp, body := g.do("GET", "/healthz", "", "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "ok", body["status"])
+
+	req, _ := http.NewRequest("GET", g.gateway.URL+"/v1/operations", nil)
+	req.Header.Set("Authorization", "Bearer "+teamToken)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	var ops []operationInfo
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&ops))
+	resp.Body.Close()
+	require.Len(t, ops, len(cufinder.Operations))
+	assert.Equal(t, operationInfo{Name: "CUF", Path: "/v1/cuf", Description: "Get company domain from company name", Required: []string{"company_name", "country_code"}}, ops[0])
+
+	g.do("POST", "/v1/enc", teamToken, `{"query":"acme.com"}`)
+	g.do("POST", "/v1/enc", teamToken, `{"query":"acme.com"}`)
+	resp, err = http.Get(g.gateway.URL + "/metrics")
+	require.NoError(t, err)
+	data, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	metrics := string(data)
+	assert.Contains(t, metrics, "# TYPE cufinder_gateway_requests_total counter\n")
+	assert.Contains(t, metrics, `cufinder_gateway_requests_total{operation="ENC",status="200"} 2`)
+	assert.Contains(t, metrics, `cufinder_gateway_cache_hits_total{operation="ENC"} 1`)
+	assert.Contains(t, metrics, `cufinder_gateway_credits_used_total{client="team"} 2`)
+	assert.Contains(t, metrics, "cufinder_gateway_cache_entries 1\n")
+}
+
+func TestConfig(t *testing.T) {
+	cfg, err := parseConfig([]byte(testConfig))
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, cfg.CacheTTL)
+	assert.Equal(t, 10000, cfg.CacheSize)
+	assert.Equal(t, 3, cfg.Clients[1].Budget)
+
+	invalid := []string{
+		`clients: []`,
+		`clients: [{token: team-token-0123456789}]`,
+		`clients: [{name: a, token: short}]`,
+		`clients: [{name: a, token: team-token-0123456789}, {name: a, token: limited-token-0123456789}]`,
+		`clients: [{name: a, token: team-token-0123456789}, {name: b, token: team-token-0123456789}]`,
+		`clients: [{name: a, token: team-token-0123456789, budget: -1}]`,
+		`cache_ttl: soon`,
+	}
+	for _, data := range invalid {
+		_, err := parseConfig([]byte(data))
+		assert.Error(t, err, data)
+	}
+}
+
+func TestCacheEviction(t *testing.T) {
+	now := time.Now()
+	c := newCache(time.Minute, 2)
+	c.put("a", []byte("1"), now)
+	c.put("b", []byte("2"), now)
+	_, ok := c.get("a", now)
+	assert.True(t, ok)
+	c.put("c", []byte("3"), now)
+
+	_, ok = c.get("b", now)
+	assert.False(t, ok, "least recently used entry is evicted")
+	_, ok = c.get("a", now.Add(2*time.Minute))
+	assert.False(t, ok, "expired entry is dropped")
+	assert.Equal(t, 1, c.len())
+}
@@ -0,0 +1,109 @@
+// Command cufinder-mcp serves CUFinder as a Model Context Protocol server,
+// so LLM agents can call the CUFinder API as tools.
+//
+// Every operation is a tool named cufinder_{operation}, as in cufinder_cuf
+// or cufinder_enc, with an input schema derived from its Params type and
+// an output schema from its Response type. Results are returned as
+// structured content, with the JSON also as text. The credit_budget tool
+// reports what the session has spent.
+//
+// By default the server speaks newline-delimited JSON-RPC on stdin and
+// stdout, for agents that start it as a subprocess:
+//
+//	{"mcpServers": {"cufinder": {"command": "cufinder-mcp", "args": ["-budget", "200"]}}}
+//
+// With -http it serves the Streamable HTTP transport at POST /mcp instead.
+// Each initialize request starts a session, named by the Mcp-Session-Id
+// response header; DELETE /mcp ends it. Callers authenticate with the
+// bearer token from CUFINDER_MCP_TOKEN, at least 16 characters, which the
+// HTTP transport requires:
+//
+//	curl -H "Authorization: Bearer $CUFINDER_MCP_TOKEN" -d @initialize.json localhost:8080/mcp
+//
+// Sessions unused for -session-idle expire, and initialize is refused with
+// 503 while -max-sessions sessions are open.
+//
+// -budget limits the credits each session may spend. Failed API calls
+// count as one credit, since the API may bill them. Once spent, tool calls
+// fail with an error result; the call that crosses the limit is still
+// served.
+//
+// The CUFinder API key is read from CUFINDER_API_KEY. Logs go to stderr.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+func main() {
+	httpAddr := flag.String("http", "", "serve the HTTP transport on this address instead of stdio")
+	budget := flag.Int("budget", 0, "credits each session may spend, 0 for no limit")
+	baseURL := flag.String("base-url", "", "CUFinder API base URL")
+	idleTimeout := flag.Duration("session-idle", 30*time.Minute, "end HTTP sessions unused for this long")
+	maxSessions := flag.Int("max-sessions", 1000, "maximum number of open HTTP sessions")
+	flag.Parse()
+
+	logger := slog.New(slog.NewJSONHandler(os.Stderr, nil))
+	opts := httpOptions{token: os.Getenv("CUFINDER_MCP_TOKEN"), idleTimeout: *idleTimeout, maxSessions: *maxSessions}
+	if err := run(*httpAddr, *budget, *baseURL, opts, logger); err != nil {
+		logger.Error("mcp server stopped", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run(httpAddr string, budget int, baseURL string, opts httpOptions, logger *slog.Logger) error {
+	apiKey := os.Getenv("CUFINDER_API_KEY")
+	if apiKey == "" {
+		return fmt.Errorf("CUFINDER_API_KEY is not set")
+	}
+	if budget < 0 {
+		return fmt.Errorf("budget must not be negative")
+	}
+	if httpAddr != "" {
+		switch {
+		case len(opts.token) < 16:
+			return fmt.Errorf("CUFINDER_MCP_TOKEN must be set to at least 16 characters for -http")
+		case opts.idleTimeout <= 0:
+			return fmt.Errorf("session-idle must be positive")
+		case opts.maxSessions <= 0:
+			return fmt.Errorf("max-sessions must be positive")
+		}
+	}
+
+	client := cufinder.NewClient(cufinder.ClientConfig{APIKey: apiKey, BaseURL: baseURL})
+	s := newServer(cufinder.NewService(client), budget, logger)
+	if httpAddr == "" {
+		return s.serveStdio(os.Stdin, os.Stdout)
+	}
+
+	srv := &http.Server{
+		Addr:              httpAddr,
+		Handler:           newHTTPTransport(s, opts).routes(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdown, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		srv.Shutdown(shutdown)
+	}()
+
+	logger.Info("mcp server listening", "addr", httpAddr, "budget", budget, "max_sessions", opts.maxSessions)
+	if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
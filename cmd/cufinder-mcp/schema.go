@@ -0,0 +1,101 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+// schema is a JSON Schema object
+type schema map[string]interface{}
+
+var optionalType = reflect.TypeOf(cufinder.Optional[int]{})
+
+// inputSchema describes the arguments of an operation, from its Params type
+func inputSchema(op cufinder.Operation) schema {
+	s := typeSchema(op.ParamsType(), map[reflect.Type]bool{})
+	if required := op.Required(); len(required) > 0 {
+		s["required"] = required
+	}
+	s["additionalProperties"] = false
+	return s
+}
+
+// outputSchema describes the structured result of an operation
+func outputSchema(op cufinder.Operation) schema {
+	return typeSchema(op.ResponseType(), map[reflect.Type]bool{})
+}
+
+// typeSchema follows encoding/json: fields are named by their json tag,
+// json:"-" fields are left out and embedded structs are inlined. seen stops
+// recursive types, which are described as any value.
+func typeSchema(t reflect.Type, seen map[reflect.Type]bool) schema {
+	if t.Kind() == reflect.Pointer {
+		return nullable(typeSchema(t.Elem(), seen))
+	}
+	if t.Kind() == reflect.Struct && t.PkgPath() == optionalType.PkgPath() && strings.HasPrefix(t.Name(), "Optional[") {
+		value, _ := t.MethodByName("Value")
+		return typeSchema(value.Type.Out(0), seen)
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return schema{"type": "string"}
+	case reflect.Bool:
+		return schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return schema{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return schema{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return schema{"type": "string", "contentEncoding": "base64"}
+		}
+		return nullable(schema{"type": "array", "items": typeSchema(t.Elem(), seen)})
+	case reflect.Map:
+		return nullable(schema{"type": "object", "additionalProperties": typeSchema(t.Elem(), seen)})
+	case reflect.Struct:
+		if seen[t] {
+			return schema{}
+		}
+		seen[t] = true
+		defer delete(seen, t)
+
+		properties := schema{}
+		structFields(t, properties, seen)
+		return schema{"type": "object", "properties": properties}
+	}
+	return schema{}
+}
+
+// nullable allows null, which encoding/json writes for nil pointers, slices
+// and maps
+func nullable(s schema) schema {
+	if typ, ok := s["type"].(string); ok {
+		s["type"] = []string{typ, "null"}
+	}
+	return s
+}
+
+func structFields(t reflect.Type, properties schema, seen map[reflect.Type]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if tag == "-" {
+			continue
+		}
+		if field.Anonymous && tag == "" && field.Type.Kind() == reflect.Struct {
+			structFields(field.Type, properties, seen)
+			continue
+		}
+		if tag == "" {
+			tag = field.Name
+		}
+		properties[tag] = typeSchema(field.Type, seen)
+	}
+}
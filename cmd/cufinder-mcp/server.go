@@ -0,0 +1,311 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+// protocolVersion is the newest MCP revision the server speaks. Older
+// clients get the revision they ask for; the tools work the same way.
+const protocolVersion = "2025-06-18"
+
+var supportedVersions = []string{"2025-06-18", "2025-03-26", "2024-11-05"}
+
+// JSON-RPC 2.0 error codes
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+)
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// notification reports whether the request expects no response
+func (r *request) notification() bool {
+	return len(r.ID) == 0
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return e.Message
+}
+
+// tool is an MCP tool definition
+type tool struct {
+	Name         string `json:"name"`
+	Title        string `json:"title,omitempty"`
+	Description  string `json:"description"`
+	InputSchema  schema `json:"inputSchema"`
+	OutputSchema schema `json:"outputSchema,omitempty"`
+}
+
+type content struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type toolResult struct {
+	Content           []content              `json:"content"`
+	StructuredContent interface{}            `json:"structuredContent,omitempty"`
+	IsError           bool                   `json:"isError,omitempty"`
+	Meta              map[string]interface{} `json:"_meta,omitempty"`
+}
+
+// budgetTool reports the session's credit budget
+const budgetTool = "credit_budget"
+
+// server answers MCP requests with CUFinder operations as tools
+type server struct {
+	service *cufinder.Service
+	budget  int
+	logger  *slog.Logger
+	tools   []tool
+}
+
+// session is the state of one MCP connection. Credits are counted per
+// session; budget 0 means no limit.
+type session struct {
+	mu          sync.Mutex
+	id          string
+	version     string
+	initialized bool
+	budget      int
+	spent       int
+}
+
+type budgetStatus struct {
+	Limit     int  `json:"limit"`
+	Spent     int  `json:"spent"`
+	Remaining *int `json:"remaining"`
+}
+
+func newServer(service *cufinder.Service, budget int, logger *slog.Logger) *server {
+	s := &server{service: service, budget: budget, logger: logger}
+	for _, op := range cufinder.Operations {
+		s.tools = append(s.tools, tool{
+			Name:         toolName(op),
+			Title:        op.Name,
+			Description:  fmt.Sprintf("%s (CUFinder %s). Costs API credits.", op.Description, op.Name),
+			InputSchema:  inputSchema(op),
+			OutputSchema: outputSchema(op),
+		})
+	}
+	s.tools = append(s.tools, tool{
+		Name:        budgetTool,
+		Title:       "Credit budget",
+		Description: "Report the credits spent in this session and how many are left. Free.",
+		InputSchema: schema{"type": "object", "properties": schema{}, "additionalProperties": false},
+		OutputSchema: schema{"type": "object", "properties": schema{
+			"limit":     schema{"type": "integer"},
+			"spent":     schema{"type": "integer"},
+			"remaining": schema{"type": []string{"integer", "null"}},
+		}},
+	})
+	return s
+}
+
+func (s *server) newSession(id string) *session {
+	return &session{id: id, budget: s.budget}
+}
+
+func toolName(op cufinder.Operation) string {
+	return "cufinder_" + strings.ToLower(op.Name)
+}
+
+func lookupTool(name string) (cufinder.Operation, bool) {
+	code, ok := strings.CutPrefix(name, "cufinder_")
+	if !ok {
+		return cufinder.Operation{}, false
+	}
+	return cufinder.LookupOperation(code)
+}
+
+// handle answers one JSON-RPC message. It returns nil for notifications.
+func (s *server) handle(sess *session, data []byte) *response {
+	var req request
+	if err := json.Unmarshal(data, &req); err != nil {
+		return &response{JSONRPC: "2.0", ID: json.RawMessage("null"), Error: &rpcError{codeParseError, "parse error: " + err.Error()}}
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		if req.notification() {
+			req.ID = json.RawMessage("null")
+		}
+		return &response{JSONRPC: "2.0", ID: req.ID, Error: &rpcError{codeInvalidRequest, "invalid JSON-RPC 2.0 request"}}
+	}
+
+	result, err := s.dispatch(sess, &req)
+	if req.notification() {
+		return nil
+	}
+	resp := &response{JSONRPC: "2.0", ID: req.ID, Result: result}
+	if err != nil {
+		var rpcErr *rpcError
+		if !errors.As(err, &rpcErr) {
+			rpcErr = &rpcError{codeInvalidParams, err.Error()}
+		}
+		resp.Result, resp.Error = nil, rpcErr
+	}
+	return resp
+}
+
+func (s *server) dispatch(sess *session, req *request) (interface{}, error) {
+	switch req.Method {
+	case "initialize":
+		return s.initialize(sess, req.Params)
+	case "notifications/initialized", "notifications/cancelled":
+		return nil, nil
+	case "ping":
+		return struct{}{}, nil
+	}
+
+	sess.mu.Lock()
+	initialized := sess.initialized
+	sess.mu.Unlock()
+	if !initialized {
+		return nil, &rpcError{codeInvalidRequest, "session is not initialized"}
+	}
+
+	switch req.Method {
+	case "tools/list":
+		return map[string]interface{}{"tools": s.tools}, nil
+	case "tools/call":
+		return s.callTool(sess, req.Params)
+	}
+	return nil, &rpcError{codeMethodNotFound, "method not found: " + req.Method}
+}
+
+func (s *server) initialize(sess *session, params json.RawMessage) (interface{}, error) {
+	var p struct {
+		ProtocolVersion string `json:"protocolVersion"`
+	}
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, fmt.Errorf("invalid initialize params: %w", err)
+	}
+	version := protocolVersion
+	for _, v := range supportedVersions {
+		if v == p.ProtocolVersion {
+			version = v
+		}
+	}
+
+	sess.mu.Lock()
+	sess.version, sess.initialized = version, true
+	sess.mu.Unlock()
+
+	instructions := "Each cufinder_* tool calls one CUFinder API endpoint and spends credits."
+	if s.budget > 0 {
+		instructions += fmt.Sprintf(" This session may spend %d credits; check credit_budget before large batches.", s.budget)
+	}
+	return map[string]interface{}{
+		"protocolVersion": version,
+		"capabilities":    map[string]interface{}{"tools": map[string]interface{}{}},
+		"serverInfo":      map[string]string{"name": "cufinder", "version": cufinder.Version},
+		"instructions":    instructions,
+	}, nil
+}
+
+func (s *server) callTool(sess *session, params json.RawMessage) (interface{}, error) {
+	var p struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	}
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, fmt.Errorf("invalid tools/call params: %w", err)
+	}
+	if p.Name == budgetTool {
+		status := sess.status()
+		return structured(status, nil), nil
+	}
+	op, ok := lookupTool(p.Name)
+	if !ok {
+		return nil, fmt.Errorf("unknown tool: %s", p.Name)
+	}
+
+	args := op.Params()
+	if len(p.Arguments) > 0 {
+		dec := json.NewDecoder(bytes.NewReader(p.Arguments))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(args); err != nil {
+			return nil, fmt.Errorf("invalid arguments for %s: %w", p.Name, err)
+		}
+	}
+	if missing := op.Missing(args); len(missing) > 0 {
+		return nil, fmt.Errorf("invalid arguments for %s: %s required", p.Name, strings.Join(missing, ", "))
+	}
+
+	// Calls are serialized per session so the budget cannot be overshot
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	if sess.budget > 0 && sess.spent >= sess.budget {
+		return errorResult(fmt.Sprintf("credit budget exhausted: %d of %d credits spent in this session", sess.spent, sess.budget)), nil
+	}
+
+	result, err := op.Call(s.service, args)
+	// Failed calls are charged too, as the API may bill them
+	credits := cufinder.CallCredits(result, err)
+	sess.spent += credits
+	if err != nil {
+		s.logger.Warn("tool call failed", "session", sess.id, "tool", p.Name, "credits", credits, "error", err)
+		return errorResult(err.Error()), nil
+	}
+	s.logger.Info("tool call", "session", sess.id, "tool", p.Name, "credits", credits, "spent", sess.spent)
+
+	meta := map[string]interface{}{"cufinder/credits_used": credits}
+	if sess.budget > 0 {
+		meta["cufinder/credits_remaining"] = max(0, sess.budget-sess.spent)
+	}
+	return structured(result, meta), nil
+}
+
+func (sess *session) status() budgetStatus {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	status := budgetStatus{Limit: sess.budget, Spent: sess.spent}
+	if sess.budget > 0 {
+		remaining := max(0, sess.budget-sess.spent)
+		status.Remaining = &remaining
+	}
+	return status
+}
+
+// structured returns v as structured content, with its JSON as text for
+// clients that do not read structured content
+func structured(v interface{}, meta map[string]interface{}) *toolResult {
+	text, err := json.Marshal(v)
+	if err != nil {
+		return errorResult("failed to encode result: " + err.Error())
+	}
+	return &toolResult{
+		Content:           []content{{Type: "text", Text: string(text)}},
+		StructuredContent: v,
+		Meta:              meta,
+	}
+}
+
+func errorResult(message string) *toolResult {
+	return &toolResult{Content: []content{{Type: "text", Text: message}}, IsError: true}
+}
@@ -0,0 +1,355 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cufinder/cufinder-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestServer returns a server backed by a fake CUFinder API that charges
+// two credits per call
+func newTestServer(t *testing.T, budget int) (*server, *atomic.Int64) {
+	var calls atomic.Int64
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		require.NoError(t, r.ParseForm())
+		body := map[string]interface{}{"credit_count": 2}
+		switch r.URL.Path {
+		case "/enc":
+			if r.PostForm.Get("query") == "broken.io" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			body["company"] = map[string]interface{}{"name": "Acme", "domain": r.PostForm.Get("query")}
+		case "/cuf":
+			body["domain"] = "acme.com"
+		}
+		json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(api.Close)
+
+	client := cufinder.NewClient(cufinder.ClientConfig{APIKey: "api-key", BaseURL: api.URL})
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return newServer(cufinder.NewService(client), budget, logger), &calls
+}
+
+const initialize = `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-06-18","capabilities":{},"clientInfo":{"name":"test","version":"1"}}}`
+
+func callTool(id int, name, args string) string {
+	return fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":"tools/call","params":{"name":%q,"arguments":%s}}`, id, name, args)
+}
+
+// rpc sends one message to a session and decodes the response
+func rpc(t *testing.T, s *server, sess *session, message string) map[string]interface{} {
+	resp := s.handle(sess, []byte(message))
+	require.NotNil(t, resp)
+	data, err := json.Marshal(resp)
+	require.NoError(t, err)
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	return decoded
+}
+
+func result(t *testing.T, resp map[string]interface{}) map[string]interface{} {
+	require.Nil(t, resp["error"], "unexpected error")
+	return resp["result"].(map[string]interface{})
+}
+
+func TestInitializeAndList(t *testing.T) {
+	s, _ := newTestServer(t, 0)
+	sess := s.newSession("test")
+
+	resp := rpc(t, s, sess, `{"jsonrpc":"2.0","id":1,"method":"tools/list"}`)
+	assert.Equal(t, float64(codeInvalidRequest), resp["error"].(map[string]interface{})["code"])
+
+	init := result(t, rpc(t, s, sess, initialize))
+	assert.Equal(t, "2025-06-18", init["protocolVersion"])
+	assert.Equal(t, "cufinder", init["serverInfo"].(map[string]interface{})["name"])
+	assert.Nil(t, s.handle(sess, []byte(`{"jsonrpc":"2.0","method":"notifications/initialized"}`)))
+
+	older := s.newSession("older")
+	init = result(t, rpc(t, s, older, `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05"}}`))
+	assert.Equal(t, "2024-11-05", init["protocolVersion"])
+
+	tools := result(t, rpc(t, s, sess, `{"jsonrpc":"2.0","id":2,"method":"tools/list"}`))["tools"].([]interface{})
+	require.Len(t, tools, len(cufinder.Operations)+1)
+	cuf := tools[0].(map[string]interface{})
+	assert.Equal(t, "cufinder_cuf", cuf["name"])
+	assert.Equal(t, "CUF", cuf["title"])
+	input := cuf["inputSchema"].(map[string]interface{})
+	assert.Equal(t, []interface{}{"company_name", "country_code"}, input["required"])
+	assert.Equal(t, map[string]interface{}{"type": "string"}, input["properties"].(map[string]interface{})["company_name"])
+	assert.Equal(t, "credit_budget", tools[len(tools)-1].(map[string]interface{})["name"])
+
+	resp = rpc(t, s, sess, `{"jsonrpc":"2.0","id":3,"method":"resources/list"}`)
+	assert.Equal(t, float64(codeMethodNotFound), resp["error"].(map[string]interface{})["code"])
+	resp = rpc(t, s, sess, `{"jsonrpc":"2.0","id":4,"method":`)
+	assert.Equal(t, float64(codeParseError), resp["error"].(map[string]interface{})["code"])
+}
+
+func TestSchema(t *testing.T) {
+	cse, _ := cufinder.LookupOperation("CSE")
+	properties := inputSchema(cse)["properties"].(schema)
+	assert.Equal(t, schema{"type": "integer"}, properties["followers_count_min"])
+	assert.Equal(t, schema{"type": []string{"array", "null"}, "items": schema{"type": "string"}}, properties["products_services"])
+	assert.Equal(t, schema{"type": "boolean"}, properties["is_school"])
+	assert.NotContains(t, inputSchema(cse), "required")
+
+	rel, _ := cufinder.LookupOperation("REL")
+	output := outputSchema(rel)["properties"].(schema)
+	assert.Contains(t, output, "credit_count")
+	assert.Contains(t, output, "meta_data")
+	assert.NotContains(t, output, "EmailClass")
+
+	// Every operation has a schema that encodes
+	for _, op := range cufinder.Operations {
+		_, err := json.Marshal(tool{InputSchema: inputSchema(op), OutputSchema: outputSchema(op)})
+		assert.NoError(t, err, op.Name)
+	}
+}
+
+func TestCallTool(t *testing.T) {
+	s, calls := newTestServer(t, 0)
+	sess := s.newSession("test")
+	result(t, rpc(t, s, sess, initialize))
+
+	res := result(t, rpc(t, s, sess, callTool(2, "cufinder_enc", `{"query":"acme.com"}`)))
+	assert.Nil(t, res["isError"])
+	company := res["structuredContent"].(map[string]interface{})["company"].(map[string]interface{})
+	assert.Equal(t, "Acme", company["name"])
+	text := res["content"].([]interface{})[0].(map[string]interface{})["text"].(string)
+	var decoded cufinder.EncResponse
+	require.NoError(t, json.Unmarshal([]byte(text), &decoded))
+	assert.Equal(t, "acme.com", decoded.Company.Domain)
+	assert.Equal(t, float64(2), res["_meta"].(map[string]interface{})["cufinder/credits_used"])
+
+	// Tool failures are results the model can read, not protocol errors
+	res = result(t, rpc(t, s, sess, callTool(3, "cufinder_enc", `{"query":"broken.io"}`)))
+	assert.Equal(t, true, res["isError"])
+	res = result(t, rpc(t, s, sess, callTool(4, "cufinder_cuf", `{"company_name":"Acme","country_code":"Narnia"}`)))
+	assert.Equal(t, true, res["isError"])
+	assert.Equal(t, int64(2), calls.Load())
+
+	invalid := []string{
+		callTool(5, "cufinder_nope", `{}`),
+		callTool(6, "enc", `{"query":"acme.com"}`),
+		callTool(7, "cufinder_enc", `{"q":"acme.com"}`),
+		callTool(8, "cufinder_cuf", `{"company_name":"Acme"}`),
+	}
+	for _, message := range invalid {
+		resp := rpc(t, s, sess, message)
+		require.NotNil(t, resp["error"], message)
+		assert.Equal(t, float64(codeInvalidParams), resp["error"].(map[string]interface{})["code"], message)
+	}
+	assert.Equal(t, int64(2), calls.Load())
+}
+
+func TestBudget(t *testing.T) {
+	s, calls := newTestServer(t, 3)
+	sess := s.newSession("test")
+	result(t, rpc(t, s, sess, initialize))
+
+	res := result(t, rpc(t, s, sess, callTool(2, "cufinder_enc", `{"query":"a.com"}`)))
+	assert.Equal(t, float64(1), res["_meta"].(map[string]interface{})["cufinder/credits_remaining"])
+	res = result(t, rpc(t, s, sess, callTool(3, "cufinder_enc", `{"query":"b.com"}`)))
+	assert.Nil(t, res["isError"])
+
+	res = result(t, rpc(t, s, sess, callTool(4, "cufinder_enc", `{"query":"c.com"}`)))
+	assert.Equal(t, true, res["isError"])
+	assert.Contains(t, res["content"].([]interface{})[0].(map[string]interface{})["text"], "credit budget exhausted")
+	assert.Equal(t, int64(2), calls.Load())
+
+	status := result(t, rpc(t, s, sess, callTool(5, "credit_budget", `{}`)))["structuredContent"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"limit": float64(3), "spent": float64(4), "remaining": float64(0)}, status)
+
+	// Budgets are per session
+	other := s.newSession("other")
+	result(t, rpc(t, s, other, initialize))
+	res = result(t, rpc(t, s, other, callTool(2, "cufinder_enc", `{"query":"c.com"}`)))
+	assert.Nil(t, res["isError"])
+
+	// Failed calls are charged, so they cannot get around the budget
+	failing := s.newSession("failing")
+	result(t, rpc(t, s, failing, initialize))
+	for i := 0; i < 3; i++ {
+		res = result(t, rpc(t, s, failing, callTool(2+i, "cufinder_enc", `{"query":"broken.io"}`)))
+		assert.Equal(t, true, res["isError"])
+	}
+	res = result(t, rpc(t, s, failing, callTool(5, "cufinder_enc", `{"query":"broken.io"}`)))
+	assert.Contains(t, res["content"].([]interface{})[0].(map[string]interface{})["text"], "credit budget exhausted")
+	assert.Equal(t, 3, failing.status().Spent)
+}
+
+func TestStdio(t *testing.T) {
+	s, _ := newTestServer(t, 0)
+	in := strings.Join([]string{
+		initialize,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		"",
+		callTool(2, "cufinder_cuf", `{"company_name":"Acme","country_code":"US"}`),
+	}, "\n")
+	var out bytes.Buffer
+	require.NoError(t, s.serveStdio(strings.NewReader(in), &out))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+	var resp response
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &resp))
+	assert.JSONEq(t, "2", string(resp.ID))
+	assert.Contains(t, lines[1], `"structuredContent":{"credit_count":2,"domain":"acme.com"}`)
+}
+
+const testToken = "0123456789abcdef"
+
+func newTestTransport(t *testing.T, opts httpOptions) (*httpTransport, *httptest.Server) {
+	s, _ := newTestServer(t, 0)
+	opts.token = testToken
+	if opts.idleTimeout == 0 {
+		opts.idleTimeout = time.Hour
+	}
+	if opts.maxSessions == 0 {
+		opts.maxSessions = 10
+	}
+	transport := newHTTPTransport(s, opts)
+	srv := httptest.NewServer(transport.routes())
+	t.Cleanup(srv.Close)
+	return transport, srv
+}
+
+// postMCP posts a message with the test token and the session header
+func postMCP(t *testing.T, url, session, body string) (*http.Response, map[string]interface{}) {
+	req, err := http.NewRequest("POST", url+"/mcp", strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json, text/event-stream")
+	if session != "" {
+		req.Header.Set("Mcp-Session-Id", session)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var decoded map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&decoded)
+	return resp, decoded
+}
+
+func TestHTTP(t *testing.T) {
+	_, srv := newTestTransport(t, httpOptions{})
+	post := func(session, body string) (*http.Response, map[string]interface{}) {
+		return postMCP(t, srv.URL, session, body)
+	}
+
+	resp, body := post("", initialize)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	session := resp.Header.Get("Mcp-Session-Id")
+	require.NotEmpty(t, session)
+	assert.Equal(t, "2025-06-18", result(t, body)["protocolVersion"])
+
+	resp, _ = post(session, `{"jsonrpc":"2.0","method":"notifications/initialized"}`)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	resp, body = post(session, callTool(2, "cufinder_enc", `{"query":"acme.com"}`))
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Nil(t, result(t, body)["isError"])
+
+	resp, _ = post("", callTool(3, "cufinder_enc", `{"query":"acme.com"}`))
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp, _ = post("unknown", callTool(3, "cufinder_enc", `{"query":"acme.com"}`))
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	req, _ := http.NewRequest("GET", srv.URL+"/mcp", nil)
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+
+	req, _ = http.NewRequest("DELETE", srv.URL+"/mcp", nil)
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	req.Header.Set("Mcp-Session-Id", session)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	resp, _ = post(session, callTool(4, "cufinder_enc", `{"query":"acme.com"}`))
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestHTTPAuth(t *testing.T) {
+	transport, srv := newTestTransport(t, httpOptions{})
+
+	for name, header := range map[string]string{
+		"Missing": "",
+		"Wrong":   "Bearer fedcba9876543210",
+		"Scheme":  "Basic " + testToken,
+	} {
+		t.Run(name, func(t *testing.T) {
+			req, _ := http.NewRequest("POST", srv.URL+"/mcp", strings.NewReader(initialize))
+			if header != "" {
+				req.Header.Set("Authorization", header)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			resp.Body.Close()
+			assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+			assert.Empty(t, resp.Header.Get("Mcp-Session-Id"))
+		})
+	}
+	assert.Empty(t, transport.sessions, "no session before authentication")
+
+	resp, err := http.Get(srv.URL + "/healthz")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestHTTPSessionLimits(t *testing.T) {
+	now := time.Now()
+	transport, srv := newTestTransport(t, httpOptions{idleTimeout: time.Minute, maxSessions: 2})
+	transport.now = func() time.Time { return now }
+
+	open := func() string {
+		resp, _ := postMCP(t, srv.URL, "", initialize)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		return resp.Header.Get("Mcp-Session-Id")
+	}
+	first, second := open(), open()
+
+	resp, _ := postMCP(t, srv.URL, "", initialize)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Len(t, transport.sessions, 2)
+
+	// Using a session keeps it alive
+	now = now.Add(40 * time.Second)
+	resp, _ = postMCP(t, srv.URL, first, `{"jsonrpc":"2.0","method":"notifications/initialized"}`)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	now = now.Add(40 * time.Second)
+	resp, _ = postMCP(t, srv.URL, second, `{"jsonrpc":"2.0","method":"notifications/initialized"}`)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode, "idle session expired")
+
+	third := open()
+	assert.Len(t, transport.sessions, 2)
+	resp, _ = postMCP(t, srv.URL, first, `{"jsonrpc":"2.0","method":"notifications/initialized"}`)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	// Expired sessions are ended when a session is created
+	now = now.Add(2 * time.Minute)
+	open()
+	assert.Len(t, transport.sessions, 1)
+	assert.NotContains(t, transport.sessions, first)
+	assert.NotContains(t, transport.sessions, third)
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const maxMessageSize = 1 << 20
+
+// serveStdio runs one session over newline-delimited JSON-RPC messages,
+// until in is closed
+func (s *server) serveStdio(in io.Reader, out io.Writer) error {
+	sess := s.newSession("stdio")
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), maxMessageSize)
+	enc := json.NewEncoder(out)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+		if resp := s.handle(sess, line); resp != nil {
+			if err := enc.Encode(resp); err != nil {
+				return err
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+// httpOptions configures the HTTP transport
+type httpOptions struct {
+	// token is the bearer token callers must present
+	token string
+	// idleTimeout ends sessions that have not been used for this long
+	idleTimeout time.Duration
+	// maxSessions is the number of open sessions
+	maxSessions int
+}
+
+// errTooManySessions is returned when all sessions are in use
+var errTooManySessions = errors.New("too many sessions")
+
+// httpTransport serves the Streamable HTTP transport without server
+// initiated streams: every POST gets a single JSON response. Sessions are
+// created by initialize and named by the Mcp-Session-Id header. Every
+// request needs the bearer token, so sessions are only created for
+// authenticated callers; idle sessions expire and their number is capped.
+type httpTransport struct {
+	server   *server
+	opts     httpOptions
+	now      func() time.Time
+	mu       sync.Mutex
+	sessions map[string]*httpSession
+}
+
+type httpSession struct {
+	*session
+	lastUsed time.Time
+}
+
+func newHTTPTransport(s *server, opts httpOptions) *httpTransport {
+	return &httpTransport{server: s, opts: opts, now: time.Now, sessions: make(map[string]*httpSession)}
+}
+
+func (t *httpTransport) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mcp", t.handleMCP)
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"status":"ok"}`+"\n")
+	})
+	return mux
+}
+
+func (t *httpTransport) handleMCP(w http.ResponseWriter, r *http.Request) {
+	token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(t.opts.token)) != 1 {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="cufinder-mcp"`)
+		http.Error(w, "missing or invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	id := r.Header.Get("Mcp-Session-Id")
+	switch r.Method {
+	case http.MethodPost:
+	case http.MethodDelete:
+		if t.remove(id) {
+			w.WriteHeader(http.StatusNoContent)
+		} else {
+			http.Error(w, "unknown session", http.StatusNotFound)
+		}
+		return
+	default:
+		// No server initiated messages, so no SSE stream to open
+		w.Header().Set("Allow", "POST, DELETE")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxMessageSize))
+	if err != nil {
+		http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	var req request
+	initialize := json.Unmarshal(data, &req) == nil && req.Method == "initialize"
+	var sess *session
+	switch {
+	case initialize:
+		if sess, err = t.create(); errors.Is(err, errTooManySessions) {
+			w.Header().Set("Retry-After", "60")
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		} else if err != nil {
+			http.Error(w, "failed to create session", http.StatusInternalServerError)
+			return
+		}
+	case id == "":
+		http.Error(w, "missing Mcp-Session-Id header", http.StatusBadRequest)
+		return
+	default:
+		if sess = t.lookup(id); sess == nil {
+			http.Error(w, "unknown session", http.StatusNotFound)
+			return
+		}
+	}
+
+	resp := t.server.handle(sess, data)
+	if resp == nil {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+	if initialize && resp.Error != nil {
+		t.remove(sess.id)
+	} else {
+		w.Header().Set("Mcp-Session-Id", sess.id)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// create starts a session, after ending expired ones
+func (t *httpTransport) create() (*session, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	now := t.now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for id, sess := range t.sessions {
+		if t.expired(sess, now) {
+			delete(t.sessions, id)
+		}
+	}
+	if len(t.sessions) >= t.opts.maxSessions {
+		return nil, errTooManySessions
+	}
+	sess := &httpSession{session: t.server.newSession(hex.EncodeToString(b)), lastUsed: now}
+	t.sessions[sess.id] = sess
+	return sess.session, nil
+}
+
+// lookup returns a live session and marks it used
+func (t *httpTransport) lookup(id string) *session {
+	now := t.now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	sess := t.sessions[id]
+	if sess == nil {
+		return nil
+	}
+	if t.expired(sess, now) {
+		delete(t.sessions, id)
+		return nil
+	}
+	sess.lastUsed = now
+	return sess.session
+}
+
+func (t *httpTransport) expired(sess *httpSession, now time.Time) bool {
+	return now.Sub(sess.lastUsed) >= t.opts.idleTimeout
+}
+
+func (t *httpTransport) remove(id string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, ok := t.sessions[id]
+	delete(t.sessions, id)
+	return ok
+}
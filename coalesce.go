@@ -0,0 +1,95 @@
+package cufinder
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// CoalesceStats reports how many service calls shared an in-flight request
+type CoalesceStats struct {
+	// Requests is the number of calls that were sent to the API
+	Requests int64
+	// Coalesced is the number of calls answered by an identical request
+	// that was already in flight
+	Coalesced int64
+}
+
+// flightCall is an in-flight or completed request shared by its callers
+type flightCall struct {
+	wg       sync.WaitGroup
+	response map[string]interface{}
+	err      error
+}
+
+// flightGroup deduplicates concurrent requests with the same key
+type flightGroup struct {
+	mu        sync.Mutex
+	calls     map[string]*flightCall
+	requests  atomic.Int64
+	coalesced atomic.Int64
+}
+
+// do runs fn once for all concurrent callers with the same key. The returned
+// response is shared and must be treated as read-only.
+func (g *flightGroup) do(key string, fn func() (map[string]interface{}, error)) (map[string]interface{}, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall)
+	}
+	if c, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		g.coalesced.Add(1)
+		c.wg.Wait()
+		return c.response, c.err
+	}
+
+	c := &flightCall{}
+	c.wg.Add(1)
+	g.calls[key] = c
+	g.mu.Unlock()
+
+	g.requests.Add(1)
+	returned := false
+	defer func() {
+		// Release waiters and forget the key even if fn panics or calls
+		// runtime.Goexit. Waiters get an error; the panic goes on in this
+		// goroutine.
+		var recovered interface{}
+		if !returned {
+			c.err = errors.New("coalesced request exited")
+			if recovered = recover(); recovered != nil {
+				c.err = fmt.Errorf("coalesced request panicked: %v", recovered)
+			}
+		}
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		c.wg.Done()
+		if recovered != nil {
+			panic(recovered)
+		}
+	}()
+	c.response, c.err = fn()
+	returned = true
+
+	return c.response, c.err
+}
+
+// stats returns a snapshot of the group counters
+func (g *flightGroup) stats() CoalesceStats {
+	return CoalesceStats{
+		Requests:  g.requests.Load(),
+		Coalesced: g.coalesced.Load(),
+	}
+}
+
+// coalesceKey identifies a request by endpoint and canonical parameters
+func coalesceKey(endpoint string, params interface{}) (string, error) {
+	values, err := FormEncoder{RepeatSlices: true}.Values(params)
+	if err != nil {
+		return "", err
+	}
+	return endpoint + "?" + values.Encode(), nil
+}
@@ -0,0 +1,175 @@
+package cufinder
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoalescing(t *testing.T) {
+	const workers = 10
+
+	var hits atomic.Int64
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data":      map[string]interface{}{"company_name": "TechCorp Inc"},
+			"meta_data": map[string]interface{}{"source": "test"},
+		})
+	}))
+	defer server.Close()
+
+	newSDK := func(disable bool) *SDK {
+		return NewSDKWithConfig(ClientConfig{
+			APIKey:            "test-api-key",
+			BaseURL:           server.URL,
+			DisableCoalescing: disable,
+		})
+	}
+
+	run := func(sdk *SDK) []*DtcResponse {
+		results := make([]*DtcResponse, workers)
+		var wg sync.WaitGroup
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				// Differently formatted inputs share one canonical request
+				input := "techcorp.com"
+				if i%2 == 0 {
+					input = "https://www.techcorp.com/about"
+				}
+				result, err := sdk.DTC(input)
+				require.NoError(t, err)
+				results[i] = result
+			}(i)
+		}
+
+		// Wait until every worker is either in flight or coalesced
+		for {
+			stats := sdk.CoalesceStats()
+			if stats.Requests+stats.Coalesced == workers || hits.Load() == workers {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+		close(release)
+		wg.Wait()
+		return results
+	}
+
+	t.Run("Shares In-Flight Requests", func(t *testing.T) {
+		sdk := newSDK(false)
+		results := run(sdk)
+
+		assert.Equal(t, int64(1), hits.Load())
+		assert.Equal(t, CoalesceStats{Requests: 1, Coalesced: workers - 1}, sdk.CoalesceStats())
+		for _, result := range results {
+			assert.Equal(t, "TechCorp Inc", result.CompanyName)
+			assert.Equal(t, "test", result.MetaData["source"])
+		}
+	})
+
+	t.Run("Opt Out", func(t *testing.T) {
+		hits.Store(0)
+		release = make(chan struct{})
+		sdk := newSDK(true)
+		run(sdk)
+
+		assert.Equal(t, int64(workers), hits.Load())
+		assert.Equal(t, CoalesceStats{}, sdk.CoalesceStats())
+	})
+}
+
+func TestCoalescingPanic(t *testing.T) {
+	var g flightGroup
+	started, release := make(chan struct{}), make(chan struct{})
+
+	go func() {
+		defer func() { recover() }()
+		g.do("key", func() (map[string]interface{}, error) {
+			close(started)
+			<-release
+			panic("boom")
+		})
+	}()
+	<-started
+
+	waiter := make(chan error)
+	go func() {
+		_, err := g.do("key", func() (map[string]interface{}, error) {
+			t.Error("coalesced call must not run")
+			return nil, nil
+		})
+		waiter <- err
+	}()
+	for g.stats().Coalesced == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+
+	select {
+	case err := <-waiter:
+		assert.EqualError(t, err, "coalesced request panicked: boom")
+	case <-time.After(5 * time.Second):
+		t.Fatal("waiter blocked after a panic")
+	}
+
+	// The key is released, so later calls run again
+	response, err := g.do("key", func() (map[string]interface{}, error) {
+		return map[string]interface{}{"ok": true}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, response["ok"])
+
+	assert.Panics(t, func() {
+		g.do("other", func() (map[string]interface{}, error) { panic("again") })
+	})
+}
+
+// failingStore loads nothing and fails every save
+type failingStore struct{}
+
+func (failingStore) Load(string, interface{}, time.Duration) (map[string]interface{}, bool, error) {
+	return nil, false, nil
+}
+
+func (failingStore) Save(string, interface{}, map[string]interface{}) error {
+	return errors.New("disk full")
+}
+
+func TestStoreSaveError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"company_name": "TechCorp Inc", "credit_count": 1})
+	}))
+	defer server.Close()
+
+	var reported []string
+	sdk := NewSDKWithConfig(ClientConfig{
+		APIKey:      "test-api-key",
+		BaseURL:     server.URL,
+		Store:       failingStore{},
+		StoreMaxAge: time.Hour,
+		OnStoreError: func(endpoint string, err error) {
+			reported = append(reported, endpoint+": "+err.Error())
+		},
+	})
+
+	// The paid response is returned even though it was not saved
+	result, err := sdk.DTC("techcorp.com")
+	require.NoError(t, err)
+	assert.Equal(t, "TechCorp Inc", result.CompanyName)
+	assert.Equal(t, []string{"/dtc: failed to save response: disk full"}, reported)
+}
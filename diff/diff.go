@@ -0,0 +1,160 @@
+// Package diff compares two snapshots of a CUFinder response and reports what
+// changed, both as raw field changes and as typed events such as JobChanged,
+// NewFundingRound or TechAdded.
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+// ChangeKind describes how a field changed
+type ChangeKind string
+
+const (
+	Modified ChangeKind = "modified"
+	Added    ChangeKind = "added"
+	Removed  ChangeKind = "removed"
+)
+
+// Change is a single field difference between two snapshots. For list
+// fields each added or removed item is reported as its own change.
+type Change struct {
+	Path string
+	Kind ChangeKind
+	Old  interface{}
+	New  interface{}
+}
+
+var baseResponseType = reflect.TypeOf(cufinder.BaseResponse{})
+
+// Compare returns the field-by-field differences between two snapshots of the
+// same response type. Paths use the JSON field names, e.g. "person.job_title".
+// Lists are compared as sets. Request metadata from BaseResponse and fields
+// not sent by the API (tagged `json:"-"`) are ignored.
+func Compare(old, new interface{}) ([]Change, error) {
+	ov, nv := indirect(reflect.ValueOf(old)), indirect(reflect.ValueOf(new))
+	if ov.IsValid() && nv.IsValid() && ov.Type() != nv.Type() {
+		return nil, fmt.Errorf("cannot compare %s with %s", ov.Type(), nv.Type())
+	}
+
+	var changes []Change
+	compareValues("", ov, nv, &changes)
+	return changes, nil
+}
+
+// indirect follows pointers, returning an invalid value for nil
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func compareValues(path string, ov, nv reflect.Value, changes *[]Change) {
+	if !ov.IsValid() || !nv.IsValid() {
+		if ov.IsValid() != nv.IsValid() {
+			*changes = append(*changes, Change{Path: path, Kind: Modified, Old: valueOf(ov), New: valueOf(nv)})
+		}
+		return
+	}
+	// Interface fields may hold different types in the two snapshots
+	if ov.Type() != nv.Type() {
+		*changes = append(*changes, Change{Path: path, Kind: Modified, Old: valueOf(ov), New: valueOf(nv)})
+		return
+	}
+
+	switch ov.Kind() {
+	case reflect.Struct:
+		compareStructs(path, ov, nv, changes)
+	case reflect.Slice, reflect.Array:
+		compareSets(path, ov, nv, changes)
+	case reflect.Ptr, reflect.Interface:
+		compareValues(path, indirect(ov.Elem()), indirect(nv.Elem()), changes)
+	default:
+		if !reflect.DeepEqual(ov.Interface(), nv.Interface()) {
+			*changes = append(*changes, Change{Path: path, Kind: Modified, Old: ov.Interface(), New: nv.Interface()})
+		}
+	}
+}
+
+func compareStructs(path string, ov, nv reflect.Value, changes *[]Change) {
+	t := ov.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || field.Type == baseResponseType || field.Tag.Get("json") == "-" {
+			continue
+		}
+		compareValues(joinPath(path, fieldName(field)), ov.Field(i), nv.Field(i), changes)
+	}
+}
+
+// compareSets reports list items present in only one snapshot
+func compareSets(path string, ov, nv reflect.Value, changes *[]Change) {
+	oldItems, newItems := itemSet(ov), itemSet(nv)
+
+	for _, key := range sortedKeys(oldItems) {
+		if _, ok := newItems[key]; !ok {
+			*changes = append(*changes, Change{Path: path, Kind: Removed, Old: oldItems[key]})
+		}
+	}
+	for _, key := range sortedKeys(newItems) {
+		if _, ok := oldItems[key]; !ok {
+			*changes = append(*changes, Change{Path: path, Kind: Added, New: newItems[key]})
+		}
+	}
+}
+
+// itemSet keys list items by their JSON form so structs compare by value
+func itemSet(v reflect.Value) map[string]interface{} {
+	items := make(map[string]interface{}, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		item := v.Index(i).Interface()
+		key, err := json.Marshal(item)
+		if err != nil {
+			key = []byte(fmt.Sprintf("%#v", item))
+		}
+		items[string(key)] = item
+	}
+	return items
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func valueOf(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Interface()
+}
+
+// fieldName returns the JSON name of a field, or its lower-cased Go name
+func fieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return strings.ToLower(field.Name)
+	}
+	return name
+}
+
+func joinPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
@@ -0,0 +1,122 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+func TestCompare(t *testing.T) {
+	old := cufinder.EncResponse{
+		BaseResponse: cufinder.BaseResponse{CreditCount: 1},
+		Company:      cufinder.EncCompany{Name: "TechCorp", EmployeeCount: 120, City: "Austin"},
+	}
+	new := cufinder.EncResponse{
+		BaseResponse: cufinder.BaseResponse{CreditCount: 2},
+		Company:      cufinder.EncCompany{Name: "TechCorp", EmployeeCount: 180, City: "Austin"},
+	}
+
+	changes, err := Compare(&old, &new)
+	require.NoError(t, err)
+	assert.Equal(t, []Change{
+		{Path: "company.employee_count", Kind: Modified, Old: 120, New: 180},
+	}, changes)
+
+	_, err = Compare(old, cufinder.CarResponse{})
+	assert.Error(t, err)
+}
+
+func TestCompareDynamicTypes(t *testing.T) {
+	list := []interface{}{"Engineer at TechCorp"}
+	cases := []struct {
+		name     string
+		old, new any
+	}{
+		{"Slice To Map", list, map[string]interface{}{"title": "Engineer"}},
+		{"Slice To String", list, "Engineer at TechCorp"},
+		{"Map To Slice", map[string]interface{}{"title": "Engineer"}, list},
+		{"Nil To Slice", nil, list},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			changes, err := Compare(cufinder.Person{Experience: c.old}, cufinder.Person{Experience: c.new})
+			require.NoError(t, err)
+			assert.Equal(t, []Change{{Path: "experience", Kind: Modified, Old: c.old, New: c.new}}, changes)
+		})
+	}
+}
+
+func TestEvents(t *testing.T) {
+	t.Run("Job Changed", func(t *testing.T) {
+		old := cufinder.EppResponse{Person: cufinder.EppPerson{FullName: "John Doe", JobTitle: "Engineer", CompanyName: "TechCorp"}}
+		new := cufinder.EppResponse{Person: cufinder.EppPerson{FullName: "John Doe", JobTitle: "CTO", CompanyName: "DataCorp"}}
+
+		events, err := Events(old, new)
+		require.NoError(t, err)
+		assert.Equal(t, []Event{
+			JobChanged{OldTitle: "Engineer", NewTitle: "CTO", OldCompany: "TechCorp", NewCompany: "DataCorp"},
+		}, events)
+	})
+
+	t.Run("New Funding Round", func(t *testing.T) {
+		old := &cufinder.ElfResponse{Fundraising: cufinder.ElfFundraising{FundingLastRoundType: "Seed"}}
+		new := &cufinder.ElfResponse{Fundraising: cufinder.ElfFundraising{
+			FundingLastRoundType:       "Series A",
+			FundingMoneyRaised:         "12000000",
+			FundingAmmountCurrencyCode: "USD",
+		}}
+
+		events, err := Events(old, new)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "New funding round: Series A (12000000 USD)", events[0].String())
+	})
+
+	t.Run("Set Differences", func(t *testing.T) {
+		old := cufinder.FtsResponse{Technologies: []string{"React", "Go", "MySQL"}}
+		new := cufinder.FtsResponse{Technologies: []string{"Go", "React", "PostgreSQL"}}
+
+		events, err := Events(old, new)
+		require.NoError(t, err)
+		assert.Equal(t, []Event{TechRemoved{Technology: "MySQL"}, TechAdded{Technology: "PostgreSQL"}}, events)
+
+		events, err = Events(
+			cufinder.DteResponse{Emails: []string{"info@techcorp.com"}},
+			cufinder.DteResponse{Emails: []string{"sales@techcorp.com", "info@techcorp.com"}},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []Event{EmailAdded{Email: "sales@techcorp.com"}}, events)
+	})
+
+	t.Run("Other Fields", func(t *testing.T) {
+		events, err := Events(
+			cufinder.CarResponse{Revenue: "$1M-$10M"},
+			cufinder.CarResponse{Revenue: "$10M-$50M"},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []Event{RevenueChanged{Old: "$1M-$10M", New: "$10M-$50M"}}, events)
+
+		events, err = Events(
+			cufinder.CloResponse{Locations: []cufinder.CloLocation{{City: "Austin"}}},
+			cufinder.CloResponse{Locations: []cufinder.CloLocation{{City: "Austin"}, {City: "Berlin"}}},
+		)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, EventFieldChanged, events[0].Type())
+	})
+}
+
+func TestReport(t *testing.T) {
+	report := Report("techcorp.com", []Event{
+		TechAdded{Technology: "Kubernetes"},
+		RevenueChanged{Old: "", New: "$10M-$50M"},
+	})
+	assert.Equal(t, "techcorp.com: 2 changes\n"+
+		"  - Technology added: Kubernetes\n"+
+		"  - Revenue changed: (none) -> $10M-$50M\n", report)
+
+	assert.Equal(t, "techcorp.com: no changes\n", Report("techcorp.com", nil))
+}
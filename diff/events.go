@@ -0,0 +1,308 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+// EventType names a kind of change event
+type EventType string
+
+const (
+	EventJobChanged        EventType = "job_changed"
+	EventNewFundingRound   EventType = "new_funding_round"
+	EventTechAdded         EventType = "tech_added"
+	EventTechRemoved       EventType = "tech_removed"
+	EventRevenueChanged    EventType = "revenue_changed"
+	EventSubsidiaryAdded   EventType = "subsidiary_added"
+	EventSubsidiaryRemoved EventType = "subsidiary_removed"
+	EventEmailAdded        EventType = "email_added"
+	EventEmailRemoved      EventType = "email_removed"
+	EventFieldChanged      EventType = "field_changed"
+)
+
+// Event is a typed, human-meaningful change between two snapshots
+type Event interface {
+	Type() EventType
+	String() string
+}
+
+// JobChanged reports a new title or employer from EPP or TEP
+type JobChanged struct {
+	OldTitle   string
+	NewTitle   string
+	OldCompany string
+	NewCompany string
+}
+
+// Type implements Event
+func (e JobChanged) Type() EventType { return EventJobChanged }
+
+func (e JobChanged) String() string {
+	return fmt.Sprintf("Job changed: %s -> %s", describeJob(e.OldTitle, e.OldCompany), describeJob(e.NewTitle, e.NewCompany))
+}
+
+// NewFundingRound reports a change of the latest round from ELF
+type NewFundingRound struct {
+	OldRoundType string
+	RoundType    string
+	MoneyRaised  string
+	Currency     string
+	InvestorsURL string
+}
+
+// Type implements Event
+func (e NewFundingRound) Type() EventType { return EventNewFundingRound }
+
+func (e NewFundingRound) String() string {
+	s := "New funding round"
+	if e.RoundType != "" {
+		s += ": " + e.RoundType
+	}
+	if e.MoneyRaised != "" {
+		s += strings.TrimRight(fmt.Sprintf(" (%s %s", e.MoneyRaised, e.Currency), " ") + ")"
+	}
+	return s
+}
+
+// TechAdded reports a technology newly detected by FTS
+type TechAdded struct {
+	Technology string
+}
+
+// Type implements Event
+func (e TechAdded) Type() EventType { return EventTechAdded }
+
+func (e TechAdded) String() string { return "Technology added: " + e.Technology }
+
+// TechRemoved reports a technology no longer detected by FTS
+type TechRemoved struct {
+	Technology string
+}
+
+// Type implements Event
+func (e TechRemoved) Type() EventType { return EventTechRemoved }
+
+func (e TechRemoved) String() string { return "Technology removed: " + e.Technology }
+
+// RevenueChanged reports a new annual revenue estimate from CAR
+type RevenueChanged struct {
+	Old string
+	New string
+}
+
+// Type implements Event
+func (e RevenueChanged) Type() EventType { return EventRevenueChanged }
+
+func (e RevenueChanged) String() string {
+	return fmt.Sprintf("Revenue changed: %s -> %s", orNone(e.Old), orNone(e.New))
+}
+
+// SubsidiaryAdded reports a new subsidiary from FCC
+type SubsidiaryAdded struct {
+	Subsidiary string
+}
+
+// Type implements Event
+func (e SubsidiaryAdded) Type() EventType { return EventSubsidiaryAdded }
+
+func (e SubsidiaryAdded) String() string { return "Subsidiary added: " + e.Subsidiary }
+
+// SubsidiaryRemoved reports a subsidiary no longer returned by FCC
+type SubsidiaryRemoved struct {
+	Subsidiary string
+}
+
+// Type implements Event
+func (e SubsidiaryRemoved) Type() EventType { return EventSubsidiaryRemoved }
+
+func (e SubsidiaryRemoved) String() string { return "Subsidiary removed: " + e.Subsidiary }
+
+// EmailAdded reports a new address from DTE
+type EmailAdded struct {
+	Email string
+}
+
+// Type implements Event
+func (e EmailAdded) Type() EventType { return EventEmailAdded }
+
+func (e EmailAdded) String() string { return "Email added: " + e.Email }
+
+// EmailRemoved reports an address no longer returned by DTE
+type EmailRemoved struct {
+	Email string
+}
+
+// Type implements Event
+func (e EmailRemoved) Type() EventType { return EventEmailRemoved }
+
+func (e EmailRemoved) String() string { return "Email removed: " + e.Email }
+
+// FieldChanged reports any other change
+type FieldChanged struct {
+	Change
+}
+
+// Type implements Event
+func (e FieldChanged) Type() EventType { return EventFieldChanged }
+
+func (e FieldChanged) String() string {
+	switch e.Kind {
+	case Added:
+		return fmt.Sprintf("%s: added %v", e.Path, e.New)
+	case Removed:
+		return fmt.Sprintf("%s: removed %v", e.Path, e.Old)
+	default:
+		return fmt.Sprintf("%s: %v -> %v", e.Path, orNone(e.Old), orNone(e.New))
+	}
+}
+
+// Events compares two snapshots of the same response type and interprets the
+// changes as typed events. Changes without a dedicated event are reported as
+// FieldChanged.
+func Events(old, new interface{}) ([]Event, error) {
+	changes, err := Compare(old, new)
+	if err != nil {
+		return nil, err
+	}
+
+	// A new round absorbs every other fundraising change, such as currency
+	newRound := false
+	for _, c := range changes {
+		parent, leaf := splitPath(c.Path)
+		if parent == "fundraising_info" && (leaf == "funding_last_round_type" || leaf == "funding_money_raised") && text(c.New) != "" {
+			newRound = true
+		}
+	}
+
+	var (
+		events      []Event
+		seenJob     bool
+		seenFunding bool
+	)
+
+	for _, c := range changes {
+		parent, leaf := splitPath(c.Path)
+		switch {
+		case leaf == "technologies" && c.Kind == Added:
+			events = append(events, TechAdded{Technology: text(c.New)})
+		case leaf == "technologies" && c.Kind == Removed:
+			events = append(events, TechRemoved{Technology: text(c.Old)})
+		case leaf == "subsidiaries" && c.Kind == Added:
+			events = append(events, SubsidiaryAdded{Subsidiary: text(c.New)})
+		case leaf == "subsidiaries" && c.Kind == Removed:
+			events = append(events, SubsidiaryRemoved{Subsidiary: text(c.Old)})
+		case leaf == "emails" && c.Kind == Added:
+			events = append(events, EmailAdded{Email: text(c.New)})
+		case leaf == "emails" && c.Kind == Removed:
+			events = append(events, EmailRemoved{Email: text(c.Old)})
+		case c.Path == "annual_revenue":
+			events = append(events, RevenueChanged{Old: text(c.Old), New: text(c.New)})
+		case parent == "person" && (leaf == "job_title" || leaf == "company_name"):
+			if !seenJob {
+				seenJob = true
+				events = append(events, jobChange(old, new))
+			}
+		case parent == "fundraising_info" && newRound:
+			if !seenFunding {
+				seenFunding = true
+				events = append(events, fundingRound(old, new))
+			}
+		default:
+			events = append(events, FieldChanged{Change: c})
+		}
+	}
+
+	return events, nil
+}
+
+// jobChange builds a JobChanged event from two person snapshots
+func jobChange(old, new interface{}) JobChanged {
+	oldTitle, oldCompany := currentJob(old)
+	newTitle, newCompany := currentJob(new)
+	return JobChanged{OldTitle: oldTitle, NewTitle: newTitle, OldCompany: oldCompany, NewCompany: newCompany}
+}
+
+// currentJob extracts the job title and employer of a person response
+func currentJob(v interface{}) (string, string) {
+	switch p := v.(type) {
+	case cufinder.EppResponse:
+		return p.Person.JobTitle, p.Person.CompanyName
+	case *cufinder.EppResponse:
+		if p != nil {
+			return currentJob(*p)
+		}
+	case cufinder.TepResponse:
+		return p.Person.JobTitle, p.Person.CompanyName
+	case *cufinder.TepResponse:
+		if p != nil {
+			return currentJob(*p)
+		}
+	case cufinder.RelResponse:
+		return p.Person.JobTitle, p.Person.CompanyName
+	case *cufinder.RelResponse:
+		if p != nil {
+			return currentJob(*p)
+		}
+	}
+	return "", ""
+}
+
+// fundingRound builds a NewFundingRound event from two ELF snapshots
+func fundingRound(old, new interface{}) NewFundingRound {
+	before, after := fundraising(old), fundraising(new)
+	return NewFundingRound{
+		OldRoundType: before.FundingLastRoundType,
+		RoundType:    after.FundingLastRoundType,
+		MoneyRaised:  after.FundingMoneyRaised,
+		Currency:     after.FundingAmmountCurrencyCode,
+		InvestorsURL: after.FundingLastRoundInvestorsUrl,
+	}
+}
+
+func fundraising(v interface{}) cufinder.ElfFundraising {
+	switch r := v.(type) {
+	case cufinder.ElfResponse:
+		return r.Fundraising
+	case *cufinder.ElfResponse:
+		if r != nil {
+			return r.Fundraising
+		}
+	}
+	return cufinder.ElfFundraising{}
+}
+
+func splitPath(path string) (string, string) {
+	if i := strings.LastIndex(path, "."); i >= 0 {
+		return path[:i], path[i+1:]
+	}
+	return "", path
+}
+
+func text(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+func orNone(v interface{}) string {
+	if s := text(v); s != "" {
+		return s
+	}
+	return "(none)"
+}
+
+func describeJob(title, company string) string {
+	switch {
+	case title != "" && company != "":
+		return fmt.Sprintf("%q at %s", title, company)
+	case title != "":
+		return fmt.Sprintf("%q", title)
+	case company != "":
+		return company
+	}
+	return "(none)"
+}
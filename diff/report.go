@@ -0,0 +1,39 @@
+package diff
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Report renders events as a human-readable summary for subject, e.g. a
+// domain or LinkedIn URL
+func Report(subject string, events []Event) string {
+	var b strings.Builder
+	WriteReport(&b, subject, events)
+	return b.String()
+}
+
+// WriteReport writes the summary produced by Report to w
+func WriteReport(w io.Writer, subject string, events []Event) error {
+	switch len(events) {
+	case 0:
+		_, err := fmt.Fprintf(w, "%s: no changes\n", subject)
+		return err
+	case 1:
+		if _, err := fmt.Fprintf(w, "%s: 1 change\n", subject); err != nil {
+			return err
+		}
+	default:
+		if _, err := fmt.Fprintf(w, "%s: %d changes\n", subject, len(events)); err != nil {
+			return err
+		}
+	}
+
+	for _, e := range events {
+		if _, err := fmt.Fprintf(w, "  - %s\n", e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
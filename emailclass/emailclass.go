@@ -0,0 +1,215 @@
+// Package emailclass classifies email addresses offline as person-like,
+// role-based (info@, sales@), free-mail (gmail, outlook) or disposable.
+//
+// The provider and role lists are bundled with the package and can be
+// extended at run time, for example from a file that is updated
+// independently of releases:
+//
+//	c := emailclass.New()
+//	f, _ := os.Open("disposable.txt")
+//	c.LoadDisposable(f)
+//
+// The package has no dependencies so the SDK can use it to pre-filter REL
+// requests (see cufinder.ClientConfig.RELFilter).
+package emailclass
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// Category is the primary kind of an address
+type Category string
+
+const (
+	// Person is an address that looks like it belongs to one person at a
+	// company domain
+	Person Category = "person"
+	// Role is a shared or functional mailbox such as info@ or sales@
+	Role Category = "role"
+	// FreeMail is a consumer mailbox such as gmail.com or outlook.com
+	FreeMail Category = "free"
+	// Disposable is a temporary mailbox such as mailinator.com
+	Disposable Category = "disposable"
+	// Invalid is not a syntactically valid address
+	Invalid Category = "invalid"
+)
+
+//go:embed lists/free.txt
+var bundledFree string
+
+//go:embed lists/disposable.txt
+var bundledDisposable string
+
+//go:embed lists/roles.txt
+var bundledRoles string
+
+// Classification describes an address. Category is the most significant
+// finding; the flags report every finding, so a role address at a free-mail
+// domain has both IsRole and IsFreeMail set.
+type Classification struct {
+	Email        string   `json:"email"`
+	Local        string   `json:"local"`
+	Domain       string   `json:"domain"`
+	Category     Category `json:"category"`
+	IsRole       bool     `json:"is_role"`
+	IsFreeMail   bool     `json:"is_free_mail"`
+	IsDisposable bool     `json:"is_disposable"`
+}
+
+// Classifier classifies addresses against provider and role lists. It is
+// safe for concurrent use.
+type Classifier struct {
+	mu         sync.RWMutex
+	free       map[string]bool
+	disposable map[string]bool
+	roles      map[string]bool
+}
+
+// New creates a classifier with the bundled lists
+func New() *Classifier {
+	c := NewEmpty()
+	// The bundled lists are known to be well formed
+	c.LoadFreeMail(strings.NewReader(bundledFree))
+	c.LoadDisposable(strings.NewReader(bundledDisposable))
+	c.LoadRoles(strings.NewReader(bundledRoles))
+	return c
+}
+
+// NewEmpty creates a classifier without any lists
+func NewEmpty() *Classifier {
+	return &Classifier{
+		free:       make(map[string]bool),
+		disposable: make(map[string]bool),
+		roles:      make(map[string]bool),
+	}
+}
+
+// Default is the classifier used by the package-level functions
+var Default = New()
+
+// Classify classifies an address with the Default classifier
+func Classify(email string) Classification {
+	return Default.Classify(email)
+}
+
+// AddFreeMail adds free-mail provider domains
+func (c *Classifier) AddFreeMail(domains ...string) {
+	c.add(c.free, domains)
+}
+
+// AddDisposable adds disposable provider domains
+func (c *Classifier) AddDisposable(domains ...string) {
+	c.add(c.disposable, domains)
+}
+
+// AddRoles adds role local parts
+func (c *Classifier) AddRoles(locals ...string) {
+	c.add(c.roles, locals)
+}
+
+// LoadFreeMail adds free-mail domains from a list with one entry per line.
+// Blank lines and lines starting with # are ignored.
+func (c *Classifier) LoadFreeMail(r io.Reader) error {
+	return c.load(c.free, r)
+}
+
+// LoadDisposable adds disposable domains from a list with one entry per line
+func (c *Classifier) LoadDisposable(r io.Reader) error {
+	return c.load(c.disposable, r)
+}
+
+// LoadRoles adds role local parts from a list with one entry per line
+func (c *Classifier) LoadRoles(r io.Reader) error {
+	return c.load(c.roles, r)
+}
+
+func (c *Classifier) add(set map[string]bool, entries []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range entries {
+		if e = strings.ToLower(strings.TrimSpace(e)); e != "" {
+			set[e] = true
+		}
+	}
+}
+
+func (c *Classifier) load(set map[string]bool, r io.Reader) error {
+	var entries []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entries = append(entries, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read list: %w", err)
+	}
+	c.add(set, entries)
+	return nil
+}
+
+// Classify classifies an address. Disposable takes precedence over role,
+// and role over free-mail.
+func (c *Classifier) Classify(email string) Classification {
+	email = strings.TrimSpace(email)
+	result := Classification{Email: email, Category: Invalid}
+
+	at := strings.LastIndex(email, "@")
+	if at <= 0 || at == len(email)-1 {
+		return result
+	}
+	local := strings.ToLower(email[:at])
+	domain := strings.TrimSuffix(strings.ToLower(email[at+1:]), ".")
+	if strings.ContainsAny(local, " \t") || !strings.Contains(domain, ".") || strings.ContainsAny(domain, " \t@") {
+		return result
+	}
+	result.Local, result.Domain = local, domain
+
+	c.mu.RLock()
+	result.IsDisposable = matchDomain(c.disposable, domain)
+	result.IsFreeMail = matchDomain(c.free, domain)
+	result.IsRole = c.roles[roleBase(local)]
+	c.mu.RUnlock()
+
+	switch {
+	case result.IsDisposable:
+		result.Category = Disposable
+	case result.IsRole:
+		result.Category = Role
+	case result.IsFreeMail:
+		result.Category = FreeMail
+	default:
+		result.Category = Person
+	}
+	return result
+}
+
+// matchDomain reports whether domain or one of its parent domains is in set
+func matchDomain(set map[string]bool, domain string) bool {
+	for {
+		if set[domain] {
+			return true
+		}
+		dot := strings.IndexByte(domain, '.')
+		if dot < 0 {
+			return false
+		}
+		domain = domain[dot+1:]
+	}
+}
+
+// roleBase strips a +tag and trailing digits, so sales+eu and support2 are
+// recognized as roles
+func roleBase(local string) string {
+	if plus := strings.IndexByte(local, '+'); plus >= 0 {
+		local = local[:plus]
+	}
+	return strings.TrimRight(local, "0123456789")
+}
@@ -0,0 +1,55 @@
+package emailclass
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassify(t *testing.T) {
+	tests := map[string]Category{
+		"john.doe@techcorp.com":    Person,
+		"info@techcorp.com":        Role,
+		"Sales+EU@techcorp.com":    Role,
+		"support2@techcorp.com":    Role,
+		"jane@gmail.com":           FreeMail,
+		"jane@mail.yahoo.co.uk":    FreeMail,
+		"x@mailinator.com":         Disposable,
+		"info@yopmail.com":         Disposable,
+		"not-an-email":             Invalid,
+		"@techcorp.com":            Invalid,
+		"john@localhost":           Invalid,
+		"john doe@techcorp.com":    Invalid,
+		"  padded@techcorp.com.  ": Person,
+	}
+	for email, want := range tests {
+		assert.Equal(t, want, Classify(email).Category, email)
+	}
+
+	c := Classify("info@gmail.com")
+	assert.Equal(t, Role, c.Category)
+	assert.True(t, c.IsRole)
+	assert.True(t, c.IsFreeMail)
+	assert.False(t, c.IsDisposable)
+	assert.Equal(t, "info", c.Local)
+	assert.Equal(t, "gmail.com", c.Domain)
+}
+
+func TestUpdateLists(t *testing.T) {
+	c := New()
+	assert.Equal(t, Person, c.Classify("a@newtempmail.io").Category)
+
+	err := c.LoadDisposable(strings.NewReader("# updated list\n\nNewTempMail.io\n"))
+	assert.NoError(t, err)
+	c.AddFreeMail("isp.example")
+	c.AddRoles("growth")
+
+	assert.Equal(t, Disposable, c.Classify("a@newtempmail.io").Category)
+	assert.Equal(t, FreeMail, c.Classify("a@isp.example").Category)
+	assert.Equal(t, Role, c.Classify("growth@techcorp.com").Category)
+
+	// Other classifiers keep their own lists
+	assert.Equal(t, Person, Classify("a@newtempmail.io").Category)
+	assert.Equal(t, Person, NewEmpty().Classify("info@gmail.com").Category)
+}
@@ -0,0 +1,75 @@
+package cufinder
+
+import (
+	"fmt"
+
+	"github.com/cufinder/cufinder-go/emailclass"
+)
+
+// RELFilter controls how REL treats role, free-mail and disposable addresses
+type RELFilter int
+
+const (
+	// RELFilterOff sends every address unchecked
+	RELFilterOff RELFilter = iota
+	// RELFilterFlag sends every address and reports its classification in
+	// RelResponse.EmailClass
+	RELFilterFlag
+	// RELFilterRefuse returns an *EmailFilterError for filtered addresses
+	// instead of spending a credit on them
+	RELFilterRefuse
+)
+
+// defaultRELFilterCategories are filtered when no categories are configured
+var defaultRELFilterCategories = []emailclass.Category{
+	emailclass.Role, emailclass.FreeMail, emailclass.Disposable,
+}
+
+// EmailFilterError is returned when REL refuses an address locally
+type EmailFilterError struct {
+	Email    string
+	Category emailclass.Category
+}
+
+// Error implements the error interface
+func (e *EmailFilterError) Error() string {
+	return fmt.Sprintf("REL refused %q: %s address", e.Email, e.Category)
+}
+
+// emailFilter holds the client's REL pre-filter settings
+type emailFilter struct {
+	mode       RELFilter
+	categories []emailclass.Category
+	classifier *emailclass.Classifier
+}
+
+// check classifies an address, returning an error when it must be refused
+func (f emailFilter) check(email string) (*emailclass.Classification, error) {
+	if f.mode == RELFilterOff {
+		return nil, nil
+	}
+
+	c := f.classifier.Classify(email)
+	if f.mode != RELFilterRefuse {
+		return &c, nil
+	}
+	if category, ok := f.filtered(c); ok {
+		return &c, &EmailFilterError{Email: email, Category: category}
+	}
+	return &c, nil
+}
+
+// filtered returns the first configured category that applies to c. The
+// flags are checked as well as the primary category, so a role address at a
+// free-mail domain is refused by a role filter.
+func (f emailFilter) filtered(c emailclass.Classification) (emailclass.Category, bool) {
+	for _, category := range f.categories {
+		if category == c.Category ||
+			category == emailclass.Role && c.IsRole ||
+			category == emailclass.FreeMail && c.IsFreeMail ||
+			category == emailclass.Disposable && c.IsDisposable {
+			return category, true
+		}
+	}
+	return "", false
+}
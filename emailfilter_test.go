@@ -0,0 +1,86 @@
+package cufinder
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cufinder/cufinder-go/emailclass"
+)
+
+func TestRELFilter(t *testing.T) {
+	sdk, calls := newWorkflowSDK(t, map[string]fakeEndpoint{
+		"/rel": func(form url.Values) map[string]interface{} {
+			return map[string]interface{}{"person": map[string]interface{}{"full_name": "John Doe"}}
+		},
+	})
+
+	newSDK := func(config ClientConfig) *SDK {
+		config.APIKey = "test-api-key"
+		config.BaseURL = sdk.GetClient().baseURL
+		return NewSDKWithConfig(config)
+	}
+
+	t.Run("Refuse", func(t *testing.T) {
+		refusing := newSDK(ClientConfig{RELFilter: RELFilterRefuse})
+
+		_, err := refusing.REL("info@techcorp.com")
+		var ferr *EmailFilterError
+		require.True(t, errors.As(err, &ferr))
+		assert.Equal(t, emailclass.Role, ferr.Category)
+		assert.Equal(t, `REL refused "info@techcorp.com": role address`, err.Error())
+
+		_, err = refusing.REL("jane@gmail.com")
+		assert.Error(t, err)
+		assert.Empty(t, *calls)
+
+		result, err := refusing.REL("john.doe@techcorp.com")
+		require.NoError(t, err)
+		assert.Equal(t, emailclass.Person, result.EmailClass.Category)
+		assert.Len(t, *calls, 1)
+	})
+
+	t.Run("Custom Categories", func(t *testing.T) {
+		refusing := newSDK(ClientConfig{
+			RELFilter:           RELFilterRefuse,
+			RELFilterCategories: []emailclass.Category{emailclass.Disposable},
+		})
+
+		_, err := refusing.REL("jane@gmail.com")
+		assert.NoError(t, err)
+		_, err = refusing.REL("x@mailinator.com")
+		assert.Error(t, err)
+	})
+
+	t.Run("Secondary Flags", func(t *testing.T) {
+		for _, category := range []emailclass.Category{emailclass.Role, emailclass.FreeMail} {
+			refusing := newSDK(ClientConfig{
+				RELFilter:           RELFilterRefuse,
+				RELFilterCategories: []emailclass.Category{category},
+			})
+
+			_, err := refusing.REL("info@gmail.com")
+			var ferr *EmailFilterError
+			require.True(t, errors.As(err, &ferr), category)
+			assert.Equal(t, category, ferr.Category)
+		}
+	})
+
+	t.Run("Flag", func(t *testing.T) {
+		flagging := newSDK(ClientConfig{RELFilter: RELFilterFlag})
+
+		result, err := flagging.REL("sales@techcorp.com")
+		require.NoError(t, err)
+		assert.Equal(t, emailclass.Role, result.EmailClass.Category)
+		assert.Equal(t, "John Doe", result.Person.FullName)
+		assert.True(t, strings.HasPrefix((*calls)[len(*calls)-1], "/rel?"))
+
+		result, err = sdk.REL("sales@techcorp.com")
+		require.NoError(t, err)
+		assert.Nil(t, result.EmailClass)
+	})
+}
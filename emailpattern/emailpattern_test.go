@@ -0,0 +1,79 @@
+package emailpattern
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+func TestPatternEmail(t *testing.T) {
+	tests := map[Pattern]string{
+		FirstDotLast: "jose.obrien@acme.com",
+		FLast:        "jobrien@acme.com",
+		FirstL:       "joseo@acme.com",
+		LastDotFirst: "obrien.jose@acme.com",
+		FL:           "jo@acme.com",
+	}
+	for pattern, want := range tests {
+		email, ok := pattern.Email("José", "O'Brien", "ACME.com")
+		assert.True(t, ok)
+		assert.Equal(t, want, email, pattern)
+	}
+
+	_, ok := FirstDotLast.Email("Cher", "", "acme.com")
+	assert.False(t, ok)
+	email, ok := First.Email("Cher", "", "acme.com")
+	assert.True(t, ok)
+	assert.Equal(t, "cher@acme.com", email)
+}
+
+func TestInfer(t *testing.T) {
+	samples := append(
+		FromDTE(&cufinder.DteResponse{Emails: []string{"info@acme.com", "maria.garcia@acme.com", "someone@other.com"}}),
+		FromFWE(&cufinder.FweResponse{WorkEmail: "john.smith@acme.com"}, "John Q. Smith"),
+		Sample{Email: "ANNA.LEE@acme.com", FirstName: "Anna", LastName: "Lee"},
+	)
+
+	result := Infer("https://www.acme.com", samples)
+	assert.Equal(t, "acme.com", result.Domain)
+	assert.Equal(t, 3, result.Samples)
+
+	best, ok := result.Best()
+	require.True(t, ok)
+	assert.Equal(t, FirstDotLast, best.Pattern)
+	assert.Equal(t, 2, best.Matches)
+	assert.InDelta(t, 2.125/3.25, best.Confidence, 1e-9)
+
+	require.Len(t, result.Patterns, 2)
+	assert.Equal(t, LastDotFirst, result.Patterns[1].Pattern)
+
+	assert.Equal(t, []Guess{
+		{Email: "jane.doe@acme.com", Pattern: FirstDotLast, Confidence: best.Confidence},
+		{Email: "doe.jane@acme.com", Pattern: LastDotFirst, Confidence: result.Patterns[1].Confidence},
+	}, result.Generate("Jane", "Doe"))
+
+	_, ok = Infer("acme.com", FromDTE(&cufinder.DteResponse{Emails: []string{"sales@acme.com"}})).Best()
+	assert.False(t, ok)
+}
+
+func TestDirectory(t *testing.T) {
+	d := NewDirectory()
+	d.Learn("acme.com", Sample{Email: "jsmith@acme.com", FirstName: "John", LastName: "Smith"})
+	d.Learn("acme.com", Sample{Email: "mgarcia@acme.com", FirstName: "Maria", LastName: "Garcia"})
+
+	result, ok := d.Lookup("ACME.com")
+	require.True(t, ok)
+	best, _ := result.Best()
+	assert.Equal(t, FLast, best.Pattern)
+	assert.Equal(t, 2, best.Matches)
+
+	guesses, ok := d.Guess(cufinder.Person{FullName: "Ada Lovelace", Company: cufinder.Company{Website: "https://acme.com"}})
+	require.True(t, ok)
+	assert.Equal(t, "alovelace@acme.com", guesses[0].Email)
+
+	_, ok = d.Guess(cufinder.Person{FullName: "Ada Lovelace", Company: cufinder.Company{Domain: "unknown.com"}})
+	assert.False(t, ok)
+}
@@ -0,0 +1,255 @@
+package emailpattern
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cufinder/cufinder-go"
+	"github.com/cufinder/cufinder-go/emailclass"
+)
+
+// Evidence weights. A named sample is a confirmed address of a known person;
+// an anonymous sample only shows the shape of the local part.
+const (
+	namedWeight     = 1.0
+	anonymousWeight = 0.25
+)
+
+// Sample is a known address, with the owner's name when known
+type Sample struct {
+	Email     string
+	FirstName string
+	LastName  string
+}
+
+// FromDTE returns the addresses of a DTE response as anonymous samples
+func FromDTE(response *cufinder.DteResponse) []Sample {
+	var samples []Sample
+	for _, email := range response.Emails {
+		samples = append(samples, Sample{Email: email})
+	}
+	return samples
+}
+
+// FromFWE returns the address of a FWE response as a sample owned by the
+// person with the given full name
+func FromFWE(response *cufinder.FweResponse, fullName string) Sample {
+	first, last := SplitName(fullName)
+	return Sample{Email: response.WorkEmail, FirstName: first, LastName: last}
+}
+
+// Ranked is a pattern with its confidence
+type Ranked struct {
+	Pattern Pattern `json:"pattern"`
+	// Confidence is between 0 and 1. It grows with consistent evidence, so a
+	// single confirmed address gives 0.5.
+	Confidence float64 `json:"confidence"`
+	// Matches counts the named samples the pattern reproduces
+	Matches int `json:"matches"`
+}
+
+// Result is the learned format of a domain
+type Result struct {
+	Domain string `json:"domain"`
+	// Patterns are ranked by confidence; patterns without evidence are left out
+	Patterns []Ranked `json:"patterns"`
+	// Samples counts the usable samples at the domain
+	Samples int `json:"samples"`
+}
+
+// Best returns the most likely pattern
+func (r *Result) Best() (Ranked, bool) {
+	if len(r.Patterns) == 0 {
+		return Ranked{}, false
+	}
+	return r.Patterns[0], true
+}
+
+// Guess is a candidate address
+type Guess struct {
+	Email      string  `json:"email"`
+	Pattern    Pattern `json:"pattern"`
+	Confidence float64 `json:"confidence"`
+}
+
+// Generate returns candidate addresses for a person, most likely first
+func (r *Result) Generate(firstName, lastName string) []Guess {
+	var guesses []Guess
+	seen := make(map[string]bool)
+	for _, p := range r.Patterns {
+		email, ok := p.Pattern.Email(firstName, lastName, r.Domain)
+		if !ok || seen[email] {
+			continue
+		}
+		seen[email] = true
+		guesses = append(guesses, Guess{Email: email, Pattern: p.Pattern, Confidence: p.Confidence})
+	}
+	return guesses
+}
+
+// Infer learns the address format of a domain from samples. Samples at
+// other domains and role addresses such as info@ are ignored.
+func Infer(domain string, samples []Sample) *Result {
+	domain = canonical(domain)
+	result := &Result{Domain: domain}
+
+	weights := make(map[Pattern]float64)
+	matches := make(map[Pattern]int)
+	total := 0.0
+
+	for _, s := range samples {
+		class := emailclass.Classify(s.Email)
+		if class.Category == emailclass.Invalid || class.Domain != domain || class.IsRole {
+			continue
+		}
+		local := class.Local
+
+		var votes []Pattern
+		weight := anonymousWeight
+		if NormalizeName(s.FirstName) != "" {
+			weight = namedWeight
+			for _, p := range Patterns {
+				if l, ok := p.Local(s.FirstName, s.LastName); ok && l == local {
+					votes = append(votes, p)
+				}
+			}
+		} else {
+			votes = shapePatterns(local)
+		}
+		if len(votes) == 0 {
+			continue
+		}
+
+		result.Samples++
+		total += weight
+		for _, p := range votes {
+			weights[p] += weight / float64(len(votes))
+			if weight == namedWeight {
+				matches[p]++
+			}
+		}
+	}
+
+	for _, p := range Patterns {
+		if w := weights[p]; w > 0 {
+			result.Patterns = append(result.Patterns, Ranked{
+				Pattern:    p,
+				Confidence: w / (total + 1),
+				Matches:    matches[p],
+			})
+		}
+	}
+	// Patterns is ordered by popularity, which breaks ties
+	sort.SliceStable(result.Patterns, func(i, j int) bool {
+		return result.Patterns[i].Confidence > result.Patterns[j].Confidence
+	})
+	return result
+}
+
+// shapePatterns returns the patterns an anonymous local part could follow
+func shapePatterns(local string) []Pattern {
+	for _, sep := range []string{".", "_", "-"} {
+		parts := strings.Split(local, sep)
+		if len(parts) != 2 || !isAlpha(parts[0]) || !isAlpha(parts[1]) {
+			continue
+		}
+		switch {
+		case sep == "." && len(parts[0]) == 1:
+			return []Pattern{FDotLast}
+		case sep == "." && len(parts[1]) == 1:
+			return []Pattern{FirstDotL}
+		case sep == ".":
+			return []Pattern{FirstDotLast, LastDotFirst}
+		case sep == "_":
+			return []Pattern{FirstUnderLast, LastUnderFirst}
+		default:
+			return []Pattern{FirstDashLast}
+		}
+	}
+	if isAlpha(local) && len(local) >= 2 {
+		return []Pattern{First, FLast, FirstLast}
+	}
+	return nil
+}
+
+// canonical reduces a website to its domain
+func canonical(domain string) string {
+	if d, ok := cufinder.CanonicalDomain(domain); ok {
+		return d
+	}
+	return strings.ToLower(strings.TrimSpace(domain))
+}
+
+func isAlpha(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// Directory keeps learned patterns per domain so candidate addresses can be
+// generated without further API calls. It is safe for concurrent use.
+type Directory struct {
+	mu      sync.RWMutex
+	samples map[string][]Sample
+	results map[string]*Result
+}
+
+// NewDirectory creates an empty Directory
+func NewDirectory() *Directory {
+	return &Directory{
+		samples: make(map[string][]Sample),
+		results: make(map[string]*Result),
+	}
+}
+
+// Learn adds samples for a domain and returns the updated result
+func (d *Directory) Learn(domain string, samples ...Sample) *Result {
+	domain = canonical(domain)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.samples[domain] = append(d.samples[domain], samples...)
+	result := Infer(domain, d.samples[domain])
+	d.results[domain] = result
+	return result
+}
+
+// Lookup returns the learned result for a domain
+func (d *Directory) Lookup(domain string) (*Result, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	result, ok := d.results[canonical(domain)]
+	if !ok || len(result.Patterns) == 0 {
+		return nil, false
+	}
+	return result, true
+}
+
+// Guess returns candidate addresses for a person from a people search, using
+// the pattern learned for the person's company domain
+func (d *Directory) Guess(person cufinder.Person) ([]Guess, bool) {
+	domain, ok := cufinder.CanonicalDomain(person.Company.Domain)
+	if !ok {
+		if domain, ok = cufinder.CanonicalDomain(person.Company.Website); !ok {
+			return nil, false
+		}
+	}
+	result, ok := d.Lookup(domain)
+	if !ok {
+		return nil, false
+	}
+
+	first, last := person.FirstName, person.LastName
+	if first == "" && last == "" {
+		first, last = SplitName(person.FullName)
+	}
+	guesses := result.Generate(first, last)
+	return guesses, len(guesses) > 0
+}
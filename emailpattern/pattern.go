@@ -0,0 +1,114 @@
+// Package emailpattern learns a company's email address format from known
+// addresses and generates candidate addresses for other people at the
+// company.
+//
+// Samples come from DTE (addresses without names) and FWE (addresses of
+// known people). Addresses of known people are strong evidence for a
+// pattern; anonymous addresses only hint at its shape. Once a domain's
+// pattern is learned, Generate produces addresses without further API calls.
+package emailpattern
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Pattern is an address format with {first}, {last}, {f} and {l}
+// placeholders for the full and initial first and last name
+type Pattern string
+
+const (
+	FirstDotLast   Pattern = "{first}.{last}"
+	FirstLast      Pattern = "{first}{last}"
+	FirstUnderLast Pattern = "{first}_{last}"
+	FirstDashLast  Pattern = "{first}-{last}"
+	FLast          Pattern = "{f}{last}"
+	FDotLast       Pattern = "{f}.{last}"
+	First          Pattern = "{first}"
+	Last           Pattern = "{last}"
+	FirstL         Pattern = "{first}{l}"
+	FirstDotL      Pattern = "{first}.{l}"
+	LastDotFirst   Pattern = "{last}.{first}"
+	LastFirst      Pattern = "{last}{first}"
+	LastF          Pattern = "{last}{f}"
+	LastUnderFirst Pattern = "{last}_{first}"
+	FL             Pattern = "{f}{l}"
+)
+
+// Patterns lists every known Pattern, most common first
+var Patterns = []Pattern{
+	FirstDotLast, FLast, First, FirstLast, FDotLast, FirstUnderLast, FirstDashLast,
+	FirstL, FirstDotL, LastDotFirst, LastFirst, LastF, LastUnderFirst, Last, FL,
+}
+
+// Local returns the local part of the address for a person, or false when
+// the pattern needs a name part that is empty
+func (p Pattern) Local(firstName, lastName string) (string, bool) {
+	first, last := NormalizeName(firstName), NormalizeName(lastName)
+
+	var f, l string
+	if first != "" {
+		f = first[:1]
+	}
+	if last != "" {
+		l = last[:1]
+	}
+
+	s := string(p)
+	if (strings.Contains(s, "{first}") || strings.Contains(s, "{f}")) && first == "" {
+		return "", false
+	}
+	if (strings.Contains(s, "{last}") || strings.Contains(s, "{l}")) && last == "" {
+		return "", false
+	}
+
+	r := strings.NewReplacer("{first}", first, "{last}", last, "{f}", f, "{l}", l)
+	return r.Replace(s), true
+}
+
+// Email returns the address for a person at a domain
+func (p Pattern) Email(firstName, lastName, domain string) (string, bool) {
+	local, ok := p.Local(firstName, lastName)
+	if !ok || domain == "" {
+		return "", false
+	}
+	return local + "@" + strings.ToLower(domain), true
+}
+
+// folds maps accented Latin letters to ASCII
+var folds = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'æ': "ae",
+	'ç': "c", 'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ì': "i", 'í': "i",
+	'î': "i", 'ï': "i", 'ñ': "n", 'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o",
+	'ö': "o", 'ø': "o", 'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ý': "y",
+	'ÿ': "y", 'ß': "ss", 'ł': "l", 'ś': "s", 'ź': "z", 'ż': "z", 'č': "c",
+	'ć': "c", 'š': "s", 'ž': "z", 'ř': "r", 'ě': "e", 'ő': "o", 'ű': "u",
+}
+
+// NormalizeName lower-cases a name part, folds accents and drops everything
+// but ASCII letters and digits, so "O'Brien" becomes "obrien"
+func NormalizeName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(name)) {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			b.WriteRune(r)
+		case folds[r] != "":
+			b.WriteString(folds[r])
+		}
+	}
+	return b.String()
+}
+
+// SplitName splits a full name into first and last name, ignoring middle
+// names
+func SplitName(fullName string) (string, string) {
+	fields := strings.Fields(fullName)
+	switch len(fields) {
+	case 0:
+		return "", ""
+	case 1:
+		return fields[0], ""
+	}
+	return fields[0], fields[len(fields)-1]
+}
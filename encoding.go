@@ -0,0 +1,236 @@
+package cufinder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Optional holds a value that is sent only when explicitly set, so zero
+// values such as 0 or false can be distinguished from "not provided".
+type Optional[T any] struct {
+	value T
+	set   bool
+}
+
+// Some returns an Optional set to v
+func Some[T any](v T) Optional[T] {
+	return Optional[T]{value: v, set: true}
+}
+
+// IsSet reports whether a value was provided
+func (o Optional[T]) IsSet() bool {
+	return o.set
+}
+
+// Get returns the value and whether it was provided
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.set
+}
+
+// Value returns the value, or the zero value when unset
+func (o Optional[T]) Value() T {
+	return o.value
+}
+
+// IsZero reports whether the Optional is unset, so fields tagged omitzero
+// are left out of json.Marshal output until they are set
+func (o Optional[T]) IsZero() bool {
+	return !o.set
+}
+
+// MarshalJSON encodes an unset Optional as null
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.set {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON treats null as unset
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = Optional[T]{}
+		return nil
+	}
+	if err := json.Unmarshal(data, &o.value); err != nil {
+		return err
+	}
+	o.set = true
+	return nil
+}
+
+func (o Optional[T]) optionalValue() (interface{}, bool) {
+	return o.value, o.set
+}
+
+// optional is implemented by every Optional[T]
+type optional interface {
+	optionalValue() (interface{}, bool)
+}
+
+// RequestEncoder turns request parameters into an HTTP request body
+type RequestEncoder interface {
+	ContentType() string
+	Encode(data interface{}) ([]byte, error)
+}
+
+// FormEncoder encodes parameters as application/x-www-form-urlencoded.
+// Slices are joined with commas unless RepeatSlices is set, in which case
+// each element is sent as its own key=value pair.
+type FormEncoder struct {
+	RepeatSlices bool
+}
+
+// ContentType implements RequestEncoder
+func (e FormEncoder) ContentType() string {
+	return "application/x-www-form-urlencoded"
+}
+
+// Encode implements RequestEncoder
+func (e FormEncoder) Encode(data interface{}) ([]byte, error) {
+	values, err := e.Values(data)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(values.Encode()), nil
+}
+
+// Values converts a Params struct to form values
+func (e FormEncoder) Values(data interface{}) (url.Values, error) {
+	fields, err := paramFields(data)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	for _, f := range fields {
+		if f.value.Kind() == reflect.Slice {
+			var items []string
+			for i := 0; i < f.value.Len(); i++ {
+				items = append(items, formatScalar(f.value.Index(i)))
+			}
+			if e.RepeatSlices {
+				values[f.name] = items
+			} else {
+				values.Set(f.name, strings.Join(items, ","))
+			}
+			continue
+		}
+		values.Set(f.name, formatScalar(f.value))
+	}
+
+	return values, nil
+}
+
+// JSONEncoder encodes parameters as an application/json object. Slices are
+// sent as arrays and explicitly set zero values are preserved.
+type JSONEncoder struct{}
+
+// ContentType implements RequestEncoder
+func (e JSONEncoder) ContentType() string {
+	return "application/json"
+}
+
+// Encode implements RequestEncoder
+func (e JSONEncoder) Encode(data interface{}) ([]byte, error) {
+	fields, err := paramFields(data)
+	if err != nil {
+		return nil, err
+	}
+
+	body := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		body[f.name] = f.value.Interface()
+	}
+
+	return json.Marshal(body)
+}
+
+// paramField is a single non-empty request field of a Params struct
+type paramField struct {
+	name  string
+	value reflect.Value
+}
+
+// paramFields walks the json-tagged fields of a Params struct. Fields that
+// are Optional or pointers are included whenever they are set, even to a
+// zero value; all other fields are skipped when they hold their zero value.
+func paramFields(data interface{}) ([]paramField, error) {
+	v := reflect.ValueOf(data)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("data must be a struct")
+	}
+
+	t := v.Type()
+	var fields []paramField
+
+	for i := 0; i < v.NumField(); i++ {
+		field := t.Field(i)
+		fieldValue := v.Field(i)
+
+		// Skip unexported fields
+		if !fieldValue.CanInterface() {
+			continue
+		}
+
+		// Get JSON tag name, ignoring omitempty and other options
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+
+		// Optional and pointer fields carry explicit "set" semantics
+		if opt, ok := fieldValue.Interface().(optional); ok {
+			if value, set := opt.optionalValue(); set {
+				fields = append(fields, paramField{name: name, value: reflect.ValueOf(value)})
+			}
+			continue
+		}
+		if fieldValue.Kind() == reflect.Ptr {
+			if !fieldValue.IsNil() {
+				fields = append(fields, paramField{name: name, value: fieldValue.Elem()})
+			}
+			continue
+		}
+
+		if fieldValue.Kind() == reflect.Slice {
+			if fieldValue.Len() > 0 {
+				fields = append(fields, paramField{name: name, value: fieldValue})
+			}
+			continue
+		}
+		if !fieldValue.IsZero() {
+			fields = append(fields, paramField{name: name, value: fieldValue})
+		}
+	}
+
+	return fields, nil
+}
+
+// formatScalar converts a single field value to its form representation
+func formatScalar(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	default:
+		// For other types, try to convert to string
+		return fmt.Sprintf("%v", v.Interface())
+	}
+}
@@ -0,0 +1,218 @@
+package cufinder
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var allParams = []interface{}{
+	CufParams{}, LcufParams{}, DtcParams{}, DteParams{}, NtpParams{}, RelParams{},
+	FclParams{}, ElfParams{}, CarParams{}, FccParams{}, FtsParams{}, EppParams{},
+	FweParams{}, TepParams{}, EncParams{}, CecParams{}, CloParams{}, CseParams{},
+	PseParams{}, LbsParams{}, BcdParams{}, CcpParams{}, IscParams{}, CbcParams{},
+	CscParams{}, CsnParams{}, NaoParams{}, NaaParams{},
+}
+
+// fieldType returns the underlying value type of a Params field
+func fieldType(t reflect.Type) reflect.Type {
+	if m, ok := t.MethodByName("Value"); ok && t.Kind() == reflect.Struct {
+		return m.Type.Out(0)
+	}
+	return t
+}
+
+// sampleParams fills every field of a Params type, using explicit zero
+// values for Optional fields and commas inside slice items
+func sampleParams(t *testing.T, params interface{}) interface{} {
+	typ := reflect.TypeOf(params)
+	raw := map[string]interface{}{}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name := jsonName(field)
+		switch ft := field.Type; {
+		case fieldType(ft) != ft && fieldType(ft).Kind() == reflect.Int:
+			raw[name] = 0
+		case fieldType(ft) != ft && fieldType(ft).Kind() == reflect.Bool:
+			raw[name] = false
+		case ft.Kind() == reflect.Int:
+			raw[name] = 7
+		case ft.Kind() == reflect.Slice:
+			raw[name] = []string{"crm, sales", "analytics"}
+		default:
+			raw[name] = "value of " + name
+		}
+	}
+	return jsonRoundTrip(t, raw, typ)
+}
+
+// decodeForm is the inverse of FormEncoder{RepeatSlices: true}
+func decodeForm(t *testing.T, values url.Values, typ reflect.Type) interface{} {
+	raw := map[string]interface{}{}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name := jsonName(field)
+		items, ok := values[name]
+		if !ok {
+			continue
+		}
+		switch ft := fieldType(field.Type); ft.Kind() {
+		case reflect.Int:
+			n, err := strconv.Atoi(items[0])
+			require.NoError(t, err)
+			raw[name] = n
+		case reflect.Bool:
+			b, err := strconv.ParseBool(items[0])
+			require.NoError(t, err)
+			raw[name] = b
+		case reflect.Slice:
+			raw[name] = items
+		default:
+			raw[name] = items[0]
+		}
+	}
+	return jsonRoundTrip(t, raw, typ)
+}
+
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	return name
+}
+
+func jsonRoundTrip(t *testing.T, raw interface{}, typ reflect.Type) interface{} {
+	data, err := json.Marshal(raw)
+	require.NoError(t, err)
+	out := reflect.New(typ)
+	require.NoError(t, json.Unmarshal(data, out.Interface()))
+	return out.Elem().Interface()
+}
+
+func TestEncodersRoundTrip(t *testing.T) {
+	for _, params := range allParams {
+		typ := reflect.TypeOf(params)
+		sample := sampleParams(t, params)
+
+		t.Run(typ.Name()+" JSON", func(t *testing.T) {
+			body, err := JSONEncoder{}.Encode(sample)
+			require.NoError(t, err)
+			assert.Equal(t, sample, jsonRoundTrip(t, json.RawMessage(body), typ))
+		})
+
+		t.Run(typ.Name()+" Form", func(t *testing.T) {
+			values, err := FormEncoder{RepeatSlices: true}.Values(sample)
+			require.NoError(t, err)
+			assert.Equal(t, sample, decodeForm(t, values, typ))
+		})
+	}
+}
+
+func TestFormEncoder(t *testing.T) {
+	params := CseParams{
+		Name:             "TechCorp",
+		ProductsServices: []string{"crm", "analytics"},
+		FoundedAfterYear: Some(0),
+		IsSchool:         Some(false),
+	}
+
+	t.Run("Legacy Comma Join", func(t *testing.T) {
+		values, err := FormEncoder{}.Values(params)
+		require.NoError(t, err)
+		assert.Equal(t, "crm,analytics", values.Get("products_services"))
+		assert.Equal(t, "0", values.Get("founded_after_year"))
+		assert.Equal(t, "false", values.Get("is_school"))
+		_, ok := values["founded_before_year"]
+		assert.False(t, ok)
+		_, ok = values["page"]
+		assert.False(t, ok)
+	})
+
+	t.Run("Repeated Keys", func(t *testing.T) {
+		values, err := FormEncoder{RepeatSlices: true}.Values(params)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"crm", "analytics"}, values["products_services"])
+	})
+
+	t.Run("Pointer Fields", func(t *testing.T) {
+		zero := 0
+		values, err := FormEncoder{}.Values(struct {
+			Set   *int `json:"set"`
+			Unset *int `json:"unset"`
+		}{Set: &zero})
+		require.NoError(t, err)
+		assert.Equal(t, url.Values{"set": {"0"}}, values)
+	})
+}
+
+func TestJSONTransport(t *testing.T) {
+	var contentType string
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &body)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"companies": []interface{}{}})
+	}))
+	defer server.Close()
+
+	sdk := NewSDKWithConfig(ClientConfig{
+		APIKey:  "test-api-key",
+		BaseURL: server.URL,
+		Encoder: JSONEncoder{},
+	})
+
+	_, err := sdk.CSE(CseParams{
+		ProductsServices: []string{"crm, sales", "analytics"},
+		FoundedAfterYear: Some(0),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "application/json", contentType)
+	assert.Equal(t, map[string]interface{}{
+		"products_services":  []interface{}{"crm, sales", "analytics"},
+		"founded_after_year": float64(0),
+	}, body)
+}
+
+func TestOptionalJSON(t *testing.T) {
+	var o Optional[int]
+	data, err := json.Marshal(o)
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+
+	require.NoError(t, json.Unmarshal([]byte("0"), &o))
+	v, ok := o.Get()
+	assert.True(t, ok)
+	assert.Equal(t, 0, v)
+
+	require.NoError(t, json.Unmarshal([]byte("null"), &o))
+	assert.False(t, o.IsSet())
+}
+
+func TestUnsetOptionalFieldsOmitted(t *testing.T) {
+	data, err := json.Marshal(CseParams{Name: "TechCorp"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"TechCorp"}`, string(data))
+
+	data, err = json.Marshal(PseParams{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(data))
+
+	// Explicit zero values survive
+	data, err = json.Marshal(CseParams{FoundedAfterYear: Some(0), IsSchool: Some(false)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"founded_after_year":0,"is_school":false}`, string(data))
+
+	var params CseParams
+	require.NoError(t, json.Unmarshal(data, &params))
+	assert.True(t, params.FoundedAfterYear.IsSet())
+	assert.False(t, params.FollowersCountMin.IsSet())
+}
@@ -0,0 +1,136 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+	"slices"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+)
+
+// ArrowOptions configures an ArrowWriter
+type ArrowOptions struct {
+	// BatchRows is the maximum number of rows in a record batch. Defaults
+	// to 10000.
+	BatchRows int
+}
+
+func (o ArrowOptions) withDefaults() ArrowOptions {
+	if o.BatchRows <= 0 {
+		o.BatchRows = 10000
+	}
+	return o
+}
+
+// ArrowWriter streams results of one type into an Arrow IPC file (Feather
+// v2), with the same schema as ParquetWriter: JSON field names, nested
+// structs as struct columns and slices as lists.
+//
+//	aw, err := export.NewArrowWriter[cufinder.Person](f, export.ArrowOptions{})
+//	for _, person := range pse.Peoples {
+//		err = aw.Write(person)
+//	}
+//	err = aw.Close()
+type ArrowWriter[T any] struct {
+	buf    *recordBuffer
+	fw     *ipc.FileWriter
+	opts   ArrowOptions
+	closed bool
+}
+
+// NewArrowWriter creates a writer for results of type T, which must be a
+// struct
+func NewArrowWriter[T any](w io.Writer, opts ArrowOptions) (*ArrowWriter[T], error) {
+	return newArrowWriter[T](w, reflect.TypeOf((*T)(nil)).Elem(), opts)
+}
+
+// NewArrowWriterFor creates a writer for results of a struct type known
+// only at run time. Write takes values of rowType or pointers to them.
+func NewArrowWriterFor(w io.Writer, rowType reflect.Type, opts ArrowOptions) (*ArrowWriter[any], error) {
+	return newArrowWriter[any](w, rowType, opts)
+}
+
+func newArrowWriter[T any](w io.Writer, rowType reflect.Type, opts ArrowOptions) (*ArrowWriter[T], error) {
+	buf, err := newRecordBuffer(rowType)
+	if err != nil {
+		return nil, err
+	}
+	fw, err := ipc.NewFileWriter(w, ipc.WithSchema(buf.schema))
+	if err != nil {
+		buf.release()
+		return nil, fmt.Errorf("failed to create Arrow writer: %w", err)
+	}
+	return &ArrowWriter[T]{buf: buf, fw: fw, opts: opts.withDefaults()}, nil
+}
+
+// Schema returns the Arrow schema
+func (aw *ArrowWriter[T]) Schema() *arrow.Schema {
+	return aw.buf.schema
+}
+
+// Write adds a row, ending the record batch when it is full
+func (aw *ArrowWriter[T]) Write(row T) error {
+	if aw.closed {
+		return fmt.Errorf("arrow writer is closed")
+	}
+	if err := aw.buf.add(row); err != nil {
+		return err
+	}
+	if aw.buf.rows >= aw.opts.BatchRows {
+		return aw.Flush()
+	}
+	return nil
+}
+
+// Flush writes the buffered rows as a record batch
+func (aw *ArrowWriter[T]) Flush() error {
+	if aw.buf.rows == 0 {
+		return nil
+	}
+	rec := aw.buf.record()
+	defer rec.Release()
+	if err := aw.fw.Write(rec); err != nil {
+		return fmt.Errorf("failed to write Arrow record batch: %w", err)
+	}
+	return nil
+}
+
+// Close flushes the last record batch and writes the file footer. It does
+// not close the underlying writer.
+func (aw *ArrowWriter[T]) Close() error {
+	if aw.closed {
+		return nil
+	}
+	if err := aw.Flush(); err != nil {
+		return err
+	}
+	aw.closed = true
+	aw.buf.release()
+	if err := aw.fw.Close(); err != nil {
+		return fmt.Errorf("failed to write Arrow footer: %w", err)
+	}
+	return nil
+}
+
+// WriteArrow writes results as an Arrow IPC file
+func WriteArrow[T any](w io.Writer, results []T, opts ArrowOptions) error {
+	return WriteArrowSeq(w, slices.Values(results), opts)
+}
+
+// WriteArrowSeq writes the results of an iterator as an Arrow IPC file, so large
+// result sets are streamed without collecting them in a slice first
+func WriteArrowSeq[T any](w io.Writer, results iter.Seq[T], opts ArrowOptions) error {
+	aw, err := NewArrowWriter[T](w, opts)
+	if err != nil {
+		return err
+	}
+	for result := range results {
+		if err := aw.Write(result); err != nil {
+			return err
+		}
+	}
+	return aw.Close()
+}
@@ -0,0 +1,75 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+func TestArrowWriter(t *testing.T) {
+	zip := "10115"
+	rows := []pqRow{
+		{Name: "a", Tags: []string{"x"}, Offices: []pqOffice{{City: "Berlin", Zip: &zip}}, Extra: []int{1}},
+		{Name: "b"},
+		{Name: "c", Active: true},
+	}
+
+	var buf bytes.Buffer
+	aw, err := NewArrowWriter[pqRow](&buf, ArrowOptions{BatchRows: 2})
+	require.NoError(t, err)
+	assert.Equal(t, "name", aw.Schema().Field(0).Name)
+	for _, row := range rows {
+		require.NoError(t, aw.Write(row))
+	}
+	require.NoError(t, aw.Close())
+	assert.Error(t, aw.Write(rows[0]))
+
+	r, err := ipc.NewFileReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	defer r.Close()
+	require.Equal(t, 2, r.NumRecords())
+
+	var decoded []map[string]interface{}
+	for i := 0; i < r.NumRecords(); i++ {
+		rec, err := r.RecordBatch(i)
+		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, array.RecordToJSON(rec, &out))
+		dec := json.NewDecoder(&out)
+		for dec.More() {
+			var row map[string]interface{}
+			require.NoError(t, dec.Decode(&row))
+			decoded = append(decoded, row)
+		}
+	}
+	require.Len(t, decoded, 3)
+	assert.Equal(t, []interface{}{"x"}, decoded[0]["tags"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"city": "Berlin", "zip": "10115"}}, decoded[0]["offices"])
+	assert.Equal(t, []interface{}{float64(1)}, decoded[0]["extra"])
+	assert.Nil(t, decoded[1]["tags"])
+	assert.Equal(t, true, decoded[2]["active"])
+
+	assert.NoError(t, WriteArrow(&bytes.Buffer{}, []cufinder.FclCompany{}, ArrowOptions{}))
+	assert.NoError(t, WriteArrow(&bytes.Buffer{}, []cufinder.Company{{Name: "Acme"}}, ArrowOptions{}))
+}
+
+func TestWriteArrowSeq(t *testing.T) {
+	companies := map[string]cufinder.Company{"acme.com": {Name: "Acme"}, "globex.com": {Name: "Globex"}}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteArrowSeq(&buf, maps.Values(companies), ArrowOptions{}))
+	r, err := ipc.NewFileReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	defer r.Close()
+	rec, err := r.RecordBatch(0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), rec.NumRows())
+}
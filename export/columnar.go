@@ -0,0 +1,267 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/extensions"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+)
+
+// columnarJSON is the type of map and interface values, stored as JSON
+var columnarJSON, _ = extensions.NewJSONType(arrow.BinaryTypes.String)
+
+// arrowColumn is a field of an Arrow schema built from a Go type. Structs become
+// struct columns and slices become lists, named after their JSON keys like
+// Flatten; maps and interface values are stored as JSON. Pointers, slices,
+// maps and interfaces are nullable.
+type arrowColumn struct {
+	field arrow.Field
+	// index locates the field in its parent struct
+	index    []int
+	children []*arrowColumn
+	element  *arrowColumn
+}
+
+// columnarSchema derives the Arrow schema of a struct type
+func columnarSchema(t reflect.Type) (*arrow.Schema, []*arrowColumn, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, nil, fmt.Errorf("cannot export %s as columns: not a struct", t)
+	}
+	columns, err := structColumns(t, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(columns) == 0 {
+		return nil, nil, fmt.Errorf("cannot export %s as columns: no exported fields", t)
+	}
+	fields := make([]arrow.Field, len(columns))
+	for i, c := range columns {
+		fields[i] = c.field
+	}
+	return arrow.NewSchema(fields, nil), columns, nil
+}
+
+func structColumns(t reflect.Type, index []int) ([]*arrowColumn, error) {
+	var columns []*arrowColumn
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, ok := fieldName(field)
+		if !ok {
+			continue
+		}
+		fieldIndex := append(append([]int(nil), index...), i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			embedded, err := structColumns(field.Type, fieldIndex)
+			if err != nil {
+				return nil, err
+			}
+			columns = append(columns, embedded...)
+			continue
+		}
+
+		c, err := newColumn(name, field.Type, false)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", t.Name(), field.Name, err)
+		}
+		if c != nil {
+			c.index = fieldIndex
+			columns = append(columns, c)
+		}
+	}
+	return columns, nil
+}
+
+// newColumn returns the column of a value of type t, or nil for structs
+// without exported fields
+func newColumn(name string, t reflect.Type, nullable bool) (*arrowColumn, error) {
+	if t.Kind() == reflect.Pointer {
+		return newColumn(name, t.Elem(), true)
+	}
+
+	c := &arrowColumn{field: arrow.Field{Name: name, Nullable: nullable}}
+	switch t.Kind() {
+	case reflect.Bool:
+		c.field.Type = arrow.FixedWidthTypes.Boolean
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		c.field.Type = arrow.PrimitiveTypes.Int32
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		c.field.Type = arrow.PrimitiveTypes.Int64
+	case reflect.Float32:
+		c.field.Type = arrow.PrimitiveTypes.Float32
+	case reflect.Float64:
+		c.field.Type = arrow.PrimitiveTypes.Float64
+	case reflect.String:
+		c.field.Type = arrow.BinaryTypes.String
+	case reflect.Map, reflect.Interface:
+		c.field.Type, c.field.Nullable = columnarJSON, true
+	case reflect.Struct:
+		children, err := structColumns(t, nil)
+		if err != nil || len(children) == 0 {
+			return nil, err
+		}
+		fields := make([]arrow.Field, len(children))
+		for i, child := range children {
+			fields[i] = child.field
+		}
+		c.field.Type, c.children = arrow.StructOf(fields...), children
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			c.field.Type, c.field.Nullable = arrow.BinaryTypes.Binary, true
+			return c, nil
+		}
+		element, err := newColumn("element", t.Elem(), false)
+		if err != nil || element == nil {
+			return nil, err
+		}
+		c.field.Type, c.field.Nullable, c.element = arrow.ListOfField(element.field), true, element
+	default:
+		return nil, fmt.Errorf("unsupported type %s", t)
+	}
+	return c, nil
+}
+
+// appendValue appends v to the builder of column c and returns the
+// approximate number of bytes added
+func (c *arrowColumn) appendValue(b array.Builder, v reflect.Value) (int, error) {
+	if v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface || v.Kind() == reflect.Map {
+		if v.IsNil() {
+			b.AppendNull()
+			return 1, nil
+		}
+	}
+	if c.field.Type == columnarJSON {
+		data, err := json.Marshal(v.Interface())
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", c.field.Name, err)
+		}
+		b.(*array.ExtensionBuilder).StorageBuilder().(*array.StringBuilder).Append(string(data))
+		return len(data), nil
+	}
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+
+	switch b := b.(type) {
+	case *array.BooleanBuilder:
+		b.Append(v.Bool())
+		return 1, nil
+	case *array.Int32Builder:
+		b.Append(int32(intValue(v)))
+		return 4, nil
+	case *array.Int64Builder:
+		b.Append(intValue(v))
+		return 8, nil
+	case *array.Float32Builder:
+		b.Append(float32(v.Float()))
+		return 4, nil
+	case *array.Float64Builder:
+		b.Append(v.Float())
+		return 8, nil
+	case *array.StringBuilder:
+		b.Append(v.String())
+		return v.Len() + 4, nil
+	case *array.BinaryBuilder:
+		if v.IsNil() {
+			b.AppendNull()
+			return 1, nil
+		}
+		b.Append(v.Bytes())
+		return v.Len() + 4, nil
+	case *array.StructBuilder:
+		b.Append(true)
+		size := 0
+		for i, child := range c.children {
+			n, err := child.appendValue(b.FieldBuilder(i), v.FieldByIndex(child.index))
+			if err != nil {
+				return 0, err
+			}
+			size += n
+		}
+		return size, nil
+	case *array.ListBuilder:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			b.AppendNull()
+			return 1, nil
+		}
+		b.Append(true)
+		size := 4
+		for i := 0; i < v.Len(); i++ {
+			n, err := c.element.appendValue(b.ValueBuilder(), v.Index(i))
+			if err != nil {
+				return 0, err
+			}
+			size += n
+		}
+		return size, nil
+	}
+	return 0, fmt.Errorf("%s: unsupported builder %T", c.field.Name, b)
+}
+
+func intValue(v reflect.Value) int64 {
+	if v.CanInt() {
+		return v.Int()
+	}
+	return int64(v.Uint())
+}
+
+// recordBuffer collects rows of one struct type into Arrow record batches
+type recordBuffer struct {
+	rowType reflect.Type
+	schema  *arrow.Schema
+	columns []*arrowColumn
+	builder *array.RecordBuilder
+	rows    int
+	size    int
+}
+
+func newRecordBuffer(rowType reflect.Type) (*recordBuffer, error) {
+	for rowType.Kind() == reflect.Pointer {
+		rowType = rowType.Elem()
+	}
+	schema, columns, err := columnarSchema(rowType)
+	if err != nil {
+		return nil, err
+	}
+	return &recordBuffer{
+		rowType: rowType,
+		schema:  schema,
+		columns: columns,
+		builder: array.NewRecordBuilder(memory.DefaultAllocator, schema),
+	}, nil
+}
+
+// add appends a row, a value of the row type or a pointer to one
+func (r *recordBuffer) add(row interface{}) error {
+	v := reflect.ValueOf(row)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	if !v.IsValid() || v.Type() != r.rowType {
+		return fmt.Errorf("cannot export %T as %s", row, r.rowType)
+	}
+
+	for i, c := range r.columns {
+		n, err := c.appendValue(r.builder.Field(i), v.FieldByIndex(c.index))
+		if err != nil {
+			return err
+		}
+		r.size += n
+	}
+	r.rows++
+	return nil
+}
+
+// record returns the buffered rows as a record batch and resets the
+// buffer. The caller releases the batch.
+func (r *recordBuffer) record() arrow.RecordBatch {
+	r.rows, r.size = 0, 0
+	return r.builder.NewRecordBatch()
+}
+
+func (r *recordBuffer) release() {
+	r.builder.Release()
+}
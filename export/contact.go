@@ -0,0 +1,184 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cufinder/cufinder-go"
+	"github.com/cufinder/cufinder-go/linkedin"
+)
+
+// ErrUnsupported is returned for results that are not person types
+var ErrUnsupported = errors.New("unsupported result type")
+
+// Contact is the common form of the person results written as vCards and
+// JSON-LD
+type Contact struct {
+	FullName  string
+	FirstName string
+	LastName  string
+	Title     string
+	Summary   string
+	Email     string
+	Phone     string
+	// Photo is the URL of the avatar or profile picture
+	Photo   string
+	City    string
+	State   string
+	Country string
+	// Profiles are social profile URLs keyed by network ("linkedin",
+	// "twitter", "facebook", "github")
+	Profiles     map[string]string
+	Organization Organization
+}
+
+// Organization is the employer of a contact
+type Organization struct {
+	Name     string
+	Website  string
+	LinkedIn string
+	Industry string
+}
+
+// Name returns the display name of a contact, falling back to the first
+// and last name, the email address and the organization
+func (c Contact) Name() string {
+	if name := strings.TrimSpace(c.FullName); name != "" {
+		return name
+	}
+	if name := strings.TrimSpace(c.FirstName + " " + c.LastName); name != "" {
+		return name
+	}
+	if c.Email != "" {
+		return c.Email
+	}
+	return c.Organization.Name
+}
+
+// Networks lists the supported social networks in output order
+var Networks = []string{"linkedin", "twitter", "facebook", "github"}
+
+var networkBases = map[string]string{
+	"twitter":  "https://twitter.com/",
+	"facebook": "https://www.facebook.com/",
+	"github":   "https://github.com/",
+}
+
+// NewContact converts an EppPerson, TepPerson, RelPerson or Person (as a
+// value or pointer)
+func NewContact(person interface{}) (Contact, error) {
+	switch p := person.(type) {
+	case cufinder.EppPerson:
+		return NewContact(&p)
+	case cufinder.TepPerson:
+		return NewContact(&p)
+	case cufinder.RelPerson:
+		return NewContact(&p)
+	case cufinder.Person:
+		return NewContact(&p)
+	case *cufinder.EppPerson:
+		c := Contact{
+			FullName: p.FullName, FirstName: p.FirstName, LastName: p.LastName,
+			Title: p.JobTitle, Summary: p.Summary, Photo: p.Avatar,
+			City: p.City, State: p.State, Country: p.Country,
+			Organization: Organization{Name: p.CompanyName, Website: p.CompanyWebsite, LinkedIn: p.CompanyLinkedIn, Industry: p.CompanyIndustry},
+		}
+		c.setProfiles(p.LinkedInURL, p.Twitter, p.Facebook, "")
+		return c, nil
+	case *cufinder.TepPerson:
+		c := Contact{
+			FullName: p.FullName, FirstName: p.FirstName, LastName: p.LastName,
+			Title: p.JobTitle, Summary: p.Summary, Email: p.Email, Phone: p.Phone, Photo: p.Avatar,
+			City: p.City, State: p.State, Country: p.Country,
+			Organization: Organization{Name: p.CompanyName, Website: p.CompanyWebsite, LinkedIn: p.CompanyLinkedIn, Industry: p.CompanyIndustry},
+		}
+		c.setProfiles(p.LinkedInURL, p.Twitter, p.Facebook, "")
+		return c, nil
+	case *cufinder.RelPerson:
+		c := Contact{
+			FullName: p.FullName, FirstName: p.FirstName, LastName: p.LastName,
+			Title: p.JobTitle, Summary: p.Summary, Photo: p.Avatar,
+			City: p.City, State: p.State, Country: p.Country,
+			Organization: Organization{Name: p.CompanyName, Website: p.CompanyWebsite, LinkedIn: p.CompanyLinkedIn, Industry: p.CompanyIndustry},
+		}
+		c.setProfiles(p.LinkedInURL, p.Twitter, p.Facebook, "")
+		return c, nil
+	case *cufinder.Person:
+		website := p.Company.Website
+		if website == "" {
+			website = p.Company.Domain
+		}
+		companyLinkedIn := p.Company.LinkedInURL
+		if companyLinkedIn == "" {
+			companyLinkedIn = p.Company.Social.LinkedIn
+		}
+		profile := p.Social.LinkedIn
+		if profile == "" && p.Social.LinkedinUsername != "" {
+			profile = "https://www.linkedin.com/in/" + p.Social.LinkedinUsername
+		}
+		c := Contact{
+			FullName: p.FullName, FirstName: p.FirstName, LastName: p.LastName,
+			Title: p.CurrentJob.Title, Summary: p.Overview, Photo: p.Logo,
+			City: p.Location.City, State: p.Location.State, Country: p.Location.Country,
+			Organization: Organization{Name: p.Company.Name, Website: website, LinkedIn: companyLinkedIn, Industry: p.Company.Industry},
+		}
+		c.setProfiles(profile, p.Social.Twitter, p.Social.Facebook, p.Social.Github)
+		return c, nil
+	}
+	return Contact{}, fmt.Errorf("%w: %T", ErrUnsupported, person)
+}
+
+// Contacts converts the people of a PSE response
+func Contacts(response *cufinder.PseResponse) []Contact {
+	contacts := make([]Contact, 0, len(response.Peoples))
+	for i := range response.Peoples {
+		c, _ := NewContact(&response.Peoples[i])
+		contacts = append(contacts, c)
+	}
+	return contacts
+}
+
+func (c *Contact) setProfiles(linkedIn, twitter, facebook, github string) {
+	values := map[string]string{"linkedin": linkedIn, "twitter": twitter, "facebook": facebook, "github": github}
+	for network, value := range values {
+		if u := profileURL(network, value); u != "" {
+			if c.Profiles == nil {
+				c.Profiles = make(map[string]string)
+			}
+			c.Profiles[network] = u
+		}
+	}
+}
+
+// profileURL turns a profile URL or handle into an absolute URL
+func profileURL(network, value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ""
+	}
+	if network == "linkedin" {
+		if u, err := linkedin.Parse(value); err == nil {
+			return u.String()
+		}
+	}
+	if strings.Contains(value, "://") {
+		return value
+	}
+	if strings.Contains(value, "/") {
+		return "https://" + value
+	}
+	if base, ok := networkBases[network]; ok {
+		return base + strings.TrimPrefix(value, "@")
+	}
+	return ""
+}
+
+// websiteURL turns a domain or website into an absolute URL
+func websiteURL(website string) string {
+	website = strings.TrimSpace(website)
+	if website == "" || strings.Contains(website, "://") {
+		return website
+	}
+	return "https://" + website
+}
@@ -0,0 +1,121 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/cufinder/cufinder-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var tepPerson = cufinder.TepPerson{
+	FullName:       "Jane Doe",
+	FirstName:      "Jane",
+	LastName:       "Doe",
+	JobTitle:       "VP, Sales; EMEA",
+	CompanyName:    "Acme",
+	CompanyWebsite: "acme.com",
+	Email:          "jane@acme.com",
+	Phone:          "+1 (415) 555-2671",
+	LinkedInURL:    "linkedin.com/in/janedoe/",
+	Twitter:        "@janedoe",
+	Avatar:         "https://cdn.example.com/jane.png",
+	City:           "San Francisco",
+	State:          "California",
+	Country:        "United States",
+}
+
+func TestNewContact(t *testing.T) {
+	c, err := NewContact(tepPerson)
+	require.NoError(t, err)
+	assert.Equal(t, "Jane Doe", c.Name())
+	assert.Equal(t, "https://www.linkedin.com/in/janedoe", c.Profiles["linkedin"])
+	assert.Equal(t, "https://twitter.com/janedoe", c.Profiles["twitter"])
+	assert.Equal(t, "jane@acme.com", c.Email)
+
+	person := cufinder.Person{
+		FirstName:  "John",
+		LastName:   "Roe",
+		Logo:       "https://cdn.example.com/john.png",
+		CurrentJob: cufinder.PeopleCurrentJob{Title: "Engineer"},
+		Company:    cufinder.Company{Name: "Globex", Domain: "globex.com"},
+		Social:     cufinder.PeopleSocial{LinkedinUsername: "johnroe", Github: "johnroe"},
+	}
+	c, err = NewContact(&person)
+	require.NoError(t, err)
+	assert.Equal(t, "John Roe", c.Name())
+	assert.Equal(t, "Engineer", c.Title)
+	assert.Equal(t, "https://cdn.example.com/john.png", c.Photo)
+	assert.Equal(t, "globex.com", c.Organization.Website)
+	assert.Equal(t, "https://www.linkedin.com/in/johnroe", c.Profiles["linkedin"])
+	assert.Equal(t, "https://github.com/johnroe", c.Profiles["github"])
+
+	_, err = NewContact(cufinder.Company{})
+	assert.ErrorIs(t, err, ErrUnsupported)
+
+	contacts := Contacts(&cufinder.PseResponse{Peoples: []cufinder.Person{person, {FullName: "Ann Lee"}}})
+	require.Len(t, contacts, 2)
+	assert.Equal(t, "Ann Lee", contacts[1].Name())
+}
+
+func TestVCard(t *testing.T) {
+	c, err := NewContact(&tepPerson)
+	require.NoError(t, err)
+	card := c.VCard()
+
+	assert.True(t, strings.HasPrefix(card, "BEGIN:VCARD\r\nVERSION:4.0\r\n"))
+	assert.True(t, strings.HasSuffix(card, "END:VCARD\r\n"))
+	for _, line := range []string{
+		"FN:Jane Doe",
+		"N:Doe;Jane;;;",
+		`TITLE:VP\, Sales\; EMEA`,
+		"ORG:Acme",
+		"EMAIL;TYPE=work:jane@acme.com",
+		"TEL;VALUE=uri;TYPE=work:tel:+14155552671",
+		"ADR;TYPE=work:;;;San Francisco;California;;United States",
+		"PHOTO:https://cdn.example.com/jane.png",
+		"URL;TYPE=work:https://acme.com",
+		"X-SOCIALPROFILE;TYPE=linkedin:https://www.linkedin.com/in/janedoe",
+	} {
+		assert.Contains(t, card, line+"\r\n")
+	}
+
+	long := Contact{FullName: "Zoë", Summary: strings.Repeat("ü", 100)}
+	for _, line := range strings.Split(strings.TrimSuffix(long.VCard(), "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteVCards(&buf, []Contact{c, long}))
+	assert.Equal(t, 2, strings.Count(buf.String(), "BEGIN:VCARD"))
+}
+
+func TestJSONLD(t *testing.T) {
+	c, err := NewContact(tepPerson)
+	require.NoError(t, err)
+
+	data, err := c.MarshalJSONLD()
+	require.NoError(t, err)
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &doc))
+	assert.Equal(t, SchemaContext, doc["@context"])
+	assert.Equal(t, "Person", doc["@type"])
+	assert.Equal(t, "+14155552671", doc["telephone"])
+	assert.Equal(t, "US", doc["address"].(map[string]interface{})["addressCountry"])
+	assert.Equal(t, map[string]interface{}{"@type": "Organization", "name": "Acme", "url": "https://acme.com"}, doc["worksFor"])
+	assert.Equal(t, []interface{}{"https://www.linkedin.com/in/janedoe", "https://twitter.com/janedoe"}, doc["sameAs"])
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteJSONLD(&buf, []Contact{c, {FullName: "Ann Lee"}}))
+	var graph struct {
+		Context string     `json:"@context"`
+		Graph   []PersonLD `json:"@graph"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &graph))
+	require.Len(t, graph.Graph, 2)
+	assert.Equal(t, "Ann Lee", graph.Graph[1].Name)
+	assert.Empty(t, graph.Graph[1].Context)
+}
@@ -0,0 +1,104 @@
+// Package export writes CUFinder results as CSV files for CRM imports,
+// Parquet and Arrow files for data warehouses, and person results as vCards and
+// schema.org JSON-LD.
+//
+// Results are flattened to dotted field paths named after their JSON keys
+// ("name", "main_location.city", "technologies") and mapped to columns by a
+// Mapping. Bundled mappings produce HubSpot company and contact files and
+// Salesforce account, contact and lead files; custom mappings are loaded
+// from YAML or JSON:
+//
+//	name: my-crm
+//	columns:
+//	  - header: Company
+//	    fields: [name]
+//	  - header: Domain
+//	    fields: [domain, website]
+//	    format: domain
+//	  - header: Stack
+//	    fields: [technologies]
+//	    separator: " | "
+//	  - header: Source
+//	    value: CUFinder
+//
+// A nil mapping writes every field of the result type (see Generic).
+//
+//	err := export.WriteCSV(f, export.HubSpotCompanies, []cufinder.EncCompany{enc.Company})
+//
+// EppPerson, TepPerson, RelPerson and Person results convert to a Contact,
+// which is written as vCard 4.0 or JSON-LD; Contacts converts a whole PSE
+// search result:
+//
+//	err := export.WriteVCards(f, export.Contacts(pse))
+//
+// ParquetWriter and ArrowWriter stream results of any struct type into
+// Parquet and Arrow IPC files with the Apache Arrow Go library, using a
+// schema derived from the type; nested structs and slices are kept as
+// groups and lists.
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+// Writer writes results as CSV rows, starting with a header row
+type Writer struct {
+	csv     *csv.Writer
+	mapping *Mapping
+	started bool
+}
+
+// NewWriter creates a writer. With a nil mapping the columns are taken from
+// the type of the first result.
+func NewWriter(w io.Writer, mapping *Mapping) *Writer {
+	return &Writer{csv: csv.NewWriter(w), mapping: mapping}
+}
+
+// Write writes one result, a struct or pointer to a struct
+func (w *Writer) Write(result interface{}) error {
+	record, err := Flatten(result)
+	if err != nil {
+		return err
+	}
+
+	if !w.started {
+		if w.mapping == nil {
+			w.mapping = Generic(result)
+		}
+		if err := w.csv.Write(w.mapping.Headers()); err != nil {
+			return fmt.Errorf("failed to write CSV header: %w", err)
+		}
+		w.started = true
+	}
+
+	if err := w.csv.Write(w.mapping.RecordRow(record)); err != nil {
+		return fmt.Errorf("failed to write CSV row: %w", err)
+	}
+	return nil
+}
+
+// Flush writes buffered rows to the underlying writer
+func (w *Writer) Flush() error {
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+// WriteCSV writes results with a mapping and flushes. With a nil mapping
+// and no results only an empty file is written.
+func WriteCSV[T any](w io.Writer, mapping *Mapping, results []T) error {
+	writer := NewWriter(w, mapping)
+	if len(results) == 0 && mapping != nil {
+		writer.started = true
+		if err := writer.csv.Write(mapping.Headers()); err != nil {
+			return fmt.Errorf("failed to write CSV header: %w", err)
+		}
+	}
+	for i := range results {
+		if err := writer.Write(&results[i]); err != nil {
+			return err
+		}
+	}
+	return writer.Flush()
+}
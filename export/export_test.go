@@ -0,0 +1,193 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cufinder/cufinder-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readCSV(t *testing.T, data []byte) [][]string {
+	t.Helper()
+	rows, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	require.NoError(t, err)
+	return rows
+}
+
+func column(t *testing.T, rows [][]string, row int, header string) string {
+	t.Helper()
+	for i, h := range rows[0] {
+		if h == header {
+			return rows[row][i]
+		}
+	}
+	t.Fatalf("no %q column", header)
+	return ""
+}
+
+func TestFlatten(t *testing.T) {
+	person := cufinder.Person{
+		FirstName:  "Jane",
+		Skills:     []string{"go", "sql"},
+		Experience: map[string]interface{}{"years": 4},
+		Experiences: []cufinder.PeopleExperience{
+			{Company: cufinder.PeopleExperienceCompany{Name: "Acme"}},
+			{Company: cufinder.PeopleExperienceCompany{Name: "Globex"}},
+		},
+		Company: cufinder.Company{Name: "Acme", MainLocation: cufinder.MainLocation{City: "Berlin"}},
+	}
+
+	record, err := Flatten(&person)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Jane"}, record["first_name"])
+	assert.Equal(t, []string{"go", "sql"}, record["skills"])
+	assert.Equal(t, []string{`{"years":4}`}, record["experience"])
+	assert.Equal(t, []string{"Acme", "Globex"}, record["experiences.Company.name"])
+	assert.Equal(t, []string{"Berlin"}, record["company.main_location.city"])
+	assert.NotContains(t, record, "last_name")
+
+	_, err = Flatten("text")
+	assert.Error(t, err)
+	_, err = Flatten((*cufinder.Person)(nil))
+	assert.Error(t, err)
+}
+
+func TestPaths(t *testing.T) {
+	paths := Paths(cufinder.Company{})
+	assert.Contains(t, paths, "main_location.city")
+	assert.Contains(t, paths, "employees.count")
+	assert.Contains(t, paths, "technologies")
+	assert.NotContains(t, paths, "main_location")
+	assert.Nil(t, Paths(42))
+}
+
+func TestHubSpotCompanies(t *testing.T) {
+	companies := []cufinder.Company{{
+		Name:         "Acme",
+		Website:      "https://www.acme.com/about",
+		Employees:    cufinder.CompanyEmployees{Count: 250},
+		MainLocation: cufinder.MainLocation{City: "Austin", State: "TX", Country: "US"},
+		Social:       cufinder.CompanySocial{LinkedIn: "linkedin.com/company/acme/"},
+		Technologies: []string{"React", "Go"},
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteCSV(&buf, HubSpotCompanies, companies))
+	rows := readCSV(t, buf.Bytes())
+	require.Len(t, rows, 2)
+	assert.Equal(t, "acme.com", column(t, rows, 1, "Company Domain Name"))
+	assert.Equal(t, "250", column(t, rows, 1, "Number of Employees"))
+	assert.Equal(t, "Austin", column(t, rows, 1, "City"))
+	assert.Equal(t, "United States", column(t, rows, 1, "Country/Region"))
+	assert.Equal(t, "https://www.linkedin.com/company/acme", column(t, rows, 1, "LinkedIn Company Page"))
+	assert.Equal(t, "React;Go", column(t, rows, 1, "Web Technologies"))
+}
+
+func TestSalesforceAccountsFromEnrichment(t *testing.T) {
+	companies := []cufinder.EncCompany{{Name: "Acme", Domain: "acme.com", EmployeeCount: 40, City: "Leeds", Country: "UK"}}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteCSV(&buf, SalesforceAccounts, companies))
+	rows := readCSV(t, buf.Bytes())
+	assert.Equal(t, "40", column(t, rows, 1, "NumberOfEmployees"))
+	assert.Equal(t, "Leeds", column(t, rows, 1, "BillingCity"))
+	assert.Equal(t, "United Kingdom", column(t, rows, 1, "BillingCountry"))
+}
+
+func TestContactsAndLeads(t *testing.T) {
+	tep := cufinder.TepPerson{FirstName: "Jane", LastName: "Doe", Email: "jane@acme.com", JobTitle: "CTO", CompanyName: "Acme"}
+	person := cufinder.Person{
+		FullName:   "John Roe",
+		CurrentJob: cufinder.PeopleCurrentJob{Title: "Engineer"},
+		Company:    cufinder.Company{Name: "Globex", Website: "http://globex.com"},
+	}
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf, SalesforceLeads)
+	require.NoError(t, w.Write(tep))
+	require.NoError(t, w.Write(&person))
+	require.NoError(t, w.Flush())
+
+	rows := readCSV(t, buf.Bytes())
+	require.Len(t, rows, 3)
+	assert.Equal(t, "CTO", column(t, rows, 1, "Title"))
+	assert.Equal(t, "CUFinder", column(t, rows, 1, "LeadSource"))
+	assert.Equal(t, "John Roe", column(t, rows, 2, "LastName"))
+	assert.Equal(t, "Engineer", column(t, rows, 2, "Title"))
+	assert.Equal(t, "Globex", column(t, rows, 2, "Company"))
+	assert.Equal(t, "globex.com", column(t, rows, 2, "Website"))
+
+	buf.Reset()
+	require.NoError(t, WriteCSV(&buf, HubSpotContacts, []cufinder.TepPerson{tep}))
+	rows = readCSV(t, buf.Bytes())
+	assert.Equal(t, "jane@acme.com", column(t, rows, 1, "Email"))
+}
+
+func TestGeneric(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteCSV(&buf, nil, []cufinder.FclCompany{{Name: "Acme", EmployeeCount: 3}}))
+	rows := readCSV(t, buf.Bytes())
+	require.Len(t, rows, 2)
+	assert.Equal(t, "name", rows[0][0])
+	assert.Equal(t, "Acme", column(t, rows, 1, "name"))
+	assert.Equal(t, "3", column(t, rows, 1, "employee_count"))
+	assert.Equal(t, "", column(t, rows, 1, "website"))
+
+	buf.Reset()
+	require.NoError(t, WriteCSV[cufinder.FclCompany](&buf, nil, nil))
+	assert.Empty(t, buf.String())
+
+	buf.Reset()
+	require.NoError(t, WriteCSV[cufinder.FclCompany](&buf, SalesforceAccounts, nil))
+	assert.Len(t, readCSV(t, buf.Bytes()), 1)
+}
+
+func TestParseMapping(t *testing.T) {
+	m, err := ParseMapping([]byte(`
+name: custom
+columns:
+  - header: Account
+    fields: [company_name, name]
+  - header: Stack
+    fields: [technologies]
+    separator: " | "
+  - header: Source
+    value: API
+`))
+	require.NoError(t, err)
+	row, err := m.Row(cufinder.Company{Name: "Acme", Technologies: []string{"Go", "Rust"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Acme", "Go | Rust", "API"}, row)
+
+	// JSON is valid YAML
+	_, err = ParseMapping([]byte(`{"columns":[{"header":"Name","fields":["name"]}]}`))
+	assert.NoError(t, err)
+
+	invalid := []string{
+		`columns: []`,
+		`columns: [{fields: [name]}]`,
+		`columns: [{header: Name}]`,
+		`columns: [{header: Name, fields: [name], format: upper}]`,
+		`columns: {`,
+	}
+	for _, data := range invalid {
+		_, err := ParseMapping([]byte(data))
+		assert.Error(t, err, data)
+	}
+}
+
+func TestLoadMapping(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mapping.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("columns:\n  - header: Name\n    fields: [name]\n"), 0o600))
+	m, err := LoadMapping(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Name"}, m.Headers())
+
+	_, err = LoadMapping(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+}
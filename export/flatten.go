@@ -0,0 +1,143 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Record is a result flattened to dotted field paths named after the JSON
+// keys, as in "main_location.city". Every path holds a list of values: one
+// for plain fields, one per element for slices such as "technologies" and
+// "experiences.Company.name". Fields without a JSON tag keep their Go
+// name. Zero values are left out.
+type Record map[string][]string
+
+// Flatten flattens a struct or pointer to a struct
+func Flatten(v interface{}) (Record, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, fmt.Errorf("cannot flatten a nil %s", rv.Type())
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot flatten %s: not a struct", rv.Type())
+	}
+
+	record := make(Record)
+	flattenStruct(record, "", rv)
+	return record, nil
+}
+
+// Paths lists the field paths of a struct type in declaration order. It is
+// used for the columns of generic exports.
+func Paths(v interface{}) []string {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+	var paths []string
+	typePaths(&paths, "", t)
+	return paths
+}
+
+func flattenStruct(record Record, prefix string, v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, ok := fieldName(field)
+		if !ok {
+			continue
+		}
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			flattenStruct(record, prefix, v.Field(i))
+			continue
+		}
+		flattenValue(record, prefix+name, v.Field(i))
+	}
+}
+
+func flattenValue(record Record, path string, v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		if v.Kind() == reflect.Interface {
+			// Untyped fields hold decoded JSON, which is kept as JSON
+			if data, err := json.Marshal(v.Interface()); err == nil && !isScalar(v.Elem()) {
+				record[path] = append(record[path], string(data))
+				return
+			}
+		}
+		flattenValue(record, path, v.Elem())
+	case reflect.Struct:
+		flattenStruct(record, path+".", v)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			flattenValue(record, path, v.Index(i))
+		}
+	case reflect.Map:
+		if v.Len() > 0 {
+			if data, err := json.Marshal(v.Interface()); err == nil {
+				record[path] = append(record[path], string(data))
+			}
+		}
+	default:
+		if !v.IsZero() {
+			record[path] = append(record[path], fmt.Sprint(v.Interface()))
+		}
+	}
+}
+
+func typePaths(paths *[]string, prefix string, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, ok := fieldName(field)
+		if !ok {
+			continue
+		}
+
+		ft := field.Type
+		for ft.Kind() == reflect.Pointer || ft.Kind() == reflect.Slice || ft.Kind() == reflect.Array {
+			ft = ft.Elem()
+		}
+		switch {
+		case field.Anonymous && ft.Kind() == reflect.Struct:
+			typePaths(paths, prefix, ft)
+		case ft.Kind() == reflect.Struct:
+			typePaths(paths, prefix+name+".", ft)
+		default:
+			*paths = append(*paths, prefix+name)
+		}
+	}
+}
+
+// fieldName returns the JSON name of an exported field
+func fieldName(field reflect.StructField) (string, bool) {
+	if !field.IsExported() {
+		return "", false
+	}
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name, true
+	}
+	return field.Name, true
+}
+
+func isScalar(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array, reflect.Struct:
+		return false
+	}
+	return true
+}
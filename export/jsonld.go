@@ -0,0 +1,109 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/cufinder/cufinder-go/phone"
+)
+
+// SchemaContext is the JSON-LD context of schema.org documents
+const SchemaContext = "https://schema.org"
+
+// PersonLD is a schema.org Person
+type PersonLD struct {
+	Context    string          `json:"@context,omitempty"`
+	Type       string          `json:"@type"`
+	Name       string          `json:"name,omitempty"`
+	GivenName  string          `json:"givenName,omitempty"`
+	FamilyName string          `json:"familyName,omitempty"`
+	JobTitle   string          `json:"jobTitle,omitempty"`
+	Email      string          `json:"email,omitempty"`
+	Telephone  string          `json:"telephone,omitempty"`
+	Image      string          `json:"image,omitempty"`
+	Address    *AddressLD      `json:"address,omitempty"`
+	WorksFor   *OrganizationLD `json:"worksFor,omitempty"`
+	SameAs     []string        `json:"sameAs,omitempty"`
+}
+
+// OrganizationLD is a schema.org Organization
+type OrganizationLD struct {
+	Context string   `json:"@context,omitempty"`
+	Type    string   `json:"@type"`
+	Name    string   `json:"name,omitempty"`
+	URL     string   `json:"url,omitempty"`
+	SameAs  []string `json:"sameAs,omitempty"`
+}
+
+// AddressLD is a schema.org PostalAddress
+type AddressLD struct {
+	Type            string `json:"@type"`
+	AddressLocality string `json:"addressLocality,omitempty"`
+	AddressRegion   string `json:"addressRegion,omitempty"`
+	AddressCountry  string `json:"addressCountry,omitempty"`
+}
+
+// JSONLD returns the contact as a schema.org Person
+func (c Contact) JSONLD() PersonLD {
+	p := PersonLD{
+		Type:       "Person",
+		Name:       c.Name(),
+		GivenName:  c.FirstName,
+		FamilyName: c.LastName,
+		JobTitle:   c.Title,
+		Email:      c.Email,
+		Telephone:  c.Phone,
+		Image:      c.Photo,
+	}
+	if e164, ok := phone.Normalize(c.Phone, ""); ok {
+		p.Telephone = e164
+	}
+	if c.City != "" || c.State != "" || c.Country != "" {
+		p.Address = &AddressLD{
+			Type:            "PostalAddress",
+			AddressLocality: c.City,
+			AddressRegion:   c.State,
+			AddressCountry:  FormatCountryCode.apply(c.Country),
+		}
+	}
+	if org := c.Organization; org.Name != "" || org.Website != "" {
+		p.WorksFor = &OrganizationLD{Type: "Organization", Name: org.Name, URL: websiteURL(org.Website)}
+		if u := profileURL("linkedin", org.LinkedIn); u != "" {
+			p.WorksFor.SameAs = []string{u}
+		}
+	}
+	for _, network := range Networks {
+		if u := c.Profiles[network]; u != "" {
+			p.SameAs = append(p.SameAs, u)
+		}
+	}
+	return p
+}
+
+// MarshalJSONLD returns the contact as a standalone JSON-LD document
+func (c Contact) MarshalJSONLD() ([]byte, error) {
+	p := c.JSONLD()
+	p.Context = SchemaContext
+	return json.Marshal(p)
+}
+
+// WriteJSONLD writes contacts as one JSON-LD document with a @graph of
+// schema.org Person nodes
+func WriteJSONLD(w io.Writer, contacts []Contact) error {
+	graph := make([]PersonLD, 0, len(contacts))
+	for _, c := range contacts {
+		graph = append(graph, c.JSONLD())
+	}
+	doc := struct {
+		Context string     `json:"@context"`
+		Graph   []PersonLD `json:"@graph"`
+	}{SchemaContext, graph}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("failed to write JSON-LD: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package export
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/cufinder/cufinder-go"
+	"github.com/cufinder/cufinder-go/linkedin"
+)
+
+// Format transforms a column value
+type Format string
+
+const (
+	// FormatNone writes values as they are
+	FormatNone Format = ""
+	// FormatDomain reduces websites to their canonical domain
+	FormatDomain Format = "domain"
+	// FormatLinkedIn writes canonical LinkedIn URLs
+	FormatLinkedIn Format = "linkedin"
+	// FormatCountryCode writes ISO 3166-1 alpha-2 country codes
+	FormatCountryCode Format = "country_code"
+	// FormatCountryName writes English country names
+	FormatCountryName Format = "country_name"
+)
+
+// Formats lists every Format
+var Formats = []Format{FormatNone, FormatDomain, FormatLinkedIn, FormatCountryCode, FormatCountryName}
+
+// Column is one output column
+type Column struct {
+	Header string `json:"header" yaml:"header"`
+	// Fields are field paths tried in order; the first with a value is
+	// used, so one mapping can serve several result types
+	Fields []string `json:"fields,omitempty" yaml:"fields,omitempty"`
+	// Value is written when no field has a value, or always when Fields is
+	// empty
+	Value string `json:"value,omitempty" yaml:"value,omitempty"`
+	// Separator joins the values of slices. Defaults to ";".
+	Separator string `json:"separator,omitempty" yaml:"separator,omitempty"`
+	Format    Format `json:"format,omitempty" yaml:"format,omitempty"`
+}
+
+// Mapping maps flattened results to CSV columns
+type Mapping struct {
+	Name    string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Columns []Column `json:"columns" yaml:"columns"`
+}
+
+// ParseMapping reads a mapping from YAML or JSON and validates it
+func ParseMapping(data []byte) (*Mapping, error) {
+	var m Mapping
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse export mapping: %w", err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// LoadMapping reads a mapping from a YAML or JSON file
+func LoadMapping(path string) (*Mapping, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read export mapping: %w", err)
+	}
+	return ParseMapping(data)
+}
+
+// Validate checks that every column has a header and a source
+func (m *Mapping) Validate() error {
+	if len(m.Columns) == 0 {
+		return fmt.Errorf("export mapping has no columns")
+	}
+	for i, c := range m.Columns {
+		known := false
+		for _, f := range Formats {
+			if c.Format == f {
+				known = true
+			}
+		}
+		switch {
+		case c.Header == "":
+			return fmt.Errorf("column %d: header is required", i+1)
+		case len(c.Fields) == 0 && c.Value == "":
+			return fmt.Errorf("%s: column needs fields or a value", c.Header)
+		case !known:
+			return fmt.Errorf("%s: unknown format %q", c.Header, c.Format)
+		}
+	}
+	return nil
+}
+
+// Headers returns the column headers
+func (m *Mapping) Headers() []string {
+	headers := make([]string, len(m.Columns))
+	for i, c := range m.Columns {
+		headers[i] = c.Header
+	}
+	return headers
+}
+
+// Row returns the column values for a result
+func (m *Mapping) Row(result interface{}) ([]string, error) {
+	record, err := Flatten(result)
+	if err != nil {
+		return nil, err
+	}
+	return m.RecordRow(record), nil
+}
+
+// RecordRow returns the column values for a flattened result
+func (m *Mapping) RecordRow(record Record) []string {
+	row := make([]string, len(m.Columns))
+	for i, c := range m.Columns {
+		row[i] = c.value(record)
+	}
+	return row
+}
+
+func (c Column) value(record Record) string {
+	for _, field := range c.Fields {
+		values := record[field]
+		if len(values) == 0 {
+			continue
+		}
+		formatted := make([]string, 0, len(values))
+		for _, v := range values {
+			formatted = append(formatted, c.Format.apply(v))
+		}
+		separator := c.Separator
+		if separator == "" {
+			separator = ";"
+		}
+		return strings.Join(formatted, separator)
+	}
+	return c.Value
+}
+
+func (f Format) apply(value string) string {
+	switch f {
+	case FormatDomain:
+		if domain, ok := cufinder.CanonicalDomain(value); ok {
+			return domain
+		}
+	case FormatLinkedIn:
+		if u, err := linkedin.Parse(value); err == nil {
+			return u.String()
+		}
+	case FormatCountryCode:
+		if code, ok := cufinder.LookupCountry(value); ok {
+			return code
+		}
+	case FormatCountryName:
+		if code, ok := cufinder.LookupCountry(value); ok {
+			name, _ := cufinder.CountryName(code)
+			return name
+		}
+	}
+	return value
+}
+
+// Generic returns a mapping with one column per field path of a result
+// type, headed by the path
+func Generic(result interface{}) *Mapping {
+	m := &Mapping{Name: "generic"}
+	for _, path := range Paths(result) {
+		m.Columns = append(m.Columns, Column{Header: path, Fields: []string{path}})
+	}
+	return m
+}
+
+//go:embed mappings/*.yaml
+var bundled embed.FS
+
+// Bundled mappings for CRM import files. Their fields cover the company
+// types (EncCompany, Company, FclCompany) or the person types (TepPerson,
+// EppPerson, RelPerson, Person).
+var (
+	HubSpotCompanies   = mustBundled("hubspot_companies.yaml")
+	HubSpotContacts    = mustBundled("hubspot_contacts.yaml")
+	SalesforceAccounts = mustBundled("salesforce_accounts.yaml")
+	SalesforceContacts = mustBundled("salesforce_contacts.yaml")
+	SalesforceLeads    = mustBundled("salesforce_leads.yaml")
+)
+
+func mustBundled(name string) *Mapping {
+	data, err := bundled.ReadFile("mappings/" + name)
+	if err != nil {
+		panic(err)
+	}
+	m, err := ParseMapping(data)
+	if err != nil {
+		panic(fmt.Sprintf("%s: %v", name, err))
+	}
+	return m
+}
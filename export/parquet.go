@@ -0,0 +1,168 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/apache/arrow-go/v18/parquet/schema"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+// ParquetOptions configures a ParquetWriter
+type ParquetOptions struct {
+	// RowGroupRows is the maximum number of rows in a row group. Defaults
+	// to 10000.
+	RowGroupRows int
+	// RowGroupBytes ends a row group once its buffered values reach about
+	// this size. Defaults to 64 MiB.
+	RowGroupBytes int
+}
+
+func (o ParquetOptions) withDefaults() ParquetOptions {
+	if o.RowGroupRows <= 0 {
+		o.RowGroupRows = 10000
+	}
+	if o.RowGroupBytes <= 0 {
+		o.RowGroupBytes = 64 << 20
+	}
+	return o
+}
+
+// ParquetWriter streams results of one type into a Parquet file with the
+// Apache Arrow Parquet writer. The schema is derived from the type the same
+// way as Flatten: JSON field names, nested structs as groups and slices as
+// LIST groups, so it stays stable across files. Column chunks are Snappy
+// compressed, and the Arrow schema is stored in the file metadata.
+//
+//	pw, err := export.NewParquetWriter[cufinder.Company](f, export.ParquetOptions{})
+//	for _, company := range lbs.Companies {
+//		err = pw.Write(company)
+//	}
+//	err = pw.Close()
+type ParquetWriter[T any] struct {
+	buf    *recordBuffer
+	fw     *pqarrow.FileWriter
+	schema *schema.Schema
+	opts   ParquetOptions
+	closed bool
+}
+
+// NewParquetWriter creates a writer for results of type T, which must be
+// a struct
+func NewParquetWriter[T any](w io.Writer, opts ParquetOptions) (*ParquetWriter[T], error) {
+	return newParquetWriter[T](w, reflect.TypeOf((*T)(nil)).Elem(), opts)
+}
+
+// NewParquetWriterFor creates a writer for results of a struct type known
+// only at run time. Write takes values of rowType or pointers to them.
+func NewParquetWriterFor(w io.Writer, rowType reflect.Type, opts ParquetOptions) (*ParquetWriter[any], error) {
+	return newParquetWriter[any](w, rowType, opts)
+}
+
+func newParquetWriter[T any](w io.Writer, rowType reflect.Type, opts ParquetOptions) (*ParquetWriter[T], error) {
+	buf, err := newRecordBuffer(rowType)
+	if err != nil {
+		return nil, err
+	}
+	opts = opts.withDefaults()
+
+	props := parquet.NewWriterProperties(
+		parquet.WithCompression(compress.Codecs.Snappy),
+		parquet.WithMaxRowGroupLength(int64(opts.RowGroupRows)),
+		parquet.WithCreatedBy("cufinder-go version "+cufinder.Version),
+	)
+	arrowProps := pqarrow.NewArrowWriterProperties(pqarrow.WithStoreSchema())
+	pqSchema, err := pqarrow.ToParquet(buf.schema, props, arrowProps)
+	if err != nil {
+		buf.release()
+		return nil, fmt.Errorf("failed to create Parquet schema: %w", err)
+	}
+	// The Parquet writer closes its sink, which is left to the caller
+	fw, err := pqarrow.NewFileWriter(buf.schema, struct{ io.Writer }{w}, props, arrowProps)
+	if err != nil {
+		buf.release()
+		return nil, fmt.Errorf("failed to create Parquet writer: %w", err)
+	}
+	return &ParquetWriter[T]{buf: buf, fw: fw, schema: pqSchema, opts: opts}, nil
+}
+
+// Schema returns the Parquet schema in message notation
+func (pw *ParquetWriter[T]) Schema() string {
+	var b strings.Builder
+	schema.PrintSchema(pw.schema.Root(), &b, 2)
+	// Field IDs are not assigned
+	s := strings.ReplaceAll(b.String(), " field_id=-1", "")
+	return strings.Replace(s, "repeated group schema", "message schema", 1)
+}
+
+// Write adds a row, ending the row group when it is full
+func (pw *ParquetWriter[T]) Write(row T) error {
+	if pw.closed {
+		return fmt.Errorf("parquet writer is closed")
+	}
+	if err := pw.buf.add(row); err != nil {
+		return err
+	}
+	if pw.buf.rows >= pw.opts.RowGroupRows || pw.buf.size >= pw.opts.RowGroupBytes {
+		return pw.Flush()
+	}
+	return nil
+}
+
+// Flush writes the buffered rows as a row group
+func (pw *ParquetWriter[T]) Flush() error {
+	if pw.buf.rows == 0 {
+		return nil
+	}
+	rec := pw.buf.record()
+	defer rec.Release()
+	if err := pw.fw.Write(rec); err != nil {
+		return fmt.Errorf("failed to write Parquet row group: %w", err)
+	}
+	return nil
+}
+
+// Close flushes the last row group and writes the file footer. It does not
+// close the underlying writer.
+func (pw *ParquetWriter[T]) Close() error {
+	if pw.closed {
+		return nil
+	}
+	if err := pw.Flush(); err != nil {
+		return err
+	}
+	pw.closed = true
+	pw.buf.release()
+	if err := pw.fw.Close(); err != nil {
+		return fmt.Errorf("failed to write Parquet footer: %w", err)
+	}
+	return nil
+}
+
+// WriteParquet writes results as a Parquet file
+func WriteParquet[T any](w io.Writer, results []T, opts ParquetOptions) error {
+	return WriteParquetSeq(w, slices.Values(results), opts)
+}
+
+// WriteParquetSeq writes the results of an iterator as a Parquet file, so large
+// result sets are streamed without collecting them in a slice first
+func WriteParquetSeq[T any](w io.Writer, results iter.Seq[T], opts ParquetOptions) error {
+	pw, err := NewParquetWriter[T](w, opts)
+	if err != nil {
+		return err
+	}
+	for result := range results {
+		if err := pw.Write(result); err != nil {
+			return err
+		}
+	}
+	return pw.Close()
+}
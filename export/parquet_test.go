@@ -0,0 +1,205 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+type pqOffice struct {
+	City string  `json:"city"`
+	Zip  *string `json:"zip"`
+}
+
+type pqRow struct {
+	Name    string      `json:"name"`
+	Tags    []string    `json:"tags"`
+	Offices []pqOffice  `json:"offices"`
+	Score   *float64    `json:"score"`
+	Active  bool        `json:"active"`
+	Extra   interface{} `json:"extra"`
+	hidden  string
+}
+
+// readParquet reads a file with the Arrow Parquet reader and returns its
+// metadata and rows as JSON objects
+func readParquet(t *testing.T, data []byte) (*file.Reader, []map[string]interface{}) {
+	r, err := file.NewParquetReader(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	fr, err := pqarrow.NewFileReader(r, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	table, err := fr.ReadTable(context.Background())
+	require.NoError(t, err)
+	defer table.Release()
+
+	tr := array.NewTableReader(table, -1)
+	defer tr.Release()
+	var rows []map[string]interface{}
+	for tr.Next() {
+		var buf bytes.Buffer
+		require.NoError(t, array.RecordToJSON(tr.RecordBatch(), &buf))
+		dec := json.NewDecoder(&buf)
+		for dec.More() {
+			var row map[string]interface{}
+			require.NoError(t, dec.Decode(&row))
+			rows = append(rows, row)
+		}
+	}
+	return r, rows
+}
+
+func TestParquetSchema(t *testing.T) {
+	pw, err := NewParquetWriter[pqRow](&bytes.Buffer{}, ParquetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, `message schema {
+  required byte_array name (String);
+  optional group tags (List) {
+    repeated group list {
+      required byte_array element (String);
+    }
+  }
+  optional group offices (List) {
+    repeated group list {
+      required group element {
+        required byte_array city (String);
+        optional byte_array zip (String);
+      }
+    }
+  }
+  optional double score;
+  required boolean active;
+  optional byte_array extra (JSON);
+}
+`, pw.Schema())
+
+	_, err = NewParquetWriter[string](&bytes.Buffer{}, ParquetOptions{})
+	assert.Error(t, err)
+	_, err = NewParquetWriter[struct{ C chan int }](&bytes.Buffer{}, ParquetOptions{})
+	assert.Error(t, err)
+}
+
+func TestParquetNested(t *testing.T) {
+	zip, score := "10115", 1.5
+	rows := []pqRow{
+		{
+			Name:    "a",
+			Tags:    []string{"x", "y"},
+			Offices: []pqOffice{{City: "Berlin", Zip: &zip}, {City: "Paris"}},
+			Active:  true,
+			Extra:   map[string]int{"k": 1},
+		},
+		{Name: "b", Offices: []pqOffice{}, Score: &score},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteParquet(&buf, rows, ParquetOptions{}))
+
+	r, decoded := readParquet(t, buf.Bytes())
+	assert.Equal(t, int64(2), r.NumRows())
+	assert.True(t, strings.HasPrefix(r.MetaData().GetCreatedBy(), "cufinder-go"))
+	require.Len(t, decoded, 2)
+
+	// JSON columns read back as binary, which RecordToJSON writes in base64
+	extra, err := base64.StdEncoding.DecodeString(decoded[0]["extra"].(string))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"k":1}`, string(extra))
+	decoded[0]["extra"] = nil
+
+	assert.Equal(t, map[string]interface{}{
+		"name": "a",
+		"tags": []interface{}{"x", "y"},
+		"offices": []interface{}{
+			map[string]interface{}{"city": "Berlin", "zip": "10115"},
+			map[string]interface{}{"city": "Paris", "zip": nil},
+		},
+		"score":  nil,
+		"active": true,
+		"extra":  nil,
+	}, decoded[0])
+	assert.Equal(t, map[string]interface{}{
+		"name":    "b",
+		"tags":    nil,
+		"offices": []interface{}{},
+		"score":   1.5,
+		"active":  false,
+		"extra":   nil,
+	}, decoded[1])
+}
+
+func TestParquetRowGroups(t *testing.T) {
+	companies := []cufinder.Company{
+		{Name: "Acme", Technologies: []string{"Go"}, Employees: cufinder.CompanyEmployees{Count: 10}},
+		{Name: "Globex"},
+		{Name: "Initech", MainLocation: cufinder.MainLocation{City: "Austin"}},
+	}
+
+	var buf bytes.Buffer
+	pw, err := NewParquetWriter[cufinder.Company](&buf, ParquetOptions{RowGroupRows: 2})
+	require.NoError(t, err)
+	for _, c := range companies {
+		require.NoError(t, pw.Write(c))
+	}
+	require.NoError(t, pw.Close())
+	assert.Error(t, pw.Write(companies[0]))
+
+	r, decoded := readParquet(t, buf.Bytes())
+	require.Equal(t, 2, r.NumRowGroups())
+	assert.Equal(t, int64(2), r.MetaData().RowGroup(0).NumRows())
+	assert.Equal(t, int64(1), r.MetaData().RowGroup(1).NumRows())
+	require.Len(t, decoded, 3)
+	assert.Equal(t, "Initech", decoded[2]["name"])
+	assert.Equal(t, []interface{}{"Go"}, decoded[0]["technologies"])
+
+	// Every supported result type has a schema
+	assert.NoError(t, WriteParquet(&bytes.Buffer{}, []cufinder.Person{{FullName: "Jane"}}, ParquetOptions{}))
+	assert.NoError(t, WriteParquet(&bytes.Buffer{}, []cufinder.EncCompany{{Name: "Acme"}}, ParquetOptions{}))
+	assert.NoError(t, WriteParquet(&bytes.Buffer{}, []cufinder.TepPerson{{Email: "jane@acme.com"}}, ParquetOptions{}))
+	assert.NoError(t, WriteParquet(&bytes.Buffer{}, []cufinder.FclCompany{}, ParquetOptions{}))
+}
+
+func TestWriteParquetSeq(t *testing.T) {
+	// A generated sequence is written without building a slice
+	names := func(yield func(cufinder.Company) bool) {
+		for i := 0; i < 5; i++ {
+			if !yield(cufinder.Company{Name: "Company " + strconv.Itoa(i)}) {
+				return
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteParquetSeq(&buf, names, ParquetOptions{RowGroupRows: 2}))
+	r, decoded := readParquet(t, buf.Bytes())
+	assert.Equal(t, 3, r.NumRowGroups())
+	require.Len(t, decoded, 5)
+	assert.Equal(t, "Company 4", decoded[4]["name"])
+}
+
+func TestParquetWriterFor(t *testing.T) {
+	var buf bytes.Buffer
+	pw, err := NewParquetWriterFor(&buf, reflect.TypeOf(cufinder.Person{}), ParquetOptions{})
+	require.NoError(t, err)
+	require.NoError(t, pw.Write(&cufinder.Person{FullName: "Jane"}))
+	require.NoError(t, pw.Write(cufinder.Person{FullName: "John"}))
+	assert.Error(t, pw.Write(cufinder.Company{}))
+	require.NoError(t, pw.Close())
+
+	_, decoded := readParquet(t, buf.Bytes())
+	require.Len(t, decoded, 2)
+	assert.Equal(t, "John", decoded[1]["full_name"])
+}
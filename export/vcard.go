@@ -0,0 +1,106 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cufinder/cufinder-go/phone"
+)
+
+// WriteVCards writes contacts as vCard 4.0 (RFC 6350) cards, one after the
+// other as a single .vcf file
+func WriteVCards(w io.Writer, contacts []Contact) error {
+	bw := bufio.NewWriter(w)
+	for _, c := range contacts {
+		for _, line := range c.vcardLines() {
+			if _, err := bw.WriteString(fold(line)); err != nil {
+				return fmt.Errorf("failed to write vCard: %w", err)
+			}
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("failed to write vCard: %w", err)
+	}
+	return nil
+}
+
+// VCard returns the contact as a vCard 4.0 card
+func (c Contact) VCard() string {
+	var b strings.Builder
+	for _, line := range c.vcardLines() {
+		b.WriteString(fold(line))
+	}
+	return b.String()
+}
+
+func (c Contact) vcardLines() []string {
+	lines := []string{"BEGIN:VCARD", "VERSION:4.0", "KIND:individual", "FN:" + escapeText(c.Name())}
+	if c.FirstName != "" || c.LastName != "" {
+		lines = append(lines, "N:"+escapeText(c.LastName)+";"+escapeText(c.FirstName)+";;;")
+	}
+	if c.Title != "" {
+		lines = append(lines, "TITLE:"+escapeText(c.Title))
+	}
+	if c.Organization.Name != "" {
+		lines = append(lines, "ORG:"+escapeText(c.Organization.Name))
+	}
+	if c.Email != "" {
+		lines = append(lines, "EMAIL;TYPE=work:"+escapeText(c.Email))
+	}
+	if c.Phone != "" {
+		if e164, ok := phone.Normalize(c.Phone, ""); ok {
+			lines = append(lines, "TEL;VALUE=uri;TYPE=work:tel:"+e164)
+		} else {
+			lines = append(lines, "TEL;VALUE=text;TYPE=work:"+escapeText(c.Phone))
+		}
+	}
+	if c.City != "" || c.State != "" || c.Country != "" {
+		lines = append(lines, "ADR;TYPE=work:;;;"+escapeText(c.City)+";"+escapeText(c.State)+";;"+escapeText(FormatCountryName.apply(c.Country)))
+	}
+	if c.Photo != "" {
+		lines = append(lines, "PHOTO:"+c.Photo)
+	}
+	if website := websiteURL(c.Organization.Website); website != "" {
+		lines = append(lines, "URL;TYPE=work:"+website)
+	}
+	for _, network := range Networks {
+		if u := c.Profiles[network]; u != "" {
+			lines = append(lines, "X-SOCIALPROFILE;TYPE="+network+":"+u)
+		}
+	}
+	if c.Summary != "" {
+		lines = append(lines, "NOTE:"+escapeText(c.Summary))
+	}
+	return append(lines, "END:VCARD")
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ",", `\,`, ";", `\;`, "\r\n", `\n`, "\n", `\n`)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(strings.TrimSpace(s))
+}
+
+// fold splits a content line into lines of at most 75 octets without
+// breaking UTF-8 sequences, and terminates it with CRLF
+func fold(line string) string {
+	const limit = 75
+	var b strings.Builder
+	width := limit
+	for len(line) > width {
+		cut := width
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// continuation lines start with a space
+		width = limit - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+	return b.String()
+}
@@ -0,0 +1,254 @@
+// Package geo works with the coordinates in CLO locations and LBS/CSE
+// companies: great-circle distances, bounding-box and radius filters,
+// nearest-office lookup and grouping of a company's offices by region.
+//
+//	offices := geo.Offices(clo)
+//	office, ok := geo.Nearest(offices, geo.Point{Lat: 40.7128, Lon: -74.0060})
+//	nearby := geo.Within(geo.Companies(lbs.Companies), office.Place.Point, 25)
+//
+// Places without coordinates are kept for clustering but skipped by every
+// distance based function.
+package geo
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+// EarthRadius is the mean Earth radius in kilometres
+const EarthRadius = 6371.0088
+
+// Point is a latitude and longitude in degrees
+type Point struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+// String returns "lat,lon"
+func (p Point) String() string {
+	return fmt.Sprintf("%.6f,%.6f", p.Lat, p.Lon)
+}
+
+// Distance returns the great-circle distance between two points in
+// kilometres, using the haversine formula
+func Distance(a, b Point) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLat := lat2 - lat1
+	dLon := radians(b.Lon - a.Lon)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+func radians(deg float64) float64 { return deg * math.Pi / 180 }
+func degrees(rad float64) float64 { return rad * 180 / math.Pi }
+
+// BoundingBox is an area between two latitudes and two longitudes. When
+// MinLon is greater than MaxLon the box crosses the antimeridian.
+type BoundingBox struct {
+	MinLat float64 `json:"min_lat"`
+	MinLon float64 `json:"min_lon"`
+	MaxLat float64 `json:"max_lat"`
+	MaxLon float64 `json:"max_lon"`
+}
+
+// Contains reports whether p lies inside the box
+func (b BoundingBox) Contains(p Point) bool {
+	if p.Lat < b.MinLat || p.Lat > b.MaxLat {
+		return false
+	}
+	if b.MinLon <= b.MaxLon {
+		return p.Lon >= b.MinLon && p.Lon <= b.MaxLon
+	}
+	return p.Lon >= b.MinLon || p.Lon <= b.MaxLon
+}
+
+// BoxAround returns the smallest box containing every point within radius
+// kilometres of center
+func BoxAround(center Point, radius float64) BoundingBox {
+	d := degrees(radius / EarthRadius)
+	box := BoundingBox{MinLat: center.Lat - d, MaxLat: center.Lat + d}
+
+	if box.MinLat <= -90 || box.MaxLat >= 90 {
+		// The circle covers a pole, so every longitude is in range
+		box.MinLat, box.MaxLat = math.Max(box.MinLat, -90), math.Min(box.MaxLat, 90)
+		box.MinLon, box.MaxLon = -180, 180
+		return box
+	}
+
+	dLon := degrees(math.Asin(math.Min(1, math.Sin(radians(d))/math.Cos(radians(center.Lat)))))
+	box.MinLon, box.MaxLon = normalizeLon(center.Lon-dLon), normalizeLon(center.Lon+dLon)
+	return box
+}
+
+func normalizeLon(lon float64) float64 {
+	for lon < -180 {
+		lon += 360
+	}
+	for lon > 180 {
+		lon -= 360
+	}
+	return lon
+}
+
+// Place is an office or business with its address
+type Place struct {
+	Name    string                 `json:"name,omitempty"`
+	Address cufinder.PostalAddress `json:"address"`
+	Point   Point                  `json:"point"`
+	// Company is the LBS or CSE result the place was built from
+	Company *cufinder.Company `json:"company,omitempty"`
+}
+
+// HasPoint reports whether the place has coordinates
+func (p Place) HasPoint() bool {
+	return p.Address.HasCoordinates()
+}
+
+// Offices returns the locations of a CLO response as places
+func Offices(response *cufinder.CloResponse) []Place {
+	places := make([]Place, 0, len(response.Locations))
+	for _, location := range response.Locations {
+		places = append(places, newPlace("", location.PostalAddress(), nil))
+	}
+	return places
+}
+
+// Companies returns LBS or CSE companies as places located at their main
+// location
+func Companies(companies []cufinder.Company) []Place {
+	places := make([]Place, 0, len(companies))
+	for i := range companies {
+		places = append(places, newPlace(companies[i].Name, companies[i].PostalAddress(), &companies[i]))
+	}
+	return places
+}
+
+func newPlace(name string, address cufinder.PostalAddress, company *cufinder.Company) Place {
+	return Place{
+		Name:    name,
+		Address: address,
+		Point:   Point{Lat: address.Latitude, Lon: address.Longitude},
+		Company: company,
+	}
+}
+
+// Match is a place with its distance from a reference point in kilometres
+type Match struct {
+	Place    Place   `json:"place"`
+	Distance float64 `json:"distance_km"`
+}
+
+// Within returns the places within radius kilometres of center, nearest
+// first
+func Within(places []Place, center Point, radius float64) []Match {
+	box := BoxAround(center, radius)
+
+	var matches []Match
+	for _, place := range places {
+		if !place.HasPoint() || !box.Contains(place.Point) {
+			continue
+		}
+		if d := Distance(center, place.Point); d <= radius {
+			matches = append(matches, Match{Place: place, Distance: d})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Distance < matches[j].Distance })
+	return matches
+}
+
+// InBox returns the places inside a bounding box
+func InBox(places []Place, box BoundingBox) []Place {
+	var inside []Place
+	for _, place := range places {
+		if place.HasPoint() && box.Contains(place.Point) {
+			inside = append(inside, place)
+		}
+	}
+	return inside
+}
+
+// Nearest returns the place closest to p, or false when no place has
+// coordinates
+func Nearest(places []Place, p Point) (Match, bool) {
+	var best Match
+	found := false
+	for _, place := range places {
+		if !place.HasPoint() {
+			continue
+		}
+		if d := Distance(p, place.Point); !found || d < best.Distance {
+			best, found = Match{Place: place, Distance: d}, true
+		}
+	}
+	return best, found
+}
+
+// Cluster is a group of places in the same country and region
+type Cluster struct {
+	Country string  `json:"country"`
+	Region  string  `json:"region,omitempty"`
+	Places  []Place `json:"places"`
+	// Centroid is the geographic centre of the places with coordinates;
+	// HasCentroid is false when none has any
+	Centroid    Point `json:"centroid"`
+	HasCentroid bool  `json:"has_centroid"`
+}
+
+// ClusterByRegion groups places by country and region (state or
+// province), largest group first. Region names are compared
+// case-insensitively.
+func ClusterByRegion(places []Place) []Cluster {
+	index := make(map[string]int)
+	var clusters []Cluster
+	for _, place := range places {
+		key := strings.ToUpper(place.Address.Country) + "|" + strings.ToLower(strings.TrimSpace(place.Address.Region))
+		i, ok := index[key]
+		if !ok {
+			i = len(clusters)
+			index[key] = i
+			clusters = append(clusters, Cluster{Country: place.Address.Country, Region: strings.TrimSpace(place.Address.Region)})
+		}
+		clusters[i].Places = append(clusters[i].Places, place)
+	}
+
+	for i := range clusters {
+		clusters[i].Centroid, clusters[i].HasCentroid = centroid(clusters[i].Places)
+	}
+	sort.SliceStable(clusters, func(i, j int) bool {
+		if len(clusters[i].Places) != len(clusters[j].Places) {
+			return len(clusters[i].Places) > len(clusters[j].Places)
+		}
+		if clusters[i].Country != clusters[j].Country {
+			return clusters[i].Country < clusters[j].Country
+		}
+		return clusters[i].Region < clusters[j].Region
+	})
+	return clusters
+}
+
+// centroid averages the points as vectors on the unit sphere, which stays
+// correct across the antimeridian
+func centroid(places []Place) (Point, bool) {
+	var x, y, z float64
+	n := 0
+	for _, place := range places {
+		if !place.HasPoint() {
+			continue
+		}
+		lat, lon := radians(place.Point.Lat), radians(place.Point.Lon)
+		x += math.Cos(lat) * math.Cos(lon)
+		y += math.Cos(lat) * math.Sin(lon)
+		z += math.Sin(lat)
+		n++
+	}
+	if n == 0 {
+		return Point{}, false
+	}
+	x, y, z = x/float64(n), y/float64(n), z/float64(n)
+	return Point{Lat: degrees(math.Atan2(z, math.Hypot(x, y))), Lon: degrees(math.Atan2(y, x))}, true
+}
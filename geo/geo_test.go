@@ -0,0 +1,103 @@
+package geo
+
+import (
+	"testing"
+
+	"github.com/cufinder/cufinder-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	sanFrancisco = Point{Lat: 37.7749, Lon: -122.4194}
+	newYork      = Point{Lat: 40.7128, Lon: -74.0060}
+	london       = Point{Lat: 51.5074, Lon: -0.1278}
+	paris        = Point{Lat: 48.8566, Lon: 2.3522}
+)
+
+func TestDistance(t *testing.T) {
+	assert.InDelta(t, 4129, Distance(sanFrancisco, newYork), 5)
+	assert.InDelta(t, 343.5, Distance(london, paris), 2)
+	assert.Zero(t, Distance(paris, paris))
+	// Across the antimeridian
+	assert.InDelta(t, 111.2, Distance(Point{0, 179.5}, Point{0, -179.5}), 0.5)
+}
+
+func TestBoundingBox(t *testing.T) {
+	box := BoxAround(london, 400)
+	assert.True(t, box.Contains(paris))
+	assert.False(t, box.Contains(newYork))
+
+	wrapped := BoxAround(Point{Lat: 0, Lon: 179.9}, 50)
+	assert.Greater(t, wrapped.MinLon, wrapped.MaxLon)
+	assert.True(t, wrapped.Contains(Point{Lat: 0, Lon: -179.9}))
+	assert.False(t, wrapped.Contains(Point{Lat: 0, Lon: 0}))
+
+	polar := BoxAround(Point{Lat: 89.9, Lon: 0}, 100)
+	assert.Equal(t, 90.0, polar.MaxLat)
+	assert.True(t, polar.Contains(Point{Lat: 89.95, Lon: 180}))
+}
+
+func cloResponse() *cufinder.CloResponse {
+	return &cufinder.CloResponse{Locations: []cufinder.CloLocation{
+		{Country: "United States", State: "California", City: "San Francisco", Latitude: "37.7749", Longitude: "-122.4194"},
+		{Country: "United States", State: "california", City: "Los Angeles", Latitude: "34.0522", Longitude: "-118.2437"},
+		{Country: "United States", State: "New York", City: "New York", Latitude: "40.7128", Longitude: "-74.0060"},
+		{Country: "UK", City: "London", Latitude: "51.5074", Longitude: "-0.1278"},
+		{Country: "Germany", State: "Berlin", City: "Berlin"},
+	}}
+}
+
+func TestNearestAndWithin(t *testing.T) {
+	offices := Offices(cloResponse())
+	require.Len(t, offices, 5)
+
+	match, ok := Nearest(offices, paris)
+	require.True(t, ok)
+	assert.Equal(t, "London", match.Place.Address.City)
+	assert.InDelta(t, 343.5, match.Distance, 2)
+
+	nearby := Within(offices, sanFrancisco, 600)
+	require.Len(t, nearby, 2)
+	assert.Equal(t, "San Francisco", nearby[0].Place.Address.City)
+	assert.Equal(t, "Los Angeles", nearby[1].Place.Address.City)
+
+	inside := InBox(offices, BoundingBox{MinLat: 30, MinLon: -125, MaxLat: 45, MaxLon: -70})
+	assert.Len(t, inside, 3)
+
+	_, ok = Nearest(nil, paris)
+	assert.False(t, ok)
+}
+
+func TestCompanies(t *testing.T) {
+	companies := []cufinder.Company{
+		{Name: "Cafe A", MainLocation: cufinder.MainLocation{Geo: "40.7130,-74.0070"}},
+		{Name: "Cafe B", MainLocation: cufinder.MainLocation{Geo: "40.7580,-73.9855"}},
+		{Name: "Cafe C"},
+	}
+	places := Companies(companies)
+	matches := Within(places, newYork, 2)
+	require.Len(t, matches, 1)
+	assert.Equal(t, "Cafe A", matches[0].Place.Name)
+	assert.Equal(t, "Cafe A", matches[0].Place.Company.Name)
+}
+
+func TestClusterByRegion(t *testing.T) {
+	clusters := ClusterByRegion(Offices(cloResponse()))
+	require.Len(t, clusters, 4)
+
+	assert.Equal(t, "US", clusters[0].Country)
+	assert.Equal(t, "California", clusters[0].Region)
+	assert.Len(t, clusters[0].Places, 2)
+	assert.True(t, clusters[0].HasCentroid)
+	assert.InDelta(t, 35.9, clusters[0].Centroid.Lat, 0.2)
+
+	var berlin Cluster
+	for _, c := range clusters {
+		if c.Country == "DE" {
+			berlin = c
+		}
+	}
+	assert.Len(t, berlin.Places, 1)
+	assert.False(t, berlin.HasCentroid)
+}
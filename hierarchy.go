@@ -0,0 +1,296 @@
+package cufinder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// CorporateTreeConfig configures a corporate hierarchy build
+type CorporateTreeConfig struct {
+	// Root is the parent company's domain or name
+	Root string
+	// Depth is how many levels of subsidiaries to fetch below the root
+	Depth int
+	// CountryCode is passed to CUF when resolving subsidiary names to
+	// domains. Defaults to "US".
+	CountryCode string
+	// MaxCredits caps the credits spent on the build; zero means no limit
+	MaxCredits int
+}
+
+// CorporateNode is a company in a corporate tree
+type CorporateNode struct {
+	Name     string           `json:"name"`
+	Domain   string           `json:"domain,omitempty"`
+	Depth    int              `json:"depth"`
+	Children []*CorporateNode `json:"children,omitempty"`
+	// Duplicate marks a company that already appears elsewhere in the tree.
+	// Its subsidiaries are listed under the first occurrence only.
+	Duplicate bool `json:"duplicate,omitempty"`
+	// Cycle marks a duplicate that is also an ancestor of its parent
+	Cycle bool `json:"cycle,omitempty"`
+	// Truncated marks a company whose subsidiaries were not fetched because
+	// the credit cap was reached or the build was cancelled
+	Truncated bool `json:"truncated,omitempty"`
+	// Error holds the FCC error for a company whose subsidiaries could not
+	// be fetched
+	Error string `json:"error,omitempty"`
+
+	parent *CorporateNode
+}
+
+// key identifies a company across the tree, preferring its domain
+func (n *CorporateNode) key() string {
+	if n.Domain != "" {
+		return n.Domain
+	}
+	return nameKey(n.Name)
+}
+
+// query is the FCC query for the company
+func (n *CorporateNode) query() string {
+	if n.Domain != "" {
+		return n.Domain
+	}
+	return n.Name
+}
+
+// CorporateTree is the result of a corporate hierarchy build
+type CorporateTree struct {
+	Root    *CorporateNode `json:"root"`
+	Calls   int            `json:"calls"`
+	Credits int            `json:"credits"`
+}
+
+// Companies returns every distinct company in the tree, level by level
+func (t *CorporateTree) Companies() []*CorporateNode {
+	var companies []*CorporateNode
+	queue := []*CorporateNode{t.Root}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		if node.Duplicate {
+			continue
+		}
+		companies = append(companies, node)
+		queue = append(queue, node.Children...)
+	}
+	return companies
+}
+
+// JSON returns the tree as indented JSON
+func (t *CorporateTree) JSON() ([]byte, error) {
+	return json.MarshalIndent(t, "", "  ")
+}
+
+// DOT returns the tree as a Graphviz digraph. Duplicates point to the first
+// occurrence of the company, with cycles drawn as dashed edges.
+func (t *CorporateTree) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph corporate_tree {\n")
+	b.WriteString("\trankdir=LR;\n")
+	b.WriteString("\tnode [shape=box];\n")
+
+	for _, node := range t.Companies() {
+		label := node.Name
+		if node.Domain != "" {
+			label += "\n" + node.Domain
+		}
+		attrs := "label=" + dotQuote(label)
+		if node.Truncated || node.Error != "" {
+			attrs += ", style=dotted"
+		}
+		fmt.Fprintf(&b, "\t%s [%s];\n", dotQuote(node.key()), attrs)
+	}
+
+	for _, node := range t.Companies() {
+		for _, child := range node.Children {
+			edge := fmt.Sprintf("\t%s -> %s", dotQuote(node.key()), dotQuote(child.key()))
+			if child.Cycle {
+				edge += " [style=dashed]"
+			}
+			b.WriteString(edge + ";\n")
+		}
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dotQuote quotes a Graphviz ID, keeping newlines as line breaks
+func dotQuote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + r.Replace(s) + `"`
+}
+
+// nameKey normalizes a company name for duplicate detection
+func nameKey(name string) string {
+	return "name:" + strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+// BuildCorporateTree recursively fetches the subsidiaries of root, a domain
+// or company name, down to the given depth
+func (s *Service) BuildCorporateTree(ctx context.Context, root string, depth int) (*CorporateTree, error) {
+	return s.BuildCorporateTreeWithConfig(ctx, CorporateTreeConfig{Root: root, Depth: depth})
+}
+
+// BuildCorporateTreeWithConfig recursively fetches subsidiaries with FCC,
+// level by level, resolving every company to a domain with CUF (or to a name
+// with DTC for a root domain). Companies seen before are kept as duplicate
+// leaves so cycles terminate.
+//
+// When the credit cap is reached or ctx is cancelled, the partial tree is
+// returned together with ErrCreditLimit or the context error. Unexplored
+// companies are marked Truncated.
+func (s *Service) BuildCorporateTreeWithConfig(ctx context.Context, config CorporateTreeConfig) (*CorporateTree, error) {
+	if strings.TrimSpace(config.Root) == "" {
+		return nil, fmt.Errorf("root is required")
+	}
+	if config.Depth < 0 {
+		return nil, fmt.Errorf("depth must not be negative")
+	}
+	if config.CountryCode == "" {
+		config.CountryCode = "US"
+	}
+
+	b := &treeBuilder{
+		service: s,
+		config:  config,
+		budget:  creditBudget{max: config.MaxCredits},
+		seen:    make(map[string]*CorporateNode),
+	}
+	tree, err := b.build(ctx)
+	tree.Calls, tree.Credits = b.budget.calls, b.budget.used
+	return tree, err
+}
+
+// treeBuilder holds the state of one corporate tree build
+type treeBuilder struct {
+	service *Service
+	config  CorporateTreeConfig
+	budget  creditBudget
+	seen    map[string]*CorporateNode
+}
+
+func (b *treeBuilder) build(ctx context.Context) (*CorporateTree, error) {
+	root := &CorporateNode{Name: strings.TrimSpace(b.config.Root)}
+	if domain, ok := CanonicalDomain(root.Name); ok {
+		root.Domain = domain
+	}
+	tree := &CorporateTree{Root: root}
+
+	if err := b.budget.proceed(ctx); err != nil {
+		root.Truncated = true
+		return tree, err
+	}
+	b.resolve(root)
+	b.register(root)
+
+	queue := []*CorporateNode{root}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		if node.Depth >= b.config.Depth {
+			continue
+		}
+
+		if err := b.budget.proceed(ctx); err != nil {
+			node.Truncated = true
+			for _, n := range queue {
+				if n.Depth < b.config.Depth {
+					n.Truncated = true
+				}
+			}
+			return tree, err
+		}
+
+		response, err := b.service.GetSubsidiaries(FccParams{Query: node.query()})
+		b.budget.spend(response, err)
+		if err != nil {
+			if node == root {
+				return tree, err
+			}
+			node.Error = err.Error()
+			continue
+		}
+
+		for _, name := range response.Subsidiaries {
+			if child := b.child(ctx, node, name); child != nil {
+				queue = append(queue, child)
+			}
+		}
+	}
+
+	return tree, nil
+}
+
+// child adds a subsidiary to node, returning it when it should be explored
+func (b *treeBuilder) child(ctx context.Context, parent *CorporateNode, name string) *CorporateNode {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil
+	}
+	child := &CorporateNode{Name: name, Depth: parent.Depth + 1, parent: parent}
+
+	// Skip the CUF call for names already in the tree
+	existing, ok := b.seen[nameKey(name)]
+	if !ok && b.budget.proceed(ctx) == nil {
+		b.resolve(child)
+		existing, ok = b.seen[child.key()]
+	}
+
+	if ok {
+		for _, sibling := range parent.Children {
+			if sibling.key() == existing.key() {
+				return nil
+			}
+		}
+		child.Name, child.Domain = existing.Name, existing.Domain
+		child.Duplicate = true
+		for n := parent; n != nil; n = n.parent {
+			if n == existing {
+				child.Cycle = true
+				break
+			}
+		}
+		parent.Children = append(parent.Children, child)
+		return nil
+	}
+
+	b.register(child)
+	parent.Children = append(parent.Children, child)
+	return child
+}
+
+// resolve fills in the missing domain or name of a company. Resolution
+// failures are not fatal; the company is then keyed by what is known.
+func (b *treeBuilder) resolve(node *CorporateNode) {
+	if node.Domain != "" {
+		response, err := b.service.GetCompanyName(DtcParams{CompanyWebsite: node.Domain})
+		b.budget.spend(response, err)
+		if err != nil {
+			return
+		}
+		if response.CompanyName != "" {
+			node.Name = response.CompanyName
+		}
+		return
+	}
+
+	response, err := b.service.GetDomain(CufParams{CompanyName: node.Name, CountryCode: b.config.CountryCode})
+	b.budget.spend(response, err)
+	if err != nil {
+		return
+	}
+	if domain, ok := CanonicalDomain(response.Domain); ok {
+		node.Domain = domain
+	}
+}
+
+// register records a company under its domain and name keys
+func (b *treeBuilder) register(node *CorporateNode) {
+	b.seen[node.key()] = node
+	b.seen[nameKey(node.Name)] = node
+}
@@ -0,0 +1,115 @@
+package cufinder
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func corporateEndpoints() map[string]fakeEndpoint {
+	subsidiaries := map[string][]string{
+		"holding.com": {"Alpha Corp", "Beta Corp", "Alpha Corp"},
+		"alpha.com":   {"Gamma Corp", "Holding Group"},
+		"beta.com":    {"Gamma Corp"},
+		"gamma.com":   {"Delta Corp"},
+	}
+	domains := map[string]string{
+		"Alpha Corp":    "alpha.com",
+		"Beta Corp":     "www.beta.com",
+		"Gamma Corp":    "gamma.com",
+		"Delta Corp":    "delta.com",
+		"Holding Group": "holding.com",
+	}
+
+	return map[string]fakeEndpoint{
+		"/dtc": func(form url.Values) map[string]interface{} {
+			return map[string]interface{}{"company_name": "Holding Group"}
+		},
+		"/cuf": func(form url.Values) map[string]interface{} {
+			if domain, ok := domains[form.Get("company_name")]; ok {
+				return map[string]interface{}{"domain": domain}
+			}
+			return nil
+		},
+		"/fcc": func(form url.Values) map[string]interface{} {
+			return map[string]interface{}{"subsidiaries": subsidiaries[form.Get("query")], "credit_count": 1}
+		},
+	}
+}
+
+func TestBuildCorporateTree(t *testing.T) {
+	sdk, calls := newWorkflowSDK(t, corporateEndpoints())
+
+	tree, err := sdk.BuildCorporateTree(context.Background(), "https://holding.com", 3)
+	require.NoError(t, err)
+
+	root := tree.Root
+	assert.Equal(t, "Holding Group", root.Name)
+	assert.Equal(t, "holding.com", root.Domain)
+	require.Len(t, root.Children, 2, "repeated subsidiaries are listed once")
+
+	alpha, beta := root.Children[0], root.Children[1]
+	assert.Equal(t, "alpha.com", alpha.Domain)
+	assert.Equal(t, "beta.com", beta.Domain)
+
+	require.Len(t, alpha.Children, 2)
+	gamma, holding := alpha.Children[0], alpha.Children[1]
+	assert.False(t, gamma.Duplicate)
+	assert.True(t, holding.Duplicate)
+	assert.True(t, holding.Cycle)
+
+	require.Len(t, beta.Children, 1)
+	assert.True(t, beta.Children[0].Duplicate)
+	assert.False(t, beta.Children[0].Cycle)
+
+	require.Len(t, gamma.Children, 1)
+	assert.Equal(t, 3, gamma.Children[0].Depth)
+
+	var domains []string
+	for _, c := range tree.Companies() {
+		domains = append(domains, c.Domain)
+	}
+	assert.Equal(t, []string{"holding.com", "alpha.com", "beta.com", "gamma.com", "delta.com"}, domains)
+
+	// Gamma is resolved once and delta is at the depth limit
+	assert.Equal(t, 4, strings.Count(strings.Join(*calls, " "), "/fcc?"))
+	assert.Equal(t, len(*calls), tree.Calls)
+
+	data, err := tree.JSON()
+	require.NoError(t, err)
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "Holding Group", decoded["root"].(map[string]interface{})["name"])
+
+	dot := tree.DOT()
+	assert.Contains(t, dot, `"holding.com" [label="Holding Group\nholding.com"];`)
+	assert.Contains(t, dot, `"alpha.com" -> "holding.com" [style=dashed];`)
+	assert.Contains(t, dot, `"beta.com" -> "gamma.com";`)
+}
+
+func TestBuildCorporateTreeCreditCap(t *testing.T) {
+	sdk, _ := newWorkflowSDK(t, corporateEndpoints())
+
+	tree, err := sdk.BuildCorporateTreeWithConfig(context.Background(), CorporateTreeConfig{
+		Root:       "holding.com",
+		Depth:      3,
+		MaxCredits: 4,
+	})
+	assert.ErrorIs(t, err, ErrCreditLimit)
+	require.NotNil(t, tree)
+	assert.Equal(t, 4, tree.Credits)
+
+	// DTC, FCC and two CUF calls leave the subsidiaries unexplored
+	require.Len(t, tree.Root.Children, 2)
+	assert.True(t, tree.Root.Children[0].Truncated)
+	assert.True(t, tree.Root.Children[1].Truncated)
+	assert.Empty(t, tree.Root.Children[0].Children)
+
+	_, err = sdk.BuildCorporateTree(context.Background(), " ", 1)
+	assert.Error(t, err)
+}
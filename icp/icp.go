@@ -0,0 +1,214 @@
+// Package icp matches companies against an ideal customer profile taken from
+// a CSN company snapshot.
+//
+// Given your own snapshot, a Matcher scores how well a candidate company
+// fits the industries, personas and value proposition you sell to, and
+// Prospects drives SearchCompanies and SearchPeople to build a ranked
+// prospect list:
+//
+//	own, _ := sdk.CSN("yourcompany.com")
+//	m := icp.NewMatcher(own.CompanySnapshot)
+//	list, err := m.Prospects(ctx, cufinder.NewService(sdk.GetClient()), icp.ProspectConfig{Country: "united states"})
+package icp
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+// Fit weights; together they bound the score to 1
+const (
+	industryWeight = 0.5
+	personaWeight  = 0.2
+	keywordWeight  = 0.3
+)
+
+// Candidate is the data a company is matched on
+type Candidate struct {
+	Name        string
+	Industry    string
+	Description string
+	// Snapshot is the candidate's own CSN snapshot, when fetched
+	Snapshot *cufinder.CsnSnapshotInfo
+}
+
+// FromENC builds a candidate from ENC data and an optional CSN snapshot
+func FromENC(company cufinder.EncCompany, snapshot *cufinder.CsnSnapshotInfo) Candidate {
+	return Candidate{Name: company.Name, Industry: company.Industry, Description: company.Description, Snapshot: snapshot}
+}
+
+// FromCompany builds a candidate from a CSE or LBS search result
+func FromCompany(company cufinder.Company, snapshot *cufinder.CsnSnapshotInfo) Candidate {
+	description := company.Description
+	if description == "" {
+		description = company.Overview
+	}
+	return Candidate{Name: company.Name, Industry: company.Industry, Description: description, Snapshot: snapshot}
+}
+
+// text returns everything known about what the candidate does
+func (c Candidate) text() string {
+	parts := []string{c.Industry, c.Description}
+	if c.Snapshot != nil {
+		parts = append(parts, c.Snapshot.ICP, c.Snapshot.ValueProposition)
+		parts = append(parts, c.Snapshot.TargetIndustries...)
+		parts = append(parts, c.Snapshot.TargetPersonas...)
+	}
+	return strings.Join(parts, " ")
+}
+
+// Fit is how well a candidate matches the profile
+type Fit struct {
+	// Score combines the components between 0 and 1
+	Score    float64 `json:"score"`
+	Industry float64 `json:"industry"`
+	Persona  float64 `json:"persona"`
+	Keyword  float64 `json:"keyword"`
+
+	MatchedIndustries []string `json:"matched_industries,omitempty"`
+	MatchedPersonas   []string `json:"matched_personas,omitempty"`
+	MatchedKeywords   []string `json:"matched_keywords,omitempty"`
+}
+
+// Matcher scores candidates against your own CSN snapshot
+type Matcher struct {
+	profile  cufinder.CsnSnapshotInfo
+	keywords []string
+}
+
+// NewMatcher creates a matcher for the customers described by a snapshot
+func NewMatcher(profile cufinder.CsnSnapshotInfo) *Matcher {
+	return &Matcher{
+		profile:  profile,
+		keywords: Keywords(profile.ValueProposition + " " + profile.ICP),
+	}
+}
+
+// Profile returns the snapshot the matcher was created with
+func (m *Matcher) Profile() cufinder.CsnSnapshotInfo {
+	return m.profile
+}
+
+// Fit scores a candidate. The industry component is 1 when the candidate's
+// industry is one of the target industries; the persona and keyword
+// components are the share of target personas and value proposition
+// keywords mentioned in what the candidate does.
+func (m *Matcher) Fit(c Candidate) Fit {
+	var fit Fit
+	words := wordSet(c.text())
+
+	for _, industry := range m.profile.TargetIndustries {
+		if industryMatches(industry, c.Industry) {
+			fit.MatchedIndustries = append(fit.MatchedIndustries, industry)
+		}
+	}
+	if len(fit.MatchedIndustries) > 0 {
+		fit.Industry = 1
+	}
+
+	for _, persona := range m.profile.TargetPersonas {
+		for _, keyword := range Keywords(persona) {
+			if words[keyword] {
+				fit.MatchedPersonas = append(fit.MatchedPersonas, persona)
+				break
+			}
+		}
+	}
+	if n := len(m.profile.TargetPersonas); n > 0 {
+		fit.Persona = float64(len(fit.MatchedPersonas)) / float64(n)
+	}
+
+	for _, keyword := range m.keywords {
+		if words[keyword] {
+			fit.MatchedKeywords = append(fit.MatchedKeywords, keyword)
+		}
+	}
+	if n := len(m.keywords); n > 0 {
+		fit.Keyword = float64(len(fit.MatchedKeywords)) / float64(n)
+	}
+
+	fit.Score = industryWeight*fit.Industry + personaWeight*fit.Persona + keywordWeight*fit.Keyword
+	return fit
+}
+
+// industryMatches compares industries by their significant words, so
+// "Software" matches "Software Development"
+func industryMatches(target, industry string) bool {
+	a, b := Keywords(target), wordSet(industry)
+	if len(a) == 0 || len(b) == 0 {
+		return false
+	}
+	for _, w := range a {
+		if !b[w] {
+			return false
+		}
+	}
+	return true
+}
+
+// stopwords are ignored when extracting keywords
+var stopwords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true, "be": true,
+	"by": true, "for": true, "from": true, "help": true, "helps": true, "in": true,
+	"into": true, "is": true, "it": true, "its": true, "of": true, "on": true, "or": true,
+	"our": true, "that": true, "the": true, "their": true, "them": true, "they": true,
+	"this": true, "to": true, "we": true, "who": true, "with": true, "without": true,
+	"you": true, "your": true, "companies": true, "company": true, "teams": true,
+	"team": true, "businesses": true, "business": true,
+}
+
+// Keywords returns the distinct significant lower-case words of a text, in
+// order of appearance
+func Keywords(text string) []string {
+	var keywords []string
+	seen := make(map[string]bool)
+	for _, w := range words(text) {
+		if len(w) < 3 || stopwords[w] || seen[w] {
+			continue
+		}
+		seen[w] = true
+		keywords = append(keywords, w)
+	}
+	return keywords
+}
+
+// words splits text into lower-case words, trimming a plural "s"
+func words(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, w := range fields {
+		if len(w) > 3 && strings.HasSuffix(w, "s") && !strings.HasSuffix(w, "ss") {
+			fields[i] = strings.TrimSuffix(w, "s")
+		}
+	}
+	return fields
+}
+
+func wordSet(text string) map[string]bool {
+	set := make(map[string]bool)
+	for _, w := range words(text) {
+		set[w] = true
+	}
+	return set
+}
+
+// SearchIndustries maps the target industries to the CSE industry values
+// they correspond to
+func (m *Matcher) SearchIndustries() []cufinder.Industry {
+	var industries []cufinder.Industry
+	seen := make(map[cufinder.Industry]bool)
+	for _, target := range m.profile.TargetIndustries {
+		for _, industry := range cufinder.Industries {
+			if !seen[industry] && industryMatches(target, string(industry)) {
+				seen[industry] = true
+				industries = append(industries, industry)
+			}
+		}
+	}
+	sort.Slice(industries, func(i, j int) bool { return industries[i] < industries[j] })
+	return industries
+}
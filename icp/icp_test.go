@@ -0,0 +1,157 @@
+package icp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+var ownSnapshot = cufinder.CsnSnapshotInfo{
+	ICP:              "Fast-growing fintech companies with in-house engineering teams",
+	TargetIndustries: []string{"Financial Services", "Banking"},
+	TargetPersonas:   []string{"VP of Engineering", "Heads of Payments", "Compliance officers"},
+	ValueProposition: "Payments infrastructure with built-in fraud detection",
+}
+
+func TestFit(t *testing.T) {
+	m := NewMatcher(ownSnapshot)
+
+	fit := m.Fit(FromENC(cufinder.EncCompany{
+		Industry:    "Financial Services",
+		Description: "Online payments and fraud detection for merchants",
+	}, &cufinder.CsnSnapshotInfo{TargetPersonas: []string{"Head of compliance"}}))
+
+	assert.Equal(t, []string{"Financial Services"}, fit.MatchedIndustries)
+	assert.Equal(t, []string{"Heads of Payments", "Compliance officers"}, fit.MatchedPersonas)
+	assert.Equal(t, []string{"payment", "fraud", "detection"}, fit.MatchedKeywords)
+	assert.Equal(t, 1.0, fit.Industry)
+	assert.InDelta(t, 2.0/3, fit.Persona, 1e-9)
+	assert.InDelta(t, 0.5+0.2*2/3+0.3*fit.Keyword, fit.Score, 1e-9)
+
+	poor := m.Fit(FromCompany(cufinder.Company{Industry: "Restaurants", Overview: "Pizza delivery"}, nil))
+	assert.Equal(t, 0.0, poor.Score)
+}
+
+func TestFitDecodedENC(t *testing.T) {
+	var enc cufinder.EncResponse
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"credit_count": 1,
+		"company": {"name": "PayCo", "industry": "Banking", "size": "51-200"}
+	}`), &enc))
+
+	fit := NewMatcher(ownSnapshot).Fit(FromENC(enc.Company, nil))
+	assert.Equal(t, "Banking", FromENC(enc.Company, nil).Industry)
+	assert.Equal(t, []string{"Banking"}, fit.MatchedIndustries)
+	assert.Equal(t, 1.0, fit.Industry)
+}
+
+func TestKeywords(t *testing.T) {
+	assert.Equal(t, []string{"payment", "infrastructure", "built", "fraud", "detection"},
+		Keywords("Payments infrastructure with built-in fraud detection for the payments team"))
+}
+
+func TestPersonaSearches(t *testing.T) {
+	m := NewMatcher(ownSnapshot)
+	assert.Equal(t, []PersonaSearch{
+		{Persona: "VP of Engineering", Role: cufinder.RoleEngineering, Level: cufinder.LevelVP},
+		{Persona: "Heads of Payments", Level: cufinder.LevelDirector},
+	}, m.PersonaSearches())
+	assert.Equal(t, []cufinder.Industry{"banking", "financial services", "investment banking"}, m.SearchIndustries())
+}
+
+// newProspectService serves CSE and PSE results and records the searches
+func newProspectService(t *testing.T) (*cufinder.Service, *[]string) {
+	var searches []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		searches = append(searches, r.URL.Path+":"+r.PostForm.Get("industry")+r.PostForm.Get("job_title_level"))
+
+		var data map[string]interface{}
+		switch {
+		case r.URL.Path == "/cse" && r.PostForm.Get("industry") == "financial services":
+			data = map[string]interface{}{"companies": []interface{}{
+				map[string]interface{}{"name": "PayCo", "domain": "payco.com", "industry": "Financial Services", "description": "Payments for marketplaces"},
+				map[string]interface{}{"name": "LoanCo", "domain": "loanco.com", "industry": "Financial Services"},
+			}}
+		case r.URL.Path == "/cse":
+			// The same company as another URL form
+			data = map[string]interface{}{"companies": []interface{}{
+				map[string]interface{}{"name": "LoanCo", "domain": "https://www.LoanCo.com/", "industry": "Banking"},
+			}}
+		case r.URL.Path == "/pse":
+			data = map[string]interface{}{"peoples": []interface{}{
+				map[string]interface{}{"full_name": "Jane " + r.PostForm.Get("job_title_level")},
+				map[string]interface{}{"full_name": "John " + r.PostForm.Get("job_title_level")},
+			}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+	t.Cleanup(server.Close)
+
+	return cufinder.NewService(cufinder.NewClient(cufinder.ClientConfig{APIKey: "test-api-key", BaseURL: server.URL})), &searches
+}
+
+func TestProspects(t *testing.T) {
+	service, searches := newProspectService(t)
+	list, err := NewMatcher(ownSnapshot).Prospects(context.Background(), service, ProspectConfig{PeopleCompanies: 1})
+	require.NoError(t, err)
+
+	require.Len(t, list.Prospects, 2)
+	assert.Equal(t, "PayCo", list.Prospects[0].Company.Name)
+	assert.Greater(t, list.Prospects[0].Fit.Score, list.Prospects[1].Fit.Score)
+	require.Len(t, list.Prospects[0].People, 4)
+	assert.Empty(t, list.Prospects[1].People)
+
+	assert.Equal(t, []string{
+		"/cse:banking", "/cse:financial services", "/cse:investment banking", "/pse:vp", "/pse:director",
+	}, *searches)
+	assert.Equal(t, 5, list.Calls)
+
+	_, err = NewMatcher(cufinder.CsnSnapshotInfo{TargetIndustries: []string{"Underwater basket weaving"}}).
+		Prospects(context.Background(), service, ProspectConfig{})
+	assert.Error(t, err)
+}
+
+func TestProspectsPeoplePerCompany(t *testing.T) {
+	service, searches := newProspectService(t)
+	list, err := NewMatcher(ownSnapshot).Prospects(context.Background(), service, ProspectConfig{
+		PeopleCompanies:  2,
+		PeoplePerCompany: 1,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, list.Prospects, 2)
+	for _, p := range list.Prospects {
+		require.Len(t, p.People, 1, p.Company.Name)
+		assert.Equal(t, "Jane vp", p.People[0].FullName)
+	}
+	// One persona search per company is enough
+	assert.Equal(t, []string{
+		"/cse:banking", "/cse:financial services", "/cse:investment banking", "/pse:vp", "/pse:vp",
+	}, *searches)
+	assert.Equal(t, 5, list.Credits)
+}
+
+func TestProspectsSearchError(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	t.Cleanup(server.Close)
+	service := cufinder.NewService(cufinder.NewClient(cufinder.ClientConfig{APIKey: "invalid", BaseURL: server.URL}))
+
+	list, err := NewMatcher(ownSnapshot).Prospects(context.Background(), service, ProspectConfig{PeopleCompanies: 1})
+	assert.ErrorContains(t, err, "401")
+	assert.Empty(t, list.Prospects)
+	assert.Equal(t, 3, calls, "no people search without prospects")
+	assert.Equal(t, 3, list.Credits)
+}
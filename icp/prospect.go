@@ -0,0 +1,284 @@
+package icp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+// ProspectConfig configures prospect list generation
+type ProspectConfig struct {
+	// Country narrows the company search
+	Country string
+	// MaxPages limits the pages fetched per industry. Defaults to 1.
+	MaxPages int
+	// MinFit drops companies scoring below it
+	MinFit float64
+	// MaxCompanies limits the ranked companies returned; zero means all
+	MaxCompanies int
+	// PeopleCompanies is how many of the best fitting companies get a
+	// people search for the target personas; zero skips people
+	PeopleCompanies int
+	// PeoplePerCompany caps the people kept per company; zero means no
+	// limit. Persona searches stop once a company has enough people.
+	PeoplePerCompany int
+	// MaxCredits caps the credits spent; zero means no limit
+	MaxCredits int
+}
+
+// Prospect is a company that fits the profile
+type Prospect struct {
+	Company cufinder.Company  `json:"company"`
+	Fit     Fit               `json:"fit"`
+	People  []cufinder.Person `json:"people,omitempty"`
+}
+
+// ProspectList is the ranked result of Prospects
+type ProspectList struct {
+	Prospects []Prospect `json:"prospects"`
+	Calls     int        `json:"calls"`
+	Credits   int        `json:"credits"`
+}
+
+// PersonaSearch is a people search derived from a target persona
+type PersonaSearch struct {
+	Persona string
+	Role    cufinder.JobTitleRole
+	Level   cufinder.JobTitleLevel
+}
+
+// Persona keywords mapped to the search values they imply
+var (
+	personaRoles = map[string]cufinder.JobTitleRole{
+		"engineer": cufinder.RoleEngineering, "engineering": cufinder.RoleEngineering,
+		"developer": cufinder.RoleEngineering, "cto": cufinder.RoleEngineering,
+		"technical": cufinder.RoleEngineering, "it": cufinder.RoleEngineering,
+		"marketing": cufinder.RoleMarketing, "marketer": cufinder.RoleMarketing, "cmo": cufinder.RoleMarketing,
+		"sale": cufinder.RoleSales, "revenue": cufinder.RoleSales, "cro": cufinder.RoleSales,
+		"finance": cufinder.RoleFinance, "financial": cufinder.RoleFinance, "cfo": cufinder.RoleFinance,
+		"hr": cufinder.RoleHumanResources, "people": cufinder.RoleHumanResources,
+		"talent": cufinder.RoleHumanResources, "recruiting": cufinder.RoleHumanResources,
+		"operation": cufinder.RoleOperations, "coo": cufinder.RoleOperations,
+		"legal": cufinder.RoleLegal, "counsel": cufinder.RoleLegal,
+		"design": cufinder.RoleDesign, "designer": cufinder.RoleDesign,
+		"support": cufinder.RoleCustomerService, "success": cufinder.RoleCustomerService,
+	}
+	personaLevels = map[string]cufinder.JobTitleLevel{
+		"chief": cufinder.LevelCXO, "ceo": cufinder.LevelCXO, "cto": cufinder.LevelCXO,
+		"cfo": cufinder.LevelCXO, "cmo": cufinder.LevelCXO, "coo": cufinder.LevelCXO,
+		"cro": cufinder.LevelCXO, "executive": cufinder.LevelCXO, "cxo": cufinder.LevelCXO,
+		"founder": cufinder.LevelOwner, "owner": cufinder.LevelOwner, "partner": cufinder.LevelPartner,
+		"vp": cufinder.LevelVP, "vps": cufinder.LevelVP, "vice": cufinder.LevelVP,
+		"director": cufinder.LevelDirector, "head": cufinder.LevelDirector,
+		"manager": cufinder.LevelManager, "lead": cufinder.LevelManager,
+	}
+)
+
+// PersonaSearches maps each target persona to a people search role and
+// level. Personas that mention neither are skipped.
+func (m *Matcher) PersonaSearches() []PersonaSearch {
+	var searches []PersonaSearch
+	for _, persona := range m.profile.TargetPersonas {
+		search := PersonaSearch{Persona: persona}
+		for _, w := range words(persona) {
+			if role, ok := personaRoles[w]; ok && search.Role == "" {
+				search.Role = role
+			}
+			if level, ok := personaLevels[w]; ok && search.Level == "" {
+				search.Level = level
+			}
+		}
+		if search.Role != "" || search.Level != "" {
+			searches = append(searches, search)
+		}
+	}
+	return searches
+}
+
+// Prospects searches companies in the target industries, ranks them by fit
+// and optionally finds people matching the target personas at the best ones.
+//
+// When the credit cap is reached or ctx is cancelled, the prospects found so
+// far are returned together with cufinder.ErrCreditLimit or the context error.
+// Failed company searches are skipped, and the first is returned when no
+// prospect was found.
+func (m *Matcher) Prospects(ctx context.Context, service *cufinder.Service, config ProspectConfig) (*ProspectList, error) {
+	industries := m.SearchIndustries()
+	if len(industries) == 0 {
+		return nil, fmt.Errorf("no target industry matches a search industry")
+	}
+	if config.MaxPages <= 0 {
+		config.MaxPages = 1
+	}
+
+	g := &generator{matcher: m, service: service, config: config, seen: make(map[string]bool)}
+	err := g.searchCompanies(ctx, industries)
+	g.rank()
+	if err == nil {
+		err = g.searchPeople(ctx)
+	}
+
+	return &ProspectList{Prospects: g.prospects, Calls: g.calls, Credits: g.credits}, err
+}
+
+// generator holds the state of one prospect list generation
+type generator struct {
+	matcher   *Matcher
+	service   *cufinder.Service
+	config    ProspectConfig
+	seen      map[string]bool
+	prospects []Prospect
+	calls     int
+	credits   int
+	// searchErr is the first failed company search, reported if no
+	// prospect was found
+	searchErr error
+}
+
+// proceed checks for cancellation and the credit cap before the next call
+func (g *generator) proceed(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if g.config.MaxCredits > 0 && g.credits >= g.config.MaxCredits {
+		return cufinder.ErrCreditLimit
+	}
+	return nil
+}
+
+// spend records a call and the credits it may have used
+func (g *generator) spend(response interface{}, err error) {
+	g.calls++
+	g.credits += cufinder.CallCredits(response, err)
+}
+
+func (g *generator) searchCompanies(ctx context.Context, industries []cufinder.Industry) error {
+	for _, industry := range industries {
+		for page := 1; page <= g.config.MaxPages; page++ {
+			if err := g.proceed(ctx); err != nil {
+				return err
+			}
+
+			response, err := g.service.SearchCompanies(cufinder.CseParams{
+				Industry: string(industry),
+				Country:  g.config.Country,
+				Page:     page,
+			})
+			g.spend(response, err)
+			if err != nil {
+				if g.searchErr == nil {
+					g.searchErr = err
+				}
+				continue
+			}
+			if len(response.Companies) == 0 {
+				break
+			}
+
+			for _, company := range response.Companies {
+				key := companyKey(company)
+				if key == "" || g.seen[key] {
+					continue
+				}
+				g.seen[key] = true
+
+				fit := g.matcher.Fit(FromCompany(company, nil))
+				if fit.Score >= g.config.MinFit {
+					g.prospects = append(g.prospects, Prospect{Company: company, Fit: fit})
+				}
+			}
+		}
+	}
+	if len(g.prospects) == 0 {
+		return g.searchErr
+	}
+	return nil
+}
+
+// rank sorts prospects by fit and applies MaxCompanies
+func (g *generator) rank() {
+	sort.SliceStable(g.prospects, func(i, j int) bool {
+		return g.prospects[i].Fit.Score > g.prospects[j].Fit.Score
+	})
+	if g.config.MaxCompanies > 0 && len(g.prospects) > g.config.MaxCompanies {
+		g.prospects = g.prospects[:g.config.MaxCompanies]
+	}
+}
+
+func (g *generator) searchPeople(ctx context.Context) error {
+	searches := g.matcher.PersonaSearches()
+	if g.config.PeopleCompanies <= 0 || len(searches) == 0 {
+		return nil
+	}
+
+	for i := range g.prospects {
+		if i >= g.config.PeopleCompanies {
+			break
+		}
+		p := &g.prospects[i]
+		seen := make(map[string]bool)
+
+		for _, search := range searches {
+			if g.full(p) {
+				break
+			}
+			if err := g.proceed(ctx); err != nil {
+				return err
+			}
+
+			params := cufinder.PseParams{
+				JobTitleRole:  string(search.Role),
+				JobTitleLevel: string(search.Level),
+			}
+			if p.Company.LinkedInURL != "" {
+				params.CompanyLinkedInURL = p.Company.LinkedInURL
+			} else {
+				params.CompanyName = p.Company.Name
+			}
+
+			response, err := g.service.SearchPeople(params)
+			g.spend(response, err)
+			if err != nil {
+				continue
+			}
+
+			for _, person := range response.Peoples {
+				if g.full(p) {
+					break
+				}
+				key := strings.ToLower(firstNonEmpty(person.Social.LinkedIn, person.Social.LinkedinUsername, person.FullName))
+				if !seen[key] {
+					seen[key] = true
+					p.People = append(p.People, person)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// full reports whether a prospect has PeoplePerCompany people
+func (g *generator) full(p *Prospect) bool {
+	return g.config.PeoplePerCompany > 0 && len(p.People) >= g.config.PeoplePerCompany
+}
+
+// companyKey identifies a company by its canonical domain, so URL forms of
+// the same website match, falling back to its LinkedIn URL or name
+func companyKey(company cufinder.Company) string {
+	if domain, ok := cufinder.CanonicalDomain(firstNonEmpty(company.Domain, company.Website)); ok {
+		return domain
+	}
+	return strings.ToLower(firstNonEmpty(company.LinkedInURL, company.Name))
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
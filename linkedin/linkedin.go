@@ -0,0 +1,178 @@
+// Package linkedin parses and canonicalizes LinkedIn profile and company
+// page URLs.
+//
+// The same page is linked in many forms: with or without scheme, with
+// country or mobile subdomains, trailing slashes, query strings and extra
+// path segments such as "/about". Parse reduces all of them to the entity
+// type and slug, and String renders the canonical URL:
+//
+//	u, err := linkedin.Parse("uk.linkedin.com/in/John-Doe/?trk=public")
+//	u.Kind     // linkedin.Person
+//	u.Slug     // "john-doe"
+//	u.String() // "https://www.linkedin.com/in/john-doe"
+//
+// The package has no dependencies so the SDK uses it to canonicalize
+// LinkedIn parameters and response fields.
+package linkedin
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+var (
+	// ErrEmpty is returned for an empty input
+	ErrEmpty = errors.New("empty LinkedIn URL")
+	// ErrNotLinkedIn is returned when the input does not point at linkedin.com
+	ErrNotLinkedIn = errors.New("not a LinkedIn URL")
+	// ErrUnsupported is returned for LinkedIn pages that are not a profile
+	// or company page, such as posts, jobs or search results
+	ErrUnsupported = errors.New("not a LinkedIn profile or company URL")
+	// ErrWrongKind is returned by ParseProfile and ParseCompany when the URL
+	// is of the other kind
+	ErrWrongKind = errors.New("wrong kind of LinkedIn URL")
+)
+
+// Kind is the type of entity a URL points at
+type Kind string
+
+const (
+	Person   Kind = "person"
+	Company  Kind = "company"
+	School   Kind = "school"
+	Showcase Kind = "showcase"
+)
+
+// IsOrganization reports whether the kind is a company, school or showcase
+// page
+func (k Kind) IsOrganization() bool {
+	return k == Company || k == School || k == Showcase
+}
+
+// sections maps the leading path segment to the kind it identifies
+var sections = map[string]Kind{
+	"in":       Person,
+	"pub":      Person,
+	"company":  Company,
+	"school":   School,
+	"showcase": Showcase,
+}
+
+// URL is a parsed LinkedIn profile or company page
+type URL struct {
+	Kind Kind
+	// Slug is the lower-case vanity name or numeric id. For legacy /pub/
+	// profile URLs it includes the id segments, as in "john-doe/1/2/3".
+	Slug string
+	// Legacy is set for /pub/ profile URLs
+	Legacy bool
+}
+
+// Parse parses a LinkedIn profile or company URL in any of its common forms
+func Parse(raw string) (URL, error) {
+	s := strings.TrimSpace(raw)
+	if s == "" {
+		return URL{}, ErrEmpty
+	}
+	if !strings.Contains(s, "://") {
+		s = "https://" + strings.TrimPrefix(s, "//")
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return URL{}, fmt.Errorf("%w: %q", ErrNotLinkedIn, raw)
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host != "linkedin.com" && !strings.HasSuffix(host, ".linkedin.com") {
+		return URL{}, fmt.Errorf("%w: %q", ErrNotLinkedIn, raw)
+	}
+
+	segments := strings.FieldsFunc(u.Path, func(r rune) bool { return r == '/' })
+	// The mobile site prefixes paths with /mwlite
+	if len(segments) > 0 && strings.EqualFold(segments[0], "mwlite") {
+		segments = segments[1:]
+	}
+	if len(segments) < 2 {
+		return URL{}, fmt.Errorf("%w: %q", ErrUnsupported, raw)
+	}
+
+	section := strings.ToLower(segments[0])
+	kind, ok := sections[section]
+	if !ok {
+		return URL{}, fmt.Errorf("%w: %q", ErrUnsupported, raw)
+	}
+
+	result := URL{Kind: kind, Slug: strings.ToLower(segments[1]), Legacy: section == "pub"}
+	if result.Legacy {
+		// /pub/<name>/<a>/<b>/<c> needs its id segments to be unique
+		end := len(segments)
+		if end > 5 {
+			end = 5
+		}
+		result.Slug = strings.ToLower(strings.Join(segments[1:end], "/"))
+	}
+	for _, r := range result.Slug {
+		if unicode.IsSpace(r) || unicode.IsControl(r) || strings.ContainsRune("?#@", r) {
+			return URL{}, fmt.Errorf("%w: %q", ErrUnsupported, raw)
+		}
+	}
+	return result, nil
+}
+
+// ParseProfile parses a person profile URL
+func ParseProfile(raw string) (URL, error) {
+	u, err := Parse(raw)
+	if err == nil && u.Kind != Person {
+		return URL{}, fmt.Errorf("%w: %q is a %s page", ErrWrongKind, raw, u.Kind)
+	}
+	return u, err
+}
+
+// ParseCompany parses a company, school or showcase page URL
+func ParseCompany(raw string) (URL, error) {
+	u, err := Parse(raw)
+	if err == nil && !u.Kind.IsOrganization() {
+		return URL{}, fmt.Errorf("%w: %q is a %s profile", ErrWrongKind, raw, u.Kind)
+	}
+	return u, err
+}
+
+// Canonical parses raw and returns its canonical URL
+func Canonical(raw string) (string, error) {
+	u, err := Parse(raw)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+// Path returns the canonical path, as in "/in/john-doe"
+func (u URL) Path() string {
+	section := "in"
+	switch {
+	case u.Legacy:
+		section = "pub"
+	case u.Kind != Person:
+		section = string(u.Kind)
+	}
+
+	segments := strings.Split(u.Slug, "/")
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+	return "/" + section + "/" + strings.Join(segments, "/")
+}
+
+// String returns the canonical URL, as in "https://www.linkedin.com/in/john-doe"
+func (u URL) String() string {
+	return "https://www.linkedin.com" + u.Path()
+}
+
+// Key returns the scheme-less form used to key caches and stores, as in
+// "linkedin.com/in/john-doe"
+func (u URL) Key() string {
+	return "linkedin.com" + u.Path()
+}
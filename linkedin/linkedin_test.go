@@ -0,0 +1,78 @@
+package linkedin
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		raw  string
+		kind Kind
+		slug string
+		url  string
+	}{
+		{"linkedin.com/in/john-doe", Person, "john-doe", "https://www.linkedin.com/in/john-doe"},
+		{"https://www.linkedin.com/in/John-Doe/", Person, "john-doe", "https://www.linkedin.com/in/john-doe"},
+		{"  http://uk.linkedin.com/in/john-doe?trk=public_profile#top ", Person, "john-doe", "https://www.linkedin.com/in/john-doe"},
+		{"https://www.linkedin.com/in/john-doe/details/experience/", Person, "john-doe", "https://www.linkedin.com/in/john-doe"},
+		{"https://www.linkedin.com/mwlite/in/john-doe", Person, "john-doe", "https://www.linkedin.com/in/john-doe"},
+		{"https://www.linkedin.com/in/jos%C3%A9-garc%C3%ADa", Person, "josé-garcía", "https://www.linkedin.com/in/jos%C3%A9-garc%C3%ADa"},
+		{"https://uk.linkedin.com/pub/John-Doe/1/2/3", Person, "john-doe/1/2/3", "https://www.linkedin.com/pub/john-doe/1/2/3"},
+		{"linkedin.com/company/TechCorp/about/", Company, "techcorp", "https://www.linkedin.com/company/techcorp"},
+		{"https://www.linkedin.com/company/1441", Company, "1441", "https://www.linkedin.com/company/1441"},
+		{"https://www.linkedin.com/school/stanford-university/", School, "stanford-university", "https://www.linkedin.com/school/stanford-university"},
+		{"https://www.linkedin.com/showcase/techcorp-cloud", Showcase, "techcorp-cloud", "https://www.linkedin.com/showcase/techcorp-cloud"},
+	}
+	for _, tt := range tests {
+		u, err := Parse(tt.raw)
+		require.NoError(t, err, tt.raw)
+		assert.Equal(t, tt.kind, u.Kind, tt.raw)
+		assert.Equal(t, tt.slug, u.Slug, tt.raw)
+		assert.Equal(t, tt.url, u.String(), tt.raw)
+	}
+
+	u, err := Parse("https://de.linkedin.com/in/john-doe")
+	require.NoError(t, err)
+	assert.Equal(t, "linkedin.com/in/john-doe", u.Key())
+	assert.False(t, u.Legacy)
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := map[string]error{
+		"":                                        ErrEmpty,
+		"https://example.com/in/john-doe":         ErrNotLinkedIn,
+		"https://notlinkedin.com/in/john-doe":     ErrNotLinkedIn,
+		"https://lnkd.in/abc":                     ErrNotLinkedIn,
+		"https://www.linkedin.com/in/":            ErrUnsupported,
+		"https://www.linkedin.com/feed/":          ErrUnsupported,
+		"https://www.linkedin.com/jobs/view/1234": ErrUnsupported,
+		"https://www.linkedin.com/in/john%20doe":  ErrUnsupported,
+	}
+	for raw, want := range tests {
+		_, err := Parse(raw)
+		assert.True(t, errors.Is(err, want), "%q: %v", raw, err)
+	}
+}
+
+func TestParseKind(t *testing.T) {
+	_, err := ParseProfile("https://www.linkedin.com/company/techcorp")
+	assert.True(t, errors.Is(err, ErrWrongKind))
+
+	_, err = ParseCompany("https://www.linkedin.com/in/john-doe")
+	assert.True(t, errors.Is(err, ErrWrongKind))
+
+	u, err := ParseCompany("linkedin.com/school/mit")
+	require.NoError(t, err)
+	assert.True(t, u.Kind.IsOrganization())
+
+	_, err = ParseProfile("example.com")
+	assert.True(t, errors.Is(err, ErrNotLinkedIn))
+
+	canonical, err := Canonical("www.linkedin.com/company/TechCorp?trk=x")
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.linkedin.com/company/techcorp", canonical)
+}
@@ -0,0 +1,337 @@
+package cufinder
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// LookalikeConfig configures a lookalike expansion
+type LookalikeConfig struct {
+	// Seeds are the starting companies, as domains or names
+	Seeds []string
+	// Hops is how many rounds of FCL to run. Defaults to 1.
+	Hops int
+	// MaxCredits caps the credits spent on the expansion; zero means no limit
+	MaxCredits int
+	// MaxResults limits the ranked candidates returned; zero means all
+	MaxResults int
+	// Profile is the attribute profile candidates are compared with. When
+	// empty it is derived from the most common industry, size and country
+	// among the seeds' direct lookalikes.
+	Profile LookalikeProfile
+}
+
+// LookalikeProfile holds the attributes used for similarity scoring
+type LookalikeProfile struct {
+	Industry string `json:"industry,omitempty"`
+	Size     string `json:"size,omitempty"`
+	Country  string `json:"country,omitempty"`
+}
+
+// LookalikeCandidate is a company found by a lookalike expansion
+type LookalikeCandidate struct {
+	Company FclCompany `json:"company"`
+	// Key is the canonical domain, or the normalized name without one
+	Key string `json:"key"`
+	// Hops is the fewest FCL rounds needed to reach the company
+	Hops int `json:"hops"`
+	// Seeds lists the seeds the company can be reached from in any number
+	// of hops
+	Seeds []string `json:"seeds"`
+	// Score ranks the candidate between 0 and 1
+	Score float64 `json:"score"`
+	// Reasons explains why the company was included
+	Reasons []string `json:"reasons"`
+}
+
+// LookalikeResult is the ranked outcome of a lookalike expansion
+type LookalikeResult struct {
+	Candidates []LookalikeCandidate `json:"candidates"`
+	Profile    LookalikeProfile     `json:"profile"`
+	Calls      int                  `json:"calls"`
+	Credits    int                  `json:"credits"`
+}
+
+// Scoring weights; together they bound the score to 1
+const (
+	lookalikeSeedWeight       = 0.5
+	lookalikeSimilarityWeight = 0.4
+	lookalikeHopWeight        = 0.1
+)
+
+// ExpandLookalikes runs a breadth-first lookalike expansion from seeds
+func (s *Service) ExpandLookalikes(ctx context.Context, seeds []string, hops int) (*LookalikeResult, error) {
+	return s.ExpandLookalikesWithConfig(ctx, LookalikeConfig{Seeds: seeds, Hops: hops})
+}
+
+// ExpandLookalikesWithConfig calls FCL on the seeds, then on the companies it
+// returns, up to config.Hops rounds. Companies are deduplicated by domain and
+// ranked by how many seeds they connect to, their similarity to the profile
+// and their distance from the seeds.
+//
+// When the credit cap is reached or ctx is cancelled, the candidates found so
+// far are ranked and returned together with ErrCreditLimit or the context
+// error. Likewise, a failed FCL call for a seed is returned with the
+// candidates found from the other seeds; later failures are only returned
+// when no candidate was found.
+func (s *Service) ExpandLookalikesWithConfig(ctx context.Context, config LookalikeConfig) (*LookalikeResult, error) {
+	if len(config.Seeds) == 0 {
+		return nil, fmt.Errorf("at least one seed is required")
+	}
+	if config.Hops < 0 {
+		return nil, fmt.Errorf("hops must not be negative")
+	}
+	if config.Hops == 0 {
+		config.Hops = 1
+	}
+
+	e := &lookalikeExpansion{
+		service:    s,
+		budget:     creditBudget{max: config.MaxCredits},
+		seeds:      make(map[string]string),
+		candidates: make(map[string]*lookalikeNode),
+		edges:      make(map[string][]string),
+	}
+	err := e.run(ctx, config)
+
+	profile := config.Profile
+	if profile == (LookalikeProfile{}) {
+		profile = e.consensus()
+	}
+
+	result := &LookalikeResult{
+		Candidates: e.rank(profile, len(e.seeds)),
+		Profile:    profile,
+		Calls:      e.budget.calls,
+		Credits:    e.budget.used,
+	}
+	if config.MaxResults > 0 && len(result.Candidates) > config.MaxResults {
+		result.Candidates = result.Candidates[:config.MaxResults]
+	}
+	return result, err
+}
+
+// lookalikeNode is a company discovered during an expansion
+type lookalikeNode struct {
+	company FclCompany
+	key     string
+	hops    int
+	seeds   map[string]bool
+}
+
+// lookalikeExpansion holds the state of one expansion
+type lookalikeExpansion struct {
+	service *Service
+	budget  creditBudget
+	// seeds maps seed keys to the seeds as given
+	seeds      map[string]string
+	candidates map[string]*lookalikeNode
+	// edges maps the key of each expanded company or seed to the keys of
+	// the candidates FCL returned for it
+	edges map[string][]string
+	// direct holds the seeds' direct lookalikes, used for the default profile
+	direct []FclCompany
+	// fetchErr is the first failed FCL call
+	fetchErr error
+}
+
+// frontierItem is a company whose lookalikes are fetched in the next round
+type frontierItem struct {
+	query string
+	key   string
+}
+
+func (e *lookalikeExpansion) run(ctx context.Context, config LookalikeConfig) error {
+	// Seed sets are only propagated once the graph is known, so that they do
+	// not depend on the order seeds and candidates were expanded in
+	defer e.propagate()
+
+	var frontier []frontierItem
+	for _, seed := range config.Seeds {
+		seed = strings.TrimSpace(seed)
+		key := companyKey(seed, seed)
+		if seed == "" || e.seeds[key] != "" {
+			continue
+		}
+		e.seeds[key] = seed
+		frontier = append(frontier, frontierItem{query: seed, key: key})
+	}
+
+	seedFailed := false
+	for hop := 1; hop <= config.Hops && len(frontier) > 0; hop++ {
+		var next []frontierItem
+		for _, item := range frontier {
+			if err := e.budget.proceed(ctx); err != nil {
+				return err
+			}
+
+			response, err := e.service.GetLookalikes(FclParams{Query: item.query})
+			e.budget.spend(response, err)
+			if err != nil {
+				if e.fetchErr == nil {
+					e.fetchErr = err
+				}
+				if hop == 1 {
+					seedFailed = true
+				}
+				continue
+			}
+
+			for _, company := range response.Companies {
+				key := companyKey(firstNonEmpty(company.Domain, company.Website), company.Name)
+				if key == "" || e.seeds[key] != "" {
+					continue
+				}
+				if hop == 1 {
+					e.direct = append(e.direct, company)
+				}
+
+				node, ok := e.candidates[key]
+				if !ok {
+					node = &lookalikeNode{company: company, key: key, hops: hop, seeds: make(map[string]bool)}
+					e.candidates[key] = node
+					next = append(next, frontierItem{query: firstNonEmpty(node.domain(), company.Name), key: key})
+				}
+				e.edges[item.key] = append(e.edges[item.key], key)
+			}
+		}
+		frontier = next
+	}
+	// A seed that could not be expanded makes the result incomplete; a
+	// failure further out only matters if nothing was found
+	if seedFailed || len(e.candidates) == 0 {
+		return e.fetchErr
+	}
+	return nil
+}
+
+// propagate connects every candidate to each seed it can be reached from,
+// repeating until no seed set grows
+func (e *lookalikeExpansion) propagate() {
+	for changed := true; changed; {
+		changed = false
+		for parent, children := range e.edges {
+			from := map[string]bool{}
+			if seed, ok := e.seeds[parent]; ok {
+				from[seed] = true
+			} else if node, ok := e.candidates[parent]; ok {
+				from = node.seeds
+			}
+			for _, child := range children {
+				node := e.candidates[child]
+				for seed := range from {
+					if !node.seeds[seed] {
+						node.seeds[seed] = true
+						changed = true
+					}
+				}
+			}
+		}
+	}
+}
+
+// domain returns the candidate's canonical domain, if it has one
+func (n *lookalikeNode) domain() string {
+	if strings.HasPrefix(n.key, "name:") {
+		return ""
+	}
+	return n.key
+}
+
+// consensus returns the most common attributes of the seeds' direct lookalikes
+func (e *lookalikeExpansion) consensus() LookalikeProfile {
+	industries, sizes, countries := map[string]int{}, map[string]int{}, map[string]int{}
+	for _, c := range e.direct {
+		industries[normalizeAttr(c.Industry)]++
+		sizes[normalizeAttr(c.Size)]++
+		countries[normalizeAttr(c.Country)]++
+	}
+	return LookalikeProfile{Industry: mode(industries), Size: mode(sizes), Country: mode(countries)}
+}
+
+// rank scores every candidate against the profile and sorts them
+func (e *lookalikeExpansion) rank(profile LookalikeProfile, seedCount int) []LookalikeCandidate {
+	candidates := make([]LookalikeCandidate, 0, len(e.candidates))
+	for _, node := range e.candidates {
+		c := LookalikeCandidate{Company: node.company, Key: node.key, Hops: node.hops}
+		for seed := range node.seeds {
+			c.Seeds = append(c.Seeds, seed)
+		}
+		sort.Strings(c.Seeds)
+
+		c.Reasons = append(c.Reasons, fmt.Sprintf("similar to %d of %d seeds (%s)", len(c.Seeds), seedCount, strings.Join(c.Seeds, ", ")))
+		c.Score = lookalikeSeedWeight * float64(len(c.Seeds)) / float64(seedCount)
+
+		matches := 0
+		for _, attr := range []struct{ name, want, got string }{
+			{"industry", profile.Industry, node.company.Industry},
+			{"size", profile.Size, node.company.Size},
+			{"country", profile.Country, node.company.Country},
+		} {
+			if attr.want != "" && normalizeAttr(attr.got) == normalizeAttr(attr.want) {
+				matches++
+				c.Reasons = append(c.Reasons, fmt.Sprintf("%s matches: %s", attr.name, attr.got))
+			}
+		}
+		c.Score += lookalikeSimilarityWeight * float64(matches) / 3
+
+		c.Score += lookalikeHopWeight / float64(node.hops)
+		if node.hops == 1 {
+			c.Reasons = append(c.Reasons, "direct lookalike of a seed")
+		} else {
+			c.Reasons = append(c.Reasons, fmt.Sprintf("found %d hops from the seeds", node.hops))
+		}
+
+		candidates = append(candidates, c)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return a.Key < b.Key
+	})
+	return candidates
+}
+
+// companyKey identifies a company by its canonical domain, falling back to
+// its normalized name
+func companyKey(website, name string) string {
+	if domain, ok := CanonicalDomain(website); ok {
+		return domain
+	}
+	if strings.TrimSpace(name) == "" {
+		return ""
+	}
+	return nameKey(name)
+}
+
+func normalizeAttr(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+// mode returns the most frequent non-empty value, breaking ties by value
+func mode(counts map[string]int) string {
+	best, bestCount := "", 0
+	for value, count := range counts {
+		if value == "" {
+			continue
+		}
+		if count > bestCount || (count == bestCount && value < best) {
+			best, bestCount = value, count
+		}
+	}
+	return best
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
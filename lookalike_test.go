@@ -0,0 +1,145 @@
+package cufinder
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lookalikeEndpoints() map[string]fakeEndpoint {
+	company := func(domain, industry, size, country string) map[string]interface{} {
+		return map[string]interface{}{"name": domain, "domain": domain, "industry": industry, "size": size, "country": country}
+	}
+	graph := map[string][]interface{}{
+		"stripe.com": {
+			company("adyen.com", "Financial Services", "1001-5000", "Netherlands"),
+			company("paypal.com", "Financial Services", "10001+", "United States"),
+			company("square.com", "Financial Services", "1001-5000", "United States"),
+		},
+		"braintree.com": {
+			company("www.paypal.com", "Financial Services", "10001+", "United States"),
+			company("stripe.com", "Financial Services", "1001-5000", "United States"),
+		},
+		"square.com": {
+			company("toast.com", "Software Development", "1001-5000", "United States"),
+		},
+	}
+
+	return map[string]fakeEndpoint{
+		"/fcl": func(form url.Values) map[string]interface{} {
+			return map[string]interface{}{"companies": graph[form.Get("query")], "credit_count": 1}
+		},
+	}
+}
+
+func TestExpandLookalikes(t *testing.T) {
+	sdk, _ := newWorkflowSDK(t, lookalikeEndpoints())
+
+	result, err := sdk.ExpandLookalikes(context.Background(), []string{"stripe.com", "braintree.com"}, 2)
+	require.NoError(t, err)
+
+	var keys []string
+	for _, c := range result.Candidates {
+		keys = append(keys, c.Key)
+	}
+	assert.Equal(t, []string{"paypal.com", "square.com", "adyen.com", "toast.com"}, keys)
+	assert.Equal(t, LookalikeProfile{Industry: "financial services", Size: "10001+", Country: "united states"}, result.Profile)
+
+	paypal := result.Candidates[0]
+	assert.Equal(t, []string{"braintree.com", "stripe.com"}, paypal.Seeds)
+	assert.Equal(t, 1, paypal.Hops)
+	assert.InDelta(t, 1.0, paypal.Score, 1e-9)
+	assert.Equal(t, []string{
+		"similar to 2 of 2 seeds (braintree.com, stripe.com)",
+		"industry matches: Financial Services",
+		"size matches: 10001+",
+		"country matches: United States",
+		"direct lookalike of a seed",
+	}, paypal.Reasons)
+
+	toast := result.Candidates[3]
+	assert.Equal(t, 2, toast.Hops)
+	assert.Equal(t, []string{"stripe.com"}, toast.Seeds)
+	assert.Contains(t, toast.Reasons, "found 2 hops from the seeds")
+
+	// Two seeds plus the three new companies from the first hop
+	assert.Equal(t, 5, result.Calls)
+}
+
+func TestExpandLookalikesLimits(t *testing.T) {
+	sdk, _ := newWorkflowSDK(t, lookalikeEndpoints())
+
+	result, err := sdk.ExpandLookalikesWithConfig(context.Background(), LookalikeConfig{
+		Seeds:      []string{"stripe.com"},
+		Hops:       2,
+		MaxCredits: 1,
+		MaxResults: 2,
+		Profile:    LookalikeProfile{Country: "Netherlands"},
+	})
+	assert.ErrorIs(t, err, ErrCreditLimit)
+	require.Len(t, result.Candidates, 2)
+	assert.Equal(t, "adyen.com", result.Candidates[0].Key)
+	assert.Equal(t, 1, result.Credits)
+
+	_, err = sdk.ExpandLookalikes(context.Background(), nil, 1)
+	assert.Error(t, err)
+}
+
+func TestExpandLookalikesErrors(t *testing.T) {
+	endpoints := lookalikeEndpoints()
+	fcl := endpoints["/fcl"]
+	endpoints["/fcl"] = func(form url.Values) map[string]interface{} {
+		if form.Get("query") == "broken.com" || form.Get("query") == "square.com" {
+			return nil
+		}
+		return fcl(form)
+	}
+	sdk, _ := newWorkflowSDK(t, endpoints)
+
+	// A failed seed is reported with the other seeds' candidates
+	result, err := sdk.ExpandLookalikes(context.Background(), []string{"broken.com", "stripe.com"}, 1)
+	assert.ErrorContains(t, err, "404")
+	assert.Len(t, result.Candidates, 3)
+	assert.Equal(t, 2, result.Credits)
+
+	// A failed hop is ignored once candidates were found
+	result, err = sdk.ExpandLookalikes(context.Background(), []string{"stripe.com"}, 2)
+	require.NoError(t, err)
+	assert.Len(t, result.Candidates, 3)
+
+	result, err = sdk.ExpandLookalikes(context.Background(), []string{"broken.com"}, 2)
+	assert.ErrorContains(t, err, "404")
+	assert.Empty(t, result.Candidates)
+}
+
+func TestExpandLookalikesOrderIndependent(t *testing.T) {
+	// x.com is reached from seed a.com directly and from seed b.com via
+	// y.com, in the same round that x.com is expanded
+	graph := map[string][]interface{}{
+		"a.com": {map[string]interface{}{"name": "X", "domain": "x.com"}},
+		"b.com": {map[string]interface{}{"name": "Y", "domain": "y.com"}},
+		"y.com": {map[string]interface{}{"name": "X", "domain": "x.com"}},
+		"x.com": {map[string]interface{}{"name": "Z", "domain": "z.com"}},
+	}
+	sdk, _ := newWorkflowSDK(t, map[string]fakeEndpoint{
+		"/fcl": func(form url.Values) map[string]interface{} {
+			return map[string]interface{}{"companies": graph[form.Get("query")], "credit_count": 1}
+		},
+	})
+
+	forward, err := sdk.ExpandLookalikes(context.Background(), []string{"a.com", "b.com"}, 3)
+	require.NoError(t, err)
+	reversed, err := sdk.ExpandLookalikes(context.Background(), []string{"b.com", "a.com"}, 3)
+	require.NoError(t, err)
+
+	assert.Equal(t, forward.Candidates, reversed.Candidates)
+	for _, c := range forward.Candidates {
+		if c.Key == "z.com" {
+			assert.Equal(t, []string{"a.com", "b.com"}, c.Seeds)
+			assert.Equal(t, 2, c.Hops)
+		}
+	}
+}
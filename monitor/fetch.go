@@ -0,0 +1,67 @@
+package monitor
+
+import (
+	"github.com/cufinder/cufinder-go"
+)
+
+// fetcher describes how the monitor refreshes one endpoint
+type fetcher struct {
+	// params builds the request for a watched value, used to derive the store key
+	params func(value string) interface{}
+	// fetch calls the service
+	fetch func(s *cufinder.Service, value string) (interface{}, error)
+	// result returns an empty response to decode a stored snapshot into
+	result func() interface{}
+}
+
+var fetchers = map[string]fetcher{
+	"/enc": {
+		params: func(v string) interface{} { return cufinder.EncParams{Query: v} },
+		fetch: func(s *cufinder.Service, v string) (interface{}, error) {
+			return s.EnrichCompany(cufinder.EncParams{Query: v})
+		},
+		result: func() interface{} { return &cufinder.EncResponse{} },
+	},
+	"/car": {
+		params: func(v string) interface{} { return cufinder.CarParams{Query: v} },
+		fetch: func(s *cufinder.Service, v string) (interface{}, error) {
+			return s.GetRevenue(cufinder.CarParams{Query: v})
+		},
+		result: func() interface{} { return &cufinder.CarResponse{} },
+	},
+	"/fts": {
+		params: func(v string) interface{} { return cufinder.FtsParams{Query: v} },
+		fetch: func(s *cufinder.Service, v string) (interface{}, error) {
+			return s.GetTechStack(cufinder.FtsParams{Query: v})
+		},
+		result: func() interface{} { return &cufinder.FtsResponse{} },
+	},
+	"/elf": {
+		params: func(v string) interface{} { return cufinder.ElfParams{Query: v} },
+		fetch: func(s *cufinder.Service, v string) (interface{}, error) {
+			return s.GetFundraising(cufinder.ElfParams{Query: v})
+		},
+		result: func() interface{} { return &cufinder.ElfResponse{} },
+	},
+	"/fcc": {
+		params: func(v string) interface{} { return cufinder.FccParams{Query: v} },
+		fetch: func(s *cufinder.Service, v string) (interface{}, error) {
+			return s.GetSubsidiaries(cufinder.FccParams{Query: v})
+		},
+		result: func() interface{} { return &cufinder.FccResponse{} },
+	},
+	"/dte": {
+		params: func(v string) interface{} { return cufinder.DteParams{CompanyWebsite: v} },
+		fetch: func(s *cufinder.Service, v string) (interface{}, error) {
+			return s.GetEmails(cufinder.DteParams{CompanyWebsite: v})
+		},
+		result: func() interface{} { return &cufinder.DteResponse{} },
+	},
+	"/epp": {
+		params: func(v string) interface{} { return cufinder.EppParams{LinkedInURL: v} },
+		fetch: func(s *cufinder.Service, v string) (interface{}, error) {
+			return s.EnrichProfile(cufinder.EppParams{LinkedInURL: v})
+		},
+		result: func() interface{} { return &cufinder.EppResponse{} },
+	},
+}
@@ -0,0 +1,437 @@
+// Package monitor periodically re-enriches a watchlist of companies and people
+// and reports what changed.
+//
+// Each refresh cycle calls the configured services for every watched entity,
+// stalest first, within a credit budget and rate limit. Responses are saved
+// as snapshots in a store.Store and compared with the previous snapshot using
+// the diff package; changes are delivered to a callback and/or a webhook.
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cufinder/cufinder-go"
+	"github.com/cufinder/cufinder-go/diff"
+	"github.com/cufinder/cufinder-go/store"
+)
+
+// EntityKind is the type of a watched entity
+type EntityKind string
+
+const (
+	KindDomain   EntityKind = "domain"
+	KindLinkedIn EntityKind = "linkedin"
+)
+
+// Entity is a watched company domain or LinkedIn profile URL
+type Entity struct {
+	Kind  EntityKind
+	Value string
+}
+
+// Domain returns a watchlist entry for a company domain
+func Domain(domain string) Entity {
+	return Entity{Kind: KindDomain, Value: domain}
+}
+
+// LinkedIn returns a watchlist entry for a LinkedIn profile URL
+func LinkedIn(url string) Entity {
+	return Entity{Kind: KindLinkedIn, Value: url}
+}
+
+// Change is delivered when a refreshed snapshot differs from the previous one
+type Change struct {
+	Entity     Entity
+	Endpoint   string
+	Events     []diff.Event
+	Previous   interface{}
+	Current    interface{}
+	DetectedAt time.Time
+}
+
+// Stats summarizes a refresh cycle
+type Stats struct {
+	Calls   int
+	Credits int
+	Changes int
+	Skipped int
+	Errors  int
+}
+
+// Config configures a Monitor
+type Config struct {
+	// Service performs the API calls
+	Service *cufinder.Service
+	// Store keeps snapshots. Do not also set it as the client's Store, or
+	// every response is recorded twice.
+	Store *store.Store
+
+	// Interval between refresh cycles. Defaults to 24 hours.
+	Interval time.Duration
+	// CreditBudget caps the credits spent per cycle; zero means no limit.
+	// Failed calls count as one credit, since the API may bill them.
+	// Entities left over are refreshed first in the next cycle.
+	CreditBudget int
+	// RateLimit is the minimum delay between two API calls
+	RateLimit time.Duration
+
+	// DomainEndpoints are refreshed for watched domains: any of "/enc",
+	// "/car", "/fts", "/elf", "/fcc" and "/dte". Defaults to ENC, CAR, FTS
+	// and ELF.
+	DomainEndpoints []string
+	// LinkedInEndpoints are refreshed for watched profiles. Defaults to EPP.
+	LinkedInEndpoints []string
+
+	// EventTypes limits notifications to these event types; empty means all
+	EventTypes []diff.EventType
+	// OnChange is called for every detected change
+	OnChange func(Change)
+	// WebhookURL receives every detected change as a JSON POST
+	WebhookURL string
+	// HTTPClient posts webhooks. Defaults to a client with a 10 second timeout.
+	HTTPClient *http.Client
+	// OnError is called when a refresh or webhook fails
+	OnError func(Entity, string, error)
+}
+
+// Monitor refreshes a watchlist on a schedule
+type Monitor struct {
+	config    Config
+	now       func() time.Time
+	mu        sync.Mutex
+	watchlist map[Entity]struct{}
+	lastCall  time.Time
+}
+
+// New creates a Monitor
+func New(config Config) (*Monitor, error) {
+	if config.Service == nil {
+		return nil, fmt.Errorf("service is required")
+	}
+	if config.Store == nil {
+		return nil, fmt.Errorf("store is required")
+	}
+	if config.Interval == 0 {
+		config.Interval = 24 * time.Hour
+	}
+	if config.DomainEndpoints == nil {
+		config.DomainEndpoints = []string{"/enc", "/car", "/fts", "/elf"}
+	}
+	if config.LinkedInEndpoints == nil {
+		config.LinkedInEndpoints = []string{"/epp"}
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	for _, endpoints := range [][]string{config.DomainEndpoints, config.LinkedInEndpoints} {
+		for _, endpoint := range endpoints {
+			if _, ok := fetchers[endpoint]; !ok {
+				return nil, fmt.Errorf("unsupported endpoint %q", endpoint)
+			}
+		}
+	}
+
+	return &Monitor{
+		config:    config,
+		now:       time.Now,
+		watchlist: make(map[Entity]struct{}),
+	}, nil
+}
+
+// Watch adds entities to the watchlist
+func (m *Monitor) Watch(entities ...Entity) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, e := range entities {
+		m.watchlist[e] = struct{}{}
+	}
+}
+
+// Unwatch removes entities from the watchlist
+func (m *Monitor) Unwatch(entities ...Entity) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, e := range entities {
+		delete(m.watchlist, e)
+	}
+}
+
+// Watchlist returns the watched entities
+func (m *Monitor) Watchlist() []Entity {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entities := make([]Entity, 0, len(m.watchlist))
+	for e := range m.watchlist {
+		entities = append(entities, e)
+	}
+	sort.Slice(entities, func(i, j int) bool {
+		if entities[i].Kind != entities[j].Kind {
+			return entities[i].Kind < entities[j].Kind
+		}
+		return entities[i].Value < entities[j].Value
+	})
+	return entities
+}
+
+// Run refreshes the watchlist immediately and then every Interval until ctx
+// is cancelled
+func (m *Monitor) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := m.Refresh(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// task is one endpoint refresh for one entity
+type task struct {
+	entity    Entity
+	endpoint  string
+	key       string
+	kind      store.Kind
+	previous  *store.Record
+	fetchedAt time.Time
+}
+
+// Refresh runs a single refresh cycle. Individual call failures are reported
+// to OnError and counted in Stats; only context cancellation and store
+// failures abort the cycle.
+func (m *Monitor) Refresh(ctx context.Context) (Stats, error) {
+	var stats Stats
+
+	tasks, err := m.plan()
+	if err != nil {
+		return stats, err
+	}
+
+	for _, t := range tasks {
+		if err := ctx.Err(); err != nil {
+			return stats, err
+		}
+		if m.config.CreditBudget > 0 && stats.Credits >= m.config.CreditBudget {
+			stats.Skipped++
+			continue
+		}
+		if err := m.wait(ctx); err != nil {
+			return stats, err
+		}
+
+		current, err := fetchers[t.endpoint].fetch(m.config.Service, t.entity.Value)
+		stats.Calls++
+		stats.Credits += cufinder.CallCredits(current, err)
+		if err != nil {
+			stats.Errors++
+			m.reportError(t.entity, t.endpoint, err)
+			continue
+		}
+
+		changed, err := m.record(ctx, t, current)
+		if err != nil {
+			return stats, err
+		}
+		if changed {
+			stats.Changes++
+		}
+	}
+
+	return stats, nil
+}
+
+// plan lists the refresh tasks, least recently fetched first
+func (m *Monitor) plan() ([]task, error) {
+	var tasks []task
+	for _, entity := range m.Watchlist() {
+		endpoints := m.config.DomainEndpoints
+		if entity.Kind == KindLinkedIn {
+			endpoints = m.config.LinkedInEndpoints
+		}
+
+		for _, endpoint := range endpoints {
+			kind, key := store.Key(fetchers[endpoint].params(entity.Value))
+			t := task{entity: entity, endpoint: endpoint, key: key, kind: kind}
+
+			previous, err := m.config.Store.Latest(endpoint, key)
+			switch {
+			case errors.Is(err, store.ErrNotFound):
+			case err != nil:
+				return nil, err
+			default:
+				t.previous = previous
+				t.fetchedAt = previous.FetchedAt
+			}
+			tasks = append(tasks, t)
+		}
+	}
+
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return tasks[i].fetchedAt.Before(tasks[j].fetchedAt)
+	})
+	return tasks, nil
+}
+
+// wait enforces the rate limit between API calls
+func (m *Monitor) wait(ctx context.Context) error {
+	if m.config.RateLimit > 0 && !m.lastCall.IsZero() {
+		if delay := m.config.RateLimit - m.now().Sub(m.lastCall); delay > 0 {
+			timer := time.NewTimer(delay)
+			defer timer.Stop()
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}
+	m.lastCall = m.now()
+	return nil
+}
+
+// record saves the snapshot and notifies about changes from the previous one
+func (m *Monitor) record(ctx context.Context, t task, current interface{}) (bool, error) {
+	snapshot, err := toMap(current)
+	if err != nil {
+		return false, err
+	}
+	now := m.now()
+	if err := m.config.Store.Put(t.endpoint, t.kind, t.key, snapshot, now); err != nil {
+		return false, err
+	}
+	if t.previous == nil {
+		return false, nil
+	}
+
+	previous := fetchers[t.endpoint].result()
+	if err := t.previous.Decode(previous); err != nil {
+		return false, fmt.Errorf("failed to decode previous snapshot: %w", err)
+	}
+
+	events, err := diff.Events(previous, current)
+	if err != nil {
+		return false, err
+	}
+	events = m.filter(events)
+	if len(events) == 0 {
+		return false, nil
+	}
+
+	change := Change{
+		Entity:     t.entity,
+		Endpoint:   t.endpoint,
+		Events:     events,
+		Previous:   previous,
+		Current:    current,
+		DetectedAt: now,
+	}
+	if m.config.OnChange != nil {
+		m.config.OnChange(change)
+	}
+	if m.config.WebhookURL != "" {
+		if err := m.postWebhook(ctx, change); err != nil {
+			m.reportError(t.entity, t.endpoint, err)
+		}
+	}
+	return true, nil
+}
+
+// filter keeps the configured event types
+func (m *Monitor) filter(events []diff.Event) []diff.Event {
+	if len(m.config.EventTypes) == 0 {
+		return events
+	}
+
+	var kept []diff.Event
+	for _, e := range events {
+		for _, t := range m.config.EventTypes {
+			if e.Type() == t {
+				kept = append(kept, e)
+				break
+			}
+		}
+	}
+	return kept
+}
+
+// webhookEvent is the JSON form of a diff.Event
+type webhookEvent struct {
+	Type        diff.EventType `json:"type"`
+	Description string         `json:"description"`
+	Detail      diff.Event     `json:"detail"`
+}
+
+// webhookPayload is the JSON body posted to WebhookURL
+type webhookPayload struct {
+	Kind       EntityKind     `json:"kind"`
+	Entity     string         `json:"entity"`
+	Endpoint   string         `json:"endpoint"`
+	DetectedAt time.Time      `json:"detected_at"`
+	Events     []webhookEvent `json:"events"`
+}
+
+func (m *Monitor) postWebhook(ctx context.Context, change Change) error {
+	payload := webhookPayload{
+		Kind:       change.Entity.Kind,
+		Entity:     change.Entity.Value,
+		Endpoint:   change.Endpoint,
+		DetectedAt: change.DetectedAt,
+	}
+	for _, e := range change.Events {
+		payload.Events = append(payload.Events, webhookEvent{Type: e.Type(), Description: e.String(), Detail: e})
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", m.config.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := m.config.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("webhook error: status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (m *Monitor) reportError(entity Entity, endpoint string, err error) {
+	if m.config.OnError != nil {
+		m.config.OnError(entity, endpoint, err)
+	}
+}
+
+// toMap converts a typed response into the raw form kept by the store
+func toMap(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
+	return m, nil
+}
@@ -0,0 +1,201 @@
+package monitor
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+
+	"github.com/cufinder/cufinder-go"
+	"github.com/cufinder/cufinder-go/diff"
+	"github.com/cufinder/cufinder-go/store"
+)
+
+// fakeAPI serves CAR, ELF and EPP responses whose content can be changed
+type fakeAPI struct {
+	mu       sync.Mutex
+	revenue  string
+	round    string
+	jobTitle string
+	calls    map[string]int
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls[r.URL.Path]++
+
+	var data map[string]interface{}
+	switch r.URL.Path {
+	case "/car":
+		data = map[string]interface{}{"annual_revenue": f.revenue, "credit_count": 1}
+	case "/elf":
+		data = map[string]interface{}{"fundraising_info": map[string]interface{}{"funding_last_round_type": f.round}, "credit_count": 2}
+	case "/epp":
+		data = map[string]interface{}{"person": map[string]interface{}{"full_name": "John Doe", "job_title": f.jobTitle}}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+}
+
+func (f *fakeAPI) set(revenue, round, jobTitle string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.revenue, f.round, f.jobTitle = revenue, round, jobTitle
+}
+
+func newTestMonitor(t *testing.T, config Config) (*Monitor, *fakeAPI) {
+	api := &fakeAPI{calls: make(map[string]int)}
+	api.set("$1M-$10M", "Seed", "Engineer")
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	db, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	st, err := store.New(db)
+	require.NoError(t, err)
+	t.Cleanup(func() { st.Close() })
+
+	config.Service = cufinder.NewService(cufinder.NewClient(cufinder.ClientConfig{
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		DisableCoalescing: true,
+	}))
+	config.Store = st
+	if config.DomainEndpoints == nil {
+		config.DomainEndpoints = []string{"/car", "/elf"}
+	}
+
+	m, err := New(config)
+	require.NoError(t, err)
+	m.Watch(Domain("techcorp.com"), LinkedIn("https://www.linkedin.com/in/johndoe"))
+	return m, api
+}
+
+func TestMonitorDetectsChanges(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		changes  []Change
+		payloads []map[string]interface{}
+	)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		mu.Lock()
+		payloads = append(payloads, payload)
+		mu.Unlock()
+	}))
+	defer webhook.Close()
+
+	m, api := newTestMonitor(t, Config{
+		WebhookURL: webhook.URL,
+		OnChange:   func(c Change) { changes = append(changes, c) },
+		OnError:    func(e Entity, endpoint string, err error) { t.Errorf("%s %s: %v", e.Value, endpoint, err) },
+	})
+
+	stats, err := m.Refresh(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Stats{Calls: 3, Credits: 4}, stats)
+	assert.Empty(t, changes)
+
+	stats, err = m.Refresh(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Stats{Calls: 3, Credits: 4}, stats)
+	assert.Empty(t, changes)
+
+	api.set("$10M-$50M", "Series A", "CTO")
+	stats, err = m.Refresh(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, stats.Changes)
+
+	events := make(map[diff.EventType]Entity)
+	for _, c := range changes {
+		require.Len(t, c.Events, 1)
+		events[c.Events[0].Type()] = c.Entity
+	}
+	assert.Equal(t, map[diff.EventType]Entity{
+		diff.EventRevenueChanged:  Domain("techcorp.com"),
+		diff.EventNewFundingRound: Domain("techcorp.com"),
+		diff.EventJobChanged:      LinkedIn("https://www.linkedin.com/in/johndoe"),
+	}, events)
+
+	require.Len(t, payloads, 3)
+	for _, p := range payloads {
+		if p["endpoint"] == "/car" {
+			assert.Equal(t, "techcorp.com", p["entity"])
+			assert.Equal(t, []interface{}{map[string]interface{}{
+				"type":        "revenue_changed",
+				"description": "Revenue changed: $1M-$10M -> $10M-$50M",
+				"detail":      map[string]interface{}{"Old": "$1M-$10M", "New": "$10M-$50M"},
+			}}, p["events"])
+		}
+	}
+}
+
+func TestMonitorCreditBudget(t *testing.T) {
+	m, api := newTestMonitor(t, Config{CreditBudget: 2})
+
+	stats, err := m.Refresh(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, stats.Calls)
+	assert.Equal(t, 1, stats.Skipped)
+
+	// The profile skipped last cycle has never been fetched, so it goes first
+	// and the stalest domain endpoint uses the rest of the budget
+	stats, err = m.Refresh(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.Skipped)
+	assert.Equal(t, map[string]int{"/car": 2, "/elf": 1, "/epp": 1}, api.calls)
+}
+
+func TestMonitorEventFilter(t *testing.T) {
+	var changes []Change
+	m, api := newTestMonitor(t, Config{
+		EventTypes: []diff.EventType{diff.EventJobChanged},
+		OnChange:   func(c Change) { changes = append(changes, c) },
+	})
+
+	_, err := m.Refresh(context.Background())
+	require.NoError(t, err)
+	api.set("$10M-$50M", "Series A", "CTO")
+	_, err = m.Refresh(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, changes, 1)
+	assert.Equal(t, "/epp", changes[0].Endpoint)
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Config{})
+	assert.Error(t, err)
+
+	_, err = New(Config{Service: &cufinder.Service{}, Store: &store.Store{}, DomainEndpoints: []string{"/cse"}})
+	assert.EqualError(t, err, `unsupported endpoint "/cse"`)
+}
+
+func TestMonitorCountsFailedCalls(t *testing.T) {
+	var failed []string
+	m, _ := newTestMonitor(t, Config{
+		// The fake API has no FTS endpoint
+		DomainEndpoints: []string{"/car", "/fts"},
+		OnError:         func(e Entity, endpoint string, err error) { failed = append(failed, endpoint) },
+	})
+
+	// CAR reports one credit, EPP none, and the failed FTS call may still
+	// be billed
+	stats, err := m.Refresh(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Stats{Calls: 3, Credits: 3, Errors: 1}, stats)
+	assert.Equal(t, []string{"/fts"}, failed)
+}
@@ -0,0 +1,75 @@
+package cufinder
+
+import "github.com/cufinder/cufinder-go/linkedin"
+
+// normalize canonicalizes LinkedIn URLs and phone numbers of a parsed
+// response unless it is disabled on the client. Values that cannot be parsed
+// are left unchanged.
+func (s *Service) normalize(result interface{}) {
+	if s.client.disableNormalize {
+		return
+	}
+	normalizeResponse(result, s.client.phones)
+}
+
+func normalizeResponse(result interface{}, phones phoneParser) {
+	switch r := result.(type) {
+	case *NtpResponse:
+		for i := range r.Phones {
+			phones.canonical(&r.Phones[i])
+		}
+	case *LcufResponse:
+		canonicalLinkedIn(&r.LinkedInURL)
+	case *RelResponse:
+		canonicalLinkedIn(&r.Person.LinkedInURL)
+		canonicalLinkedIn(&r.Person.CompanyLinkedIn)
+	case *EppResponse:
+		canonicalLinkedIn(&r.Person.LinkedInURL)
+		canonicalLinkedIn(&r.Person.CompanyLinkedIn)
+	case *TepResponse:
+		canonicalLinkedIn(&r.Person.LinkedInURL)
+		canonicalLinkedIn(&r.Person.CompanyLinkedIn)
+	case *EncResponse:
+		canonicalLinkedIn(&r.Company.LinkedInURL)
+	case *FclResponse:
+		for i := range r.Companies {
+			canonicalLinkedIn(&r.Companies[i].LinkedinUrl)
+		}
+	case *CseResponse:
+		for i := range r.Companies {
+			normalizeCompany(&r.Companies[i], phones)
+		}
+	case *LbsResponse:
+		for i := range r.Companies {
+			normalizeCompany(&r.Companies[i], phones)
+		}
+	case *PseResponse:
+		for i := range r.Peoples {
+			normalizePerson(&r.Peoples[i], phones)
+		}
+	}
+}
+
+func normalizeCompany(c *Company, phones phoneParser) {
+	canonicalLinkedIn(&c.LinkedInURL)
+	canonicalLinkedIn(&c.Social.LinkedIn)
+	phones.canonical(&c.Phone)
+}
+
+func normalizePerson(p *Person, phones phoneParser) {
+	canonicalLinkedIn(&p.Social.LinkedIn)
+	normalizeCompany(&p.Company, phones)
+	for i := range p.Experiences {
+		canonicalLinkedIn(&p.Experiences[i].Company.LinkedInURL)
+	}
+	for i := range p.Educations {
+		canonicalLinkedIn(&p.Educations[i].School.LinkedinURL)
+	}
+}
+
+// canonicalLinkedIn replaces a LinkedIn URL with its canonical form
+func canonicalLinkedIn(value *string) {
+	if u, err := linkedin.Parse(*value); err == nil {
+		*value = u.String()
+	}
+}
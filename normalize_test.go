@@ -0,0 +1,51 @@
+package cufinder
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeResponses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		switch r.URL.Path {
+		case "/lcuf":
+			body = map[string]interface{}{"linkedin_url": "uk.linkedin.com/company/TechCorp/"}
+		case "/pse":
+			body = map[string]interface{}{"peoples": []map[string]interface{}{{
+				"full_name": "John Doe",
+				"social":    map[string]interface{}{"linkedin": "http://linkedin.com/in/John-Doe?trk=x"},
+				"company":   map[string]interface{}{"linkedin_url": "not a url"},
+			}}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(body)
+	}))
+	defer server.Close()
+
+	sdk := NewSDKWithConfig(ClientConfig{APIKey: "test-api-key", BaseURL: server.URL})
+
+	lcuf, err := sdk.LCUF("TechCorp")
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.linkedin.com/company/techcorp", lcuf.LinkedInURL)
+
+	pse, err := sdk.PSE(PseParams{FullName: "John Doe"})
+	require.NoError(t, err)
+	require.Len(t, pse.Peoples, 1)
+	assert.Equal(t, "https://www.linkedin.com/in/john-doe", pse.Peoples[0].Social.LinkedIn)
+	assert.Equal(t, "not a url", pse.Peoples[0].Company.LinkedInURL)
+
+	raw := NewSDKWithConfig(ClientConfig{
+		APIKey:                       "test-api-key",
+		BaseURL:                      server.URL,
+		DisableResponseNormalization: true,
+	})
+	lcuf, err = raw.LCUF("TechCorp")
+	require.NoError(t, err)
+	assert.Equal(t, "uk.linkedin.com/company/TechCorp/", lcuf.LinkedInURL)
+}
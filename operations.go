@@ -0,0 +1,194 @@
+package cufinder
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Operation describes one API endpoint generically, for servers and tools
+// that expose every service by name
+type Operation struct {
+	// Name is the service code, as in "CUF"
+	Name string
+	// Endpoint is the API path, as in "/cuf"
+	Endpoint    string
+	Description string
+
+	newParams func() interface{}
+	response  reflect.Type
+	call      func(s *Service, params interface{}) (interface{}, error)
+}
+
+// Params returns a pointer to new, empty parameters, such as *CufParams
+func (o Operation) Params() interface{} {
+	return o.newParams()
+}
+
+// Response returns a pointer to a new, empty response, such as
+// *CufResponse
+func (o Operation) Response() interface{} {
+	return reflect.New(o.ResponseType()).Interface()
+}
+
+// ParamsType returns the parameter struct type
+func (o Operation) ParamsType() reflect.Type {
+	return reflect.TypeOf(o.newParams()).Elem()
+}
+
+// ResponseType returns the response struct type
+func (o Operation) ResponseType() reflect.Type {
+	return o.response
+}
+
+// Required lists the JSON names of the parameters the service requires
+func (o Operation) Required() []string {
+	return requiredFields(o.ParamsType())
+}
+
+// Missing lists the required parameters left empty in params, a pointer
+// returned by Params or a parameter struct of the matching type
+func (o Operation) Missing(params interface{}) []string {
+	return missingFields(params)
+}
+
+// Call sends the request. params must be a pointer returned by Params or a
+// parameter struct of the matching type.
+func (o Operation) Call(s *Service, params interface{}) (interface{}, error) {
+	params, err := o.pointer(params)
+	if err != nil {
+		return nil, err
+	}
+	return o.call(s, params)
+}
+
+// Key validates and canonicalizes params in place, as the service does
+// before sending them, and returns the key the service coalesces the
+// request under. Equal keys are the same API request, so caches in front
+// of the API can share responses: a DTC request for
+// "https://www.techcorp.com/" has the same key as one for "techcorp.com".
+// Invalid params return a *ValidationError.
+func (o Operation) Key(s *Service, params interface{}) (string, error) {
+	params, err := o.pointer(params)
+	if err != nil {
+		return "", err
+	}
+	if err := s.validate(o.Name, params); err != nil {
+		return "", err
+	}
+	return coalesceKey(o.Endpoint, params)
+}
+
+// pointer returns params as a pointer to the parameter struct, copying
+// values
+func (o Operation) pointer(params interface{}) (interface{}, error) {
+	want := o.ParamsType()
+	v := reflect.ValueOf(params)
+	switch {
+	case !v.IsValid():
+		return nil, fmt.Errorf("%s: params must be %s, got nil", o.Name, want)
+	case v.Type() == want:
+		ptr := reflect.New(want)
+		ptr.Elem().Set(v)
+		return ptr.Interface(), nil
+	case v.Type() != reflect.PointerTo(want) || v.IsNil():
+		return nil, fmt.Errorf("%s: params must be %s, got %T", o.Name, want, params)
+	}
+	return params, nil
+}
+
+func operation[P, R any](name, description string, call func(*Service, P) (*R, error)) Operation {
+	return Operation{
+		Name:        name,
+		Endpoint:    "/" + strings.ToLower(name),
+		Description: description,
+		newParams:   func() interface{} { return new(P) },
+		response:    reflect.TypeOf((*R)(nil)).Elem(),
+		call: func(s *Service, params interface{}) (interface{}, error) {
+			r, err := call(s, *params.(*P))
+			if err != nil {
+				return nil, err
+			}
+			return r, nil
+		},
+	}
+}
+
+// Operations lists every API endpoint
+var Operations = []Operation{
+	operation("CUF", "Get company domain from company name", (*Service).GetDomain),
+	operation("LCUF", "Get LinkedIn URL from company name", (*Service).GetLinkedInURL),
+	operation("DTC", "Get company name from domain", (*Service).GetCompanyName),
+	operation("DTE", "Get company emails from domain", (*Service).GetEmails),
+	operation("NTP", "Get company phones from company name", (*Service).GetPhones),
+	operation("EPP", "Enrich LinkedIn profile", (*Service).EnrichProfile),
+	operation("REL", "Reverse email lookup", (*Service).ReverseEmailLookup),
+	operation("FWE", "Get email from profile", (*Service).GetEmailFromProfile),
+	operation("TEP", "Enrich person information", (*Service).EnrichPerson),
+	operation("FCL", "Get company lookalikes", (*Service).GetLookalikes),
+	operation("ELF", "Get company fundraising information", (*Service).GetFundraising),
+	operation("CAR", "Get company revenue", (*Service).GetRevenue),
+	operation("FCC", "Get company subsidiaries", (*Service).GetSubsidiaries),
+	operation("FTS", "Get company tech stack", (*Service).GetTechStack),
+	operation("ENC", "Enrich company information", (*Service).EnrichCompany),
+	operation("CEC", "Get company employee countries", (*Service).GetEmployeeCountries),
+	operation("CLO", "Get company locations", (*Service).GetLocations),
+	operation("CSE", "Search companies", (*Service).SearchCompanies),
+	operation("PSE", "Search people", (*Service).SearchPeople),
+	operation("LBS", "Search local businesses", (*Service).SearchLocalBusinesses),
+	operation("BCD", "B2B Customers Finder", (*Service).ExtractB2BCustomers),
+	operation("CCP", "Company Career Page Finder", (*Service).FindCareersPage),
+	operation("ISC", "Company Saas Checker", (*Service).IsSaas),
+	operation("CBC", "Company B2B or B2C Checker", (*Service).GetCompanyBusinessType),
+	operation("CSC", "Company Mission Statement", (*Service).GetCompanyMissionStatement),
+	operation("CSN", "Company Snapshot", (*Service).GetCompanySnapshot),
+	operation("NAO", "Phone Number Normalizer", (*Service).NormalizePhone),
+	operation("NAA", "Address Normalizer", (*Service).NormalizeAddress),
+}
+
+// LookupOperation finds an operation by service code or endpoint, ignoring
+// case
+func LookupOperation(name string) (Operation, bool) {
+	name = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(name)), "/")
+	for _, o := range Operations {
+		if strings.ToLower(o.Name) == name {
+			return o, true
+		}
+	}
+	return Operation{}, false
+}
+
+// ResponseCredits returns the credits a response reports using. Responses
+// that report none count as one credit, except NAO answers made offline.
+func ResponseCredits(response interface{}) int {
+	if nao, ok := response.(*NaoResponse); ok && nao.Offline {
+		return 0
+	}
+	v := reflect.ValueOf(response)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Struct {
+		if f := v.FieldByName("CreditCount"); f.IsValid() && f.Int() > 0 {
+			return int(f.Int())
+		}
+	}
+	return 1
+}
+
+// CallCredits returns the credits a call may have used: ResponseCredits
+// for a response, nothing for a request refused locally by validation or
+// the email filter, and one credit for any other failure, since the API
+// may bill a request it answered with an error.
+func CallCredits(response interface{}, err error) int {
+	if err == nil {
+		return ResponseCredits(response)
+	}
+	var validation *ValidationError
+	var filtered *EmailFilterError
+	if errors.As(err, &validation) || errors.As(err, &filtered) {
+		return 0
+	}
+	return 1
+}
@@ -0,0 +1,91 @@
+package cufinder
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperations(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, o := range Operations {
+		assert.False(t, seen[o.Name], o.Name)
+		seen[o.Name] = true
+		assert.Equal(t, o.ParamsType(), reflect.TypeOf(o.Params()).Elem(), o.Name)
+		assert.Equal(t, o.ResponseType(), reflect.TypeOf(o.Response()).Elem(), o.Name)
+	}
+	assert.Len(t, Operations, 28)
+
+	cuf, ok := LookupOperation("cuf")
+	require.True(t, ok)
+	assert.Equal(t, "/cuf", cuf.Endpoint)
+	assert.Equal(t, []string{"company_name", "country_code"}, cuf.Required())
+	assert.Equal(t, []string{"country_code"}, cuf.Missing(&CufParams{CompanyName: "TechCorp"}))
+	assert.Empty(t, cuf.Missing(CufParams{CompanyName: "TechCorp", CountryCode: "US"}))
+
+	cse, ok := LookupOperation("/CSE")
+	require.True(t, ok)
+	assert.Empty(t, cse.Required())
+	assert.Empty(t, cse.Missing(cse.Params()))
+
+	_, ok = LookupOperation("nope")
+	assert.False(t, ok)
+}
+
+func TestOperationCall(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"credit_count": 2, "domain": "acme.com"})
+	}))
+	defer server.Close()
+	service := NewService(NewClient(ClientConfig{APIKey: "key", BaseURL: server.URL}))
+
+	cuf, _ := LookupOperation("CUF")
+	params := cuf.Params().(*CufParams)
+	params.CompanyName, params.CountryCode = "Acme", "us"
+	result, err := cuf.Call(service, params)
+	require.NoError(t, err)
+	assert.Equal(t, "acme.com", result.(*CufResponse).Domain)
+	assert.Equal(t, 2, ResponseCredits(result))
+
+	// Values work as well as pointers
+	_, err = cuf.Call(service, CufParams{CompanyName: "Acme", CountryCode: "US"})
+	assert.NoError(t, err)
+
+	_, err = cuf.Call(service, EncParams{Query: "acme"})
+	assert.Error(t, err)
+	_, err = cuf.Call(service, nil)
+	assert.Error(t, err)
+
+	assert.Equal(t, 1, ResponseCredits(&DtcResponse{}))
+	assert.Equal(t, 0, ResponseCredits(&NaoResponse{Offline: true}))
+
+	assert.Equal(t, 2, CallCredits(result, nil))
+	assert.Equal(t, 1, CallCredits(nil, errors.New("API error: status 500")))
+	_, err = cuf.Call(service, CufParams{CompanyName: "Acme", CountryCode: "USA"})
+	assert.Equal(t, 0, CallCredits(nil, err))
+}
+
+func TestOperationKey(t *testing.T) {
+	service := NewService(NewClient(ClientConfig{APIKey: "key"}))
+	dtc, _ := LookupOperation("DTC")
+
+	params := &DtcParams{CompanyWebsite: "https://www.TechCorp.com/about"}
+	key, err := dtc.Key(service, params)
+	require.NoError(t, err)
+	assert.Equal(t, "techcorp.com", params.CompanyWebsite)
+
+	other, err := dtc.Key(service, DtcParams{CompanyWebsite: "techcorp.com"})
+	require.NoError(t, err)
+	assert.Equal(t, key, other)
+
+	_, err = dtc.Key(service, DtcParams{CompanyWebsite: "localhost"})
+	var verr *ValidationError
+	assert.True(t, errors.As(err, &verr))
+}
@@ -0,0 +1,399 @@
+package cufinder
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cufinder/cufinder-go/linkedin"
+)
+
+// SeniorityTier groups job levels for account planning
+type SeniorityTier string
+
+const (
+	TierExecutive  SeniorityTier = "executive"
+	TierDirector   SeniorityTier = "director"
+	TierManager    SeniorityTier = "manager"
+	TierIndividual SeniorityTier = "individual"
+)
+
+// SeniorityTiers lists every SeniorityTier, most senior first
+var SeniorityTiers = []SeniorityTier{TierExecutive, TierDirector, TierManager, TierIndividual}
+
+// rank orders tiers, most senior first
+func (t SeniorityTier) rank() int {
+	for i, tier := range SeniorityTiers {
+		if tier == t {
+			return i
+		}
+	}
+	return len(SeniorityTiers)
+}
+
+// BuyingCommitteeConfig configures a buying committee discovery
+type BuyingCommitteeConfig struct {
+	// Domain is the account's website domain
+	Domain string
+	// CompanyLinkedInURL or CompanyName select the account in PSE. When both
+	// are empty the name is looked up from Domain with DTC.
+	CompanyLinkedInURL string
+	CompanyName        string
+	// Roles are the departments to search; empty searches every role
+	Roles []JobTitleRole
+	// Levels are the job levels to search. Defaults to manager and above.
+	Levels []JobTitleLevel
+	// MaxPages limits the pages fetched per search. Defaults to 3.
+	MaxPages int
+	// MaxCredits caps the credits spent on the discovery; zero means no limit
+	MaxCredits int
+	// EmailLookups is how many of the most senior members without a work
+	// email get one looked up with FWE
+	EmailLookups int
+}
+
+// CommitteeMember is a person in a buying committee
+type CommitteeMember struct {
+	Person      Person        `json:"person"`
+	Tier        SeniorityTier `json:"tier"`
+	Department  string        `json:"department"`
+	LinkedInURL string        `json:"linkedin_url,omitempty"`
+	// Email is the person's work email from PSE or FWE
+	Email string `json:"email,omitempty"`
+}
+
+// Department is the committee members of one department, most senior first
+type Department struct {
+	Name    string            `json:"name"`
+	Members []CommitteeMember `json:"members"`
+}
+
+// Tier returns the department's members in a seniority tier
+func (d Department) Tier(tier SeniorityTier) []CommitteeMember {
+	var members []CommitteeMember
+	for _, m := range d.Members {
+		if m.Tier == tier {
+			members = append(members, m)
+		}
+	}
+	return members
+}
+
+// BuyingCommittee is the org view of an account
+type BuyingCommittee struct {
+	Domain      string       `json:"domain"`
+	CompanyName string       `json:"company_name,omitempty"`
+	Departments []Department `json:"departments"`
+	Calls       int          `json:"calls"`
+	Credits     int          `json:"credits"`
+}
+
+// Members returns every member across departments, most senior first
+func (c *BuyingCommittee) Members() []CommitteeMember {
+	var members []CommitteeMember
+	for _, d := range c.Departments {
+		members = append(members, d.Members...)
+	}
+	sortMembers(members)
+	return members
+}
+
+// defaultCommitteeLevels are the levels searched when none are configured
+var defaultCommitteeLevels = []JobTitleLevel{
+	LevelCXO, LevelOwner, LevelPartner, LevelVP, LevelDirector, LevelManager,
+}
+
+// DiscoverBuyingCommittee finds the decision makers at an account in the
+// given roles
+func (s *Service) DiscoverBuyingCommittee(ctx context.Context, companyDomain string, roles []JobTitleRole) (*BuyingCommittee, error) {
+	return s.DiscoverBuyingCommitteeWithConfig(ctx, BuyingCommitteeConfig{Domain: companyDomain, Roles: roles})
+}
+
+// DiscoverBuyingCommitteeWithConfig runs a PSE search for every role and
+// level, following pages until a page adds nobody new. People are
+// deduplicated by LinkedIn profile, people listed at another company are
+// dropped, and the rest are classified into seniority tiers and grouped by
+// department.
+//
+// When the credit cap is reached or ctx is cancelled, the people found so
+// far are returned together with ErrCreditLimit or the context error.
+func (s *Service) DiscoverBuyingCommitteeWithConfig(ctx context.Context, config BuyingCommitteeConfig) (*BuyingCommittee, error) {
+	domain, ok := CanonicalDomain(config.Domain)
+	if !ok {
+		return nil, fmt.Errorf("a valid company domain is required")
+	}
+	if len(config.Levels) == 0 {
+		config.Levels = defaultCommitteeLevels
+	}
+	if config.MaxPages <= 0 {
+		config.MaxPages = 3
+	}
+
+	d := &committeeDiscovery{
+		service: s,
+		config:  config,
+		domain:  domain,
+		budget:  creditBudget{max: config.MaxCredits},
+		seen:    make(map[string]bool),
+	}
+	err := d.run(ctx)
+
+	committee := &BuyingCommittee{
+		Domain:      domain,
+		CompanyName: d.config.CompanyName,
+		Departments: groupDepartments(d.members),
+		Calls:       d.budget.calls,
+		Credits:     d.budget.used,
+	}
+	return committee, err
+}
+
+// committeeDiscovery holds the state of one discovery
+type committeeDiscovery struct {
+	service *Service
+	config  BuyingCommitteeConfig
+	domain  string
+	budget  creditBudget
+	seen    map[string]bool
+	members []CommitteeMember
+	// searchErr is the first failed search, reported if nobody was found
+	searchErr error
+}
+
+func (d *committeeDiscovery) run(ctx context.Context) error {
+	if d.config.CompanyLinkedInURL == "" && d.config.CompanyName == "" {
+		if err := d.budget.proceed(ctx); err != nil {
+			return err
+		}
+		response, err := d.service.GetCompanyName(DtcParams{CompanyWebsite: d.domain})
+		d.budget.spend(response, err)
+		if err != nil {
+			return fmt.Errorf("failed to resolve company name: %w", err)
+		}
+		// Without a name every search would match people at any company
+		if strings.TrimSpace(response.CompanyName) == "" {
+			return fmt.Errorf("could not resolve company name for %s", d.domain)
+		}
+		d.config.CompanyName = response.CompanyName
+	}
+
+	roles := d.config.Roles
+	if len(roles) == 0 {
+		roles = []JobTitleRole{""}
+	}
+
+	for _, role := range roles {
+		for _, level := range d.config.Levels {
+			if err := d.search(ctx, role, level); err != nil {
+				return err
+			}
+		}
+	}
+	if len(d.members) == 0 && d.searchErr != nil {
+		return d.searchErr
+	}
+
+	return d.lookupEmails(ctx)
+}
+
+// search pages through the people at one role and level
+func (d *committeeDiscovery) search(ctx context.Context, role JobTitleRole, level JobTitleLevel) error {
+	for page := 1; page <= d.config.MaxPages; page++ {
+		if err := d.budget.proceed(ctx); err != nil {
+			return err
+		}
+
+		response, err := d.service.SearchPeople(PseParams{
+			CompanyLinkedInURL: d.config.CompanyLinkedInURL,
+			CompanyName:        d.config.CompanyName,
+			JobTitleRole:       string(role),
+			JobTitleLevel:      string(level),
+			Page:               page,
+		})
+		d.budget.spend(response, err)
+		if err != nil {
+			if d.searchErr == nil {
+				d.searchErr = err
+			}
+			return nil
+		}
+
+		added := 0
+		for _, person := range response.Peoples {
+			if d.add(person) {
+				added++
+			}
+		}
+		if added == 0 {
+			return nil
+		}
+	}
+	return nil
+}
+
+// add records a person unless they were seen before or work elsewhere
+func (d *committeeDiscovery) add(person Person) bool {
+	if website := firstNonEmpty(person.Company.Domain, person.Company.Website); website != "" {
+		if domain, ok := CanonicalDomain(website); ok && domain != d.domain {
+			return false
+		}
+	}
+
+	linkedIn := profileURL(person.Social)
+	key := strings.ToLower(linkedIn)
+	if key == "" {
+		key = nameKey(person.FullName)
+	}
+	if d.seen[key] {
+		return false
+	}
+	d.seen[key] = true
+
+	d.members = append(d.members, CommitteeMember{
+		Person:      person,
+		Tier:        ClassifySeniority(person.CurrentJob),
+		Department:  ClassifyDepartment(person.CurrentJob),
+		LinkedInURL: linkedIn,
+		Email:       person.Connections.WorkEmail,
+	})
+	return true
+}
+
+// lookupEmails finds work emails for the most senior members without one
+func (d *committeeDiscovery) lookupEmails(ctx context.Context) error {
+	if d.config.EmailLookups <= 0 {
+		return nil
+	}
+	sortMembers(d.members)
+
+	lookups := 0
+	for i := range d.members {
+		m := &d.members[i]
+		if lookups >= d.config.EmailLookups {
+			break
+		}
+		if m.Email != "" || m.LinkedInURL == "" {
+			continue
+		}
+		if err := d.budget.proceed(ctx); err != nil {
+			return err
+		}
+
+		lookups++
+		response, err := d.service.GetEmailFromProfile(FweParams{LinkedInURL: m.LinkedInURL})
+		d.budget.spend(response, err)
+		if err != nil {
+			continue
+		}
+		m.Email = response.WorkEmail
+	}
+	return nil
+}
+
+// ClassifySeniority maps a job to a seniority tier by its level, falling
+// back to keywords in the title
+func ClassifySeniority(job PeopleCurrentJob) SeniorityTier {
+	switch JobTitleLevel(strings.ToLower(strings.TrimSpace(job.Level))) {
+	case LevelCXO, LevelOwner, LevelPartner, LevelVP:
+		return TierExecutive
+	case LevelDirector:
+		return TierDirector
+	case LevelManager:
+		return TierManager
+	case LevelSenior, LevelEntry, LevelTraining, LevelUnpaid:
+		return TierIndividual
+	}
+
+	title := " " + strings.ToLower(job.Title) + " "
+	for _, tier := range []struct {
+		tier     SeniorityTier
+		keywords []string
+	}{
+		{TierExecutive, []string{"chief", " ceo ", " cto ", " cfo ", " coo ", " cmo ", " cio ", "founder", "owner", "partner", "president", " vp ", "vice president"}},
+		{TierDirector, []string{"director", "head of"}},
+		{TierManager, []string{"manager", " lead "}},
+	} {
+		for _, keyword := range tier.keywords {
+			if strings.Contains(title, keyword) {
+				return tier.tier
+			}
+		}
+	}
+	return TierIndividual
+}
+
+// ClassifyDepartment returns the department of a job: its role, or its
+// first category when the role is missing
+func ClassifyDepartment(job PeopleCurrentJob) string {
+	if role := strings.ToLower(strings.TrimSpace(job.Role)); role != "" {
+		return role
+	}
+	for _, c := range job.Categories {
+		if category := strings.ToLower(strings.TrimSpace(firstNonEmpty(c.SuperCategory, c.Category))); category != "" {
+			return category
+		}
+	}
+	return "other"
+}
+
+// profileURL returns a person's LinkedIn profile URL
+func profileURL(social PeopleSocial) string {
+	if social.LinkedIn != "" {
+		return social.LinkedIn
+	}
+	if social.LinkedinUsername != "" {
+		return linkedin.URL{Kind: linkedin.Person, Slug: strings.ToLower(social.LinkedinUsername)}.String()
+	}
+	return ""
+}
+
+// groupDepartments groups members by department, largest department first
+func groupDepartments(members []CommitteeMember) []Department {
+	index := make(map[string]int)
+	var departments []Department
+	for _, m := range members {
+		i, ok := index[m.Department]
+		if !ok {
+			i = len(departments)
+			index[m.Department] = i
+			departments = append(departments, Department{Name: m.Department})
+		}
+		departments[i].Members = append(departments[i].Members, m)
+	}
+
+	for i := range departments {
+		sortMembers(departments[i].Members)
+	}
+	sort.SliceStable(departments, func(i, j int) bool {
+		if len(departments[i].Members) != len(departments[j].Members) {
+			return len(departments[i].Members) > len(departments[j].Members)
+		}
+		return departments[i].Name < departments[j].Name
+	})
+	return departments
+}
+
+// sortMembers orders members by tier, then job level, then name
+func sortMembers(members []CommitteeMember) {
+	sort.SliceStable(members, func(i, j int) bool {
+		a, b := members[i], members[j]
+		if a.Tier != b.Tier {
+			return a.Tier.rank() < b.Tier.rank()
+		}
+		if la, lb := levelRank(a.Person.CurrentJob.Level), levelRank(b.Person.CurrentJob.Level); la != lb {
+			return la < lb
+		}
+		return a.Person.FullName < b.Person.FullName
+	})
+}
+
+// levelRank orders job levels as listed in JobTitleLevels
+func levelRank(level string) int {
+	for i, l := range JobTitleLevels {
+		if string(l) == strings.ToLower(level) {
+			return i
+		}
+	}
+	return len(JobTitleLevels)
+}
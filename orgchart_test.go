@@ -0,0 +1,126 @@
+package cufinder
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func committeeEndpoints() map[string]fakeEndpoint {
+	person := func(name, username, level, role, title string) map[string]interface{} {
+		return map[string]interface{}{
+			"full_name":   name,
+			"social":      map[string]interface{}{"linkedin_username": username},
+			"company":     map[string]interface{}{"domain": "techcorp.com"},
+			"current_job": map[string]interface{}{"title": title, "level": level, "role": role},
+		}
+	}
+	people := map[string][]interface{}{
+		"engineering/cxo/1": {person("Ada Chief", "ada", "cxo", "engineering", "CTO")},
+		"engineering/director/1": {
+			person("Bob Director", "bob", "director", "engineering", "Director of Platform"),
+			person("Ada Chief", "ada", "cxo", "engineering", "CTO"),
+		},
+		"engineering/director/2": {person("Eve Lead", "eve", "", "", "Head of Infrastructure")},
+		"engineering/manager/1": {
+			person("Carl Manager", "carl", "manager", "engineering", "Engineering Manager"),
+			map[string]interface{}{"full_name": "Elsewhere", "company": map[string]interface{}{"domain": "other.com"}},
+		},
+		"finance/vp/1": {person("Dana Money", "dana", "vp", "finance", "VP Finance")},
+	}
+
+	return map[string]fakeEndpoint{
+		"/dtc": func(form url.Values) map[string]interface{} {
+			return map[string]interface{}{"company_name": "TechCorp"}
+		},
+		"/pse": func(form url.Values) map[string]interface{} {
+			if form.Get("company_name") != "TechCorp" {
+				return nil
+			}
+			key := form.Get("job_title_role") + "/" + form.Get("job_title_level") + "/" + form.Get("page")
+			return map[string]interface{}{"peoples": people[key]}
+		},
+		"/fwe": func(form url.Values) map[string]interface{} {
+			return map[string]interface{}{"work_email": "ada@techcorp.com"}
+		},
+	}
+}
+
+func TestDiscoverBuyingCommittee(t *testing.T) {
+	sdk, _ := newWorkflowSDK(t, committeeEndpoints())
+
+	committee, err := sdk.DiscoverBuyingCommitteeWithConfig(context.Background(), BuyingCommitteeConfig{
+		Domain:       "https://www.techcorp.com",
+		Roles:        []JobTitleRole{RoleEngineering, RoleFinance},
+		EmailLookups: 1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "TechCorp", committee.CompanyName)
+
+	require.Len(t, committee.Departments, 3)
+	engineering := committee.Departments[0]
+	assert.Equal(t, "engineering", engineering.Name)
+
+	var names []string
+	for _, m := range engineering.Members {
+		names = append(names, m.Person.FullName)
+	}
+	assert.Equal(t, []string{"Ada Chief", "Bob Director", "Carl Manager"}, names)
+	assert.Equal(t, TierExecutive, engineering.Members[0].Tier)
+	assert.Equal(t, "ada@techcorp.com", engineering.Members[0].Email)
+	assert.Equal(t, "https://www.linkedin.com/in/ada", engineering.Members[0].LinkedInURL)
+	assert.Len(t, engineering.Tier(TierDirector), 1)
+
+	// Eve has no level or role, so her title and missing role decide
+	assert.Equal(t, "finance", committee.Departments[1].Name)
+	other := committee.Departments[2]
+	assert.Equal(t, "other", other.Name)
+	assert.Equal(t, TierDirector, other.Members[0].Tier)
+
+	members := committee.Members()
+	require.Len(t, members, 5)
+	assert.Equal(t, []string{"Ada Chief", "Dana Money"}, []string{members[0].Person.FullName, members[1].Person.FullName})
+}
+
+func TestDiscoverBuyingCommitteeCreditCap(t *testing.T) {
+	sdk, _ := newWorkflowSDK(t, committeeEndpoints())
+
+	committee, err := sdk.DiscoverBuyingCommitteeWithConfig(context.Background(), BuyingCommitteeConfig{
+		Domain:      "techcorp.com",
+		CompanyName: "TechCorp",
+		Roles:       []JobTitleRole{RoleEngineering},
+		MaxCredits:  1,
+	})
+	assert.ErrorIs(t, err, ErrCreditLimit)
+	assert.Len(t, committee.Members(), 1)
+
+	_, err = sdk.DiscoverBuyingCommittee(context.Background(), "not a domain", nil)
+	assert.Error(t, err)
+}
+
+func TestDiscoverBuyingCommitteeUnknownCompany(t *testing.T) {
+	endpoints := committeeEndpoints()
+	endpoints["/dtc"] = func(form url.Values) map[string]interface{} {
+		return map[string]interface{}{"company_name": ""}
+	}
+	sdk, calls := newWorkflowSDK(t, endpoints)
+
+	_, err := sdk.DiscoverBuyingCommittee(context.Background(), "techcorp.com", []JobTitleRole{RoleEngineering})
+	assert.EqualError(t, err, "could not resolve company name for techcorp.com")
+	require.Len(t, *calls, 1, "no search is sent without a company filter")
+	assert.True(t, strings.HasPrefix((*calls)[0], "/dtc?"))
+}
+
+func TestClassifySeniority(t *testing.T) {
+	assert.Equal(t, TierExecutive, ClassifySeniority(PeopleCurrentJob{Level: "VP"}))
+	assert.Equal(t, TierIndividual, ClassifySeniority(PeopleCurrentJob{Level: "senior", Title: "Senior Director"}))
+	assert.Equal(t, TierExecutive, ClassifySeniority(PeopleCurrentJob{Title: "Co-Founder & CEO"}))
+	assert.Equal(t, TierManager, ClassifySeniority(PeopleCurrentJob{Title: "Tech Lead"}))
+	assert.Equal(t, TierIndividual, ClassifySeniority(PeopleCurrentJob{Title: "Software Engineer"}))
+
+	assert.Equal(t, "sales", ClassifyDepartment(PeopleCurrentJob{Categories: []JobTitleCategory{{Category: "account executive", SuperCategory: "Sales"}}}))
+}
@@ -0,0 +1,313 @@
+// Package phone parses and formats phone numbers offline.
+//
+// It knows the calling code, trunk prefix, number lengths and mobile,
+// toll-free and premium-rate prefixes of common countries, which is enough
+// to bring most business numbers into E.164 form without an API call:
+//
+//	n, err := phone.Parse("020 7946 0018", "GB")
+//	n.E164()       // "+442079460018"
+//	n.National()   // "02079460018"
+//	n.Type         // phone.FixedLine
+//
+// Numbers are checked for plausible length, not for assignment, so the SDK
+// only answers NAO offline when a number parses cleanly (see
+// cufinder.ClientConfig.OfflinePhones). The package has no dependencies.
+package phone
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrEmpty is returned when the input has no digits
+	ErrEmpty = errors.New("empty phone number")
+	// ErrInvalid is returned for inputs with letters or other characters
+	// that do not belong in a phone number
+	ErrInvalid = errors.New("invalid phone number")
+	// ErrNoRegion is returned for a national number without a default region
+	ErrNoRegion = errors.New("phone number has no calling code and no region is known")
+	// ErrUnknownRegion is returned for calling codes and regions the package
+	// has no numbering plan for
+	ErrUnknownRegion = errors.New("unknown phone region")
+	// ErrLength is returned when the number is too short or too long for its
+	// region
+	ErrLength = errors.New("phone number has an invalid length")
+)
+
+// Type is the kind of line a number belongs to, where the prefix tells
+type Type string
+
+const (
+	Unknown       Type = ""
+	FixedLine     Type = "fixed_line"
+	Mobile        Type = "mobile"
+	FixedOrMobile Type = "fixed_line_or_mobile"
+	TollFree      Type = "toll_free"
+	PremiumRate   Type = "premium_rate"
+)
+
+// Number is a parsed phone number
+type Number struct {
+	// CountryCode is the calling code, such as 44
+	CountryCode int `json:"country_code"`
+	// Region is the ISO 3166-1 alpha-2 code of the country, such as "GB"
+	Region string `json:"region"`
+	// NationalNumber is the national significant number, without trunk
+	// prefix, as in "2079460018"
+	NationalNumber string `json:"national_number"`
+	Extension      string `json:"extension,omitempty"`
+	Type           Type   `json:"type,omitempty"`
+}
+
+// Parse parses a phone number. Numbers written with "+" or the "00"
+// international prefix carry their own calling code; other numbers are read
+// as national numbers of defaultRegion, an ISO 3166-1 alpha-2 code.
+func Parse(raw, defaultRegion string) (Number, error) {
+	digits, extension, international, err := clean(raw)
+	if err != nil {
+		return Number{}, err
+	}
+	defaultRegion = strings.ToUpper(strings.TrimSpace(defaultRegion))
+
+	if !international {
+		if plan, ok := regions[defaultRegion]; ok && plan.code == 1 && strings.HasPrefix(digits, "011") {
+			digits, international = digits[3:], true
+		} else if strings.HasPrefix(digits, "00") {
+			digits, international = digits[2:], true
+		}
+	}
+
+	var n Number
+	if international {
+		n, err = parseInternational(digits)
+	} else {
+		n, err = parseNational(digits, defaultRegion)
+	}
+	if err != nil {
+		return Number{}, err
+	}
+	n.Extension = extension
+
+	plan := regions[planRegion(n)]
+	if len(n.NationalNumber) < plan.minLength || len(n.NationalNumber) > plan.maxLength {
+		return Number{}, fmt.Errorf("%w: %q", ErrLength, raw)
+	}
+	if plan.code == 1 && (n.NationalNumber[0] < '2' || n.NationalNumber[3] < '2') {
+		return Number{}, fmt.Errorf("%w: %q has an invalid area code or exchange", ErrInvalid, raw)
+	}
+	n.Type = numberType(plan, n.NationalNumber)
+	return n, nil
+}
+
+// Normalize returns the E.164 form of a number, or false when it cannot be
+// parsed
+func Normalize(raw, defaultRegion string) (string, bool) {
+	n, err := Parse(raw, defaultRegion)
+	if err != nil {
+		return "", false
+	}
+	return n.E164(), true
+}
+
+// clean strips formatting and splits off an extension
+func clean(raw string) (digits, extension string, international bool, err error) {
+	s := strings.ToLower(strings.TrimSpace(raw))
+	s = strings.TrimPrefix(s, "tel:")
+	for _, marker := range []string{";ext=", "extension", "ext.", "ext", "x", "#"} {
+		if i := strings.LastIndex(s, marker); i > 0 {
+			if ext := strings.TrimSpace(s[i+len(marker):]); ext != "" && isDigits(ext) {
+				s, extension = s[:i], ext
+				break
+			}
+		}
+	}
+	// "+44 (0)20 ..." repeats the trunk prefix after the calling code
+	s = strings.Replace(s, "(0)", "", 1)
+
+	var b strings.Builder
+	for i, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == '+' && b.Len() == 0 && !international:
+			international = true
+		case strings.ContainsRune(" -.()/ ", r):
+		default:
+			return "", "", false, fmt.Errorf("%w: %q has %q at %d", ErrInvalid, raw, r, i)
+		}
+	}
+	if b.Len() == 0 {
+		return "", "", false, ErrEmpty
+	}
+	return b.String(), extension, international, nil
+}
+
+func parseInternational(digits string) (Number, error) {
+	for size := 1; size <= 3 && size < len(digits); size++ {
+		code, _ := strconv.Atoi(digits[:size])
+		region, ok := regionFor(code, digits[size:])
+		if !ok {
+			continue
+		}
+		// A trunk prefix written after the calling code is dropped
+		national := stripTrunk(regions[planRegionOf(code, region)], digits[size:])
+		return Number{CountryCode: code, Region: region, NationalNumber: national}, nil
+	}
+	return Number{}, fmt.Errorf("%w: unknown calling code in +%s", ErrUnknownRegion, digits)
+}
+
+func parseNational(digits, defaultRegion string) (Number, error) {
+	if defaultRegion == "" {
+		return Number{}, ErrNoRegion
+	}
+	plan, ok := regions[defaultRegion]
+	if !ok {
+		return Number{}, fmt.Errorf("%w: %s", ErrUnknownRegion, defaultRegion)
+	}
+
+	national := stripTrunk(plan, digits)
+	region, _ := regionFor(plan.code, national)
+	if plan.code != 1 && plan.code != 7 {
+		region = defaultRegion
+	}
+	return Number{CountryCode: plan.code, Region: region, NationalNumber: national}, nil
+}
+
+// stripTrunk removes the trunk prefix from a national number when what
+// remains is still long enough to be a number of the region
+func stripTrunk(plan region, national string) string {
+	if plan.trunk != "" && strings.HasPrefix(national, plan.trunk) && len(national)-len(plan.trunk) >= plan.minLength {
+		return national[len(plan.trunk):]
+	}
+	return national
+}
+
+// regionFor returns the country of a national number with a calling code
+func regionFor(code int, national string) (string, bool) {
+	switch code {
+	case 1:
+		if len(national) >= 3 {
+			if region, ok := nanpRegions[national[:3]]; ok {
+				return region, true
+			}
+		}
+	case 7:
+		if strings.HasPrefix(national, "6") || strings.HasPrefix(national, "7") {
+			return "KZ", true
+		}
+	}
+	if region, ok := mainRegions[code]; ok {
+		return region, true
+	}
+	if region, ok := codeRegions[code]; ok {
+		return region, true
+	}
+	return "", false
+}
+
+// planRegion returns the region whose numbering plan applies to n. North
+// American countries outside the table share the United States plan.
+func planRegion(n Number) string {
+	return planRegionOf(n.CountryCode, n.Region)
+}
+
+func planRegionOf(code int, region string) string {
+	if _, ok := regions[region]; ok {
+		return region
+	}
+	return mainRegions[code]
+}
+
+// codeRegions maps calling codes to the single region using them
+var codeRegions = func() map[int]string {
+	names := make([]string, 0, len(regions))
+	for name := range regions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	m := make(map[int]string)
+	for _, name := range names {
+		if _, ok := m[regions[name].code]; !ok {
+			m[regions[name].code] = name
+		}
+	}
+	return m
+}()
+
+func numberType(plan region, national string) Type {
+	switch {
+	case hasPrefix(national, plan.tollFree):
+		return TollFree
+	case hasPrefix(national, plan.premium):
+		return PremiumRate
+	case plan.code == 1:
+		return FixedOrMobile
+	case hasPrefix(national, plan.mobile):
+		return Mobile
+	case len(plan.mobile) > 0:
+		return FixedLine
+	}
+	return Unknown
+}
+
+func hasPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// CallingCode returns the calling code of a region
+func CallingCode(region string) (int, bool) {
+	plan, ok := regions[strings.ToUpper(region)]
+	return plan.code, ok
+}
+
+// E164 returns the number as "+<code><national number>"
+func (n Number) E164() string {
+	return "+" + strconv.Itoa(n.CountryCode) + n.NationalNumber
+}
+
+// String returns the E.164 form with the extension, if any
+func (n Number) String() string {
+	if n.Extension != "" {
+		return n.E164() + " ext. " + n.Extension
+	}
+	return n.E164()
+}
+
+// International returns the number as dialled from abroad, as in
+// "+44 2079460018" or "+1 415-555-0100"
+func (n Number) International() string {
+	if n.CountryCode == 1 && len(n.NationalNumber) == 10 {
+		nsn := n.NationalNumber
+		return "+1 " + nsn[:3] + "-" + nsn[3:6] + "-" + nsn[6:]
+	}
+	return "+" + strconv.Itoa(n.CountryCode) + " " + n.NationalNumber
+}
+
+// National returns the number as dialled within its country, with the
+// trunk prefix, as in "02079460018" or "(415) 555-0100"
+func (n Number) National() string {
+	if n.CountryCode == 1 && len(n.NationalNumber) == 10 {
+		nsn := n.NationalNumber
+		return "(" + nsn[:3] + ") " + nsn[3:6] + "-" + nsn[6:]
+	}
+	return regions[planRegion(n)].trunk + n.NationalNumber
+}
@@ -0,0 +1,98 @@
+package phone
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		raw    string
+		region string
+		e164   string
+		where  string
+		kind   Type
+	}{
+		{"+44 20 7946 0018", "", "+442079460018", "GB", FixedLine},
+		{"+44 (0)20 7946 0018", "", "+442079460018", "GB", FixedLine},
+		{"020 7946 0018", "GB", "+442079460018", "GB", FixedLine},
+		{"07700 900123", "gb", "+447700900123", "GB", Mobile},
+		{"0044 7700 900123", "US", "+447700900123", "GB", Mobile},
+		{"(415) 555-0100", "US", "+14155550100", "US", FixedOrMobile},
+		{"1-415-555-0100", "US", "+14155550100", "US", FixedOrMobile},
+		{"+1 416 555 0100", "", "+14165550100", "CA", FixedOrMobile},
+		{"+1 876 555 0100", "", "+18765550100", "JM", FixedOrMobile},
+		{"011 49 30 123456", "US", "+4930123456", "DE", FixedLine},
+		{"+1 800 555 0100", "", "+18005550100", "US", TollFree},
+		{"+49 (0) 171 1234567", "", "+491711234567", "DE", Mobile},
+		{"01 23 45 67 89", "FR", "+33123456789", "FR", FixedLine},
+		{"+33 6 12 34 56 78", "", "+33612345678", "FR", Mobile},
+		{"8 (916) 123-45-67", "RU", "+79161234567", "RU", Mobile},
+		{"+7 701 123 4567", "", "+77011234567", "KZ", Mobile},
+		{"+61 4 1234 5678", "", "+61412345678", "AU", Mobile},
+		{"+91 98765 43210", "", "+919876543210", "IN", Mobile},
+		{"+45 33 12 34 56", "", "+4533123456", "DK", Unknown},
+		{"tel:+442079460018", "", "+442079460018", "GB", FixedLine},
+	}
+	for _, tt := range tests {
+		n, err := Parse(tt.raw, tt.region)
+		require.NoError(t, err, tt.raw)
+		assert.Equal(t, tt.e164, n.E164(), tt.raw)
+		assert.Equal(t, tt.where, n.Region, tt.raw)
+		assert.Equal(t, tt.kind, n.Type, tt.raw)
+	}
+}
+
+func TestParseExtension(t *testing.T) {
+	for _, raw := range []string{"+1 415 555 0100 ext. 42", "+1 415 555 0100 x42", "+1 415 555 0100 #42", "+14155550100;ext=42"} {
+		n, err := Parse(raw, "")
+		require.NoError(t, err, raw)
+		assert.Equal(t, "42", n.Extension, raw)
+		assert.Equal(t, "+14155550100 ext. 42", n.String(), raw)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		raw    string
+		region string
+		err    error
+	}{
+		{"", "US", ErrEmpty},
+		{"call us", "US", ErrInvalid},
+		{"1-800-FLOWERS", "US", ErrInvalid},
+		{"415 555 0100", "", ErrNoRegion},
+		{"415 555 0100", "XX", ErrUnknownRegion},
+		{"+999 123 456", "", ErrUnknownRegion},
+		{"+44 20 7946", "", ErrLength},
+		{"+1 415 555 01000", "", ErrLength},
+		{"+1 415 055 0100", "", ErrInvalid},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.raw, tt.region)
+		assert.True(t, errors.Is(err, tt.err), "%q: %v", tt.raw, err)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	n, err := Parse("+1 415 555 0100", "")
+	require.NoError(t, err)
+	assert.Equal(t, "+1 415-555-0100", n.International())
+	assert.Equal(t, "(415) 555-0100", n.National())
+
+	n, err = Parse("+44 20 7946 0018", "")
+	require.NoError(t, err)
+	assert.Equal(t, "+44 2079460018", n.International())
+	assert.Equal(t, "02079460018", n.National())
+
+	e164, ok := Normalize("020 7946 0018", "GB")
+	assert.True(t, ok)
+	assert.Equal(t, "+442079460018", e164)
+
+	code, ok := CallingCode("de")
+	assert.True(t, ok)
+	assert.Equal(t, 49, code)
+}
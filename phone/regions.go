@@ -0,0 +1,96 @@
+package phone
+
+// region describes the numbering plan of a country. Lengths are of the
+// national significant number, without trunk prefix or calling code.
+type region struct {
+	code      int
+	trunk     string
+	minLength int
+	maxLength int
+	mobile    []string
+	tollFree  []string
+	premium   []string
+}
+
+// regions covers the countries CUFinder data most often contains. Numbers
+// of other countries are parsed when written with a calling code that is
+// listed here, and rejected otherwise.
+var regions = map[string]region{
+	"US": {code: 1, trunk: "1", minLength: 10, maxLength: 10, tollFree: []string{"800", "833", "844", "855", "866", "877", "888"}, premium: []string{"900"}},
+	"CA": {code: 1, trunk: "1", minLength: 10, maxLength: 10, tollFree: []string{"800", "833", "844", "855", "866", "877", "888"}, premium: []string{"900"}},
+	"GB": {code: 44, trunk: "0", minLength: 9, maxLength: 10, mobile: []string{"71", "72", "73", "74", "75", "77", "78", "79"}, tollFree: []string{"800", "808"}, premium: []string{"9"}},
+	"DE": {code: 49, trunk: "0", minLength: 6, maxLength: 13, mobile: []string{"15", "16", "17"}, tollFree: []string{"800"}, premium: []string{"900"}},
+	"FR": {code: 33, trunk: "0", minLength: 9, maxLength: 9, mobile: []string{"6", "7"}, tollFree: []string{"80"}, premium: []string{"89"}},
+	"ES": {code: 34, minLength: 9, maxLength: 9, mobile: []string{"6", "7"}, tollFree: []string{"800", "900"}, premium: []string{"803", "806", "807"}},
+	"IT": {code: 39, minLength: 6, maxLength: 11, mobile: []string{"3"}, tollFree: []string{"80"}, premium: []string{"89"}},
+	"NL": {code: 31, trunk: "0", minLength: 9, maxLength: 9, mobile: []string{"6"}, tollFree: []string{"800"}, premium: []string{"90"}},
+	"BE": {code: 32, trunk: "0", minLength: 8, maxLength: 9, mobile: []string{"46", "47", "48", "49"}, tollFree: []string{"800"}, premium: []string{"90"}},
+	"CH": {code: 41, trunk: "0", minLength: 9, maxLength: 9, mobile: []string{"75", "76", "77", "78", "79"}, tollFree: []string{"800"}, premium: []string{"90"}},
+	"AT": {code: 43, trunk: "0", minLength: 4, maxLength: 13, mobile: []string{"65", "66", "67", "68", "69"}, tollFree: []string{"800"}, premium: []string{"90"}},
+	"SE": {code: 46, trunk: "0", minLength: 7, maxLength: 10, mobile: []string{"70", "72", "73", "76", "79"}, tollFree: []string{"20"}, premium: []string{"900"}},
+	"NO": {code: 47, minLength: 8, maxLength: 8, mobile: []string{"4", "9"}, tollFree: []string{"80"}},
+	"DK": {code: 45, minLength: 8, maxLength: 8, tollFree: []string{"80"}, premium: []string{"90"}},
+	"FI": {code: 358, trunk: "0", minLength: 5, maxLength: 12, mobile: []string{"4", "50"}, tollFree: []string{"800"}},
+	"IE": {code: 353, trunk: "0", minLength: 7, maxLength: 9, mobile: []string{"83", "85", "86", "87", "89"}, tollFree: []string{"1800"}, premium: []string{"15"}},
+	"PT": {code: 351, minLength: 9, maxLength: 9, mobile: []string{"9"}, tollFree: []string{"800"}},
+	"PL": {code: 48, minLength: 9, maxLength: 9, mobile: []string{"45", "50", "51", "53", "57", "60", "66", "69", "72", "73", "78", "79", "88"}, tollFree: []string{"800"}, premium: []string{"70"}},
+	"CZ": {code: 420, minLength: 9, maxLength: 9, mobile: []string{"6", "7"}, tollFree: []string{"800"}, premium: []string{"90"}},
+	"GR": {code: 30, minLength: 10, maxLength: 10, mobile: []string{"69"}, tollFree: []string{"800"}, premium: []string{"90"}},
+	"HU": {code: 36, trunk: "06", minLength: 8, maxLength: 9, mobile: []string{"20", "30", "31", "50", "70"}, tollFree: []string{"80"}, premium: []string{"90"}},
+	"RO": {code: 40, trunk: "0", minLength: 9, maxLength: 9, mobile: []string{"7"}, tollFree: []string{"800"}, premium: []string{"90"}},
+	"UA": {code: 380, trunk: "0", minLength: 9, maxLength: 9, mobile: []string{"39", "50", "63", "66", "67", "68", "73", "9"}, tollFree: []string{"800"}},
+	"RU": {code: 7, trunk: "8", minLength: 10, maxLength: 10, mobile: []string{"9"}, tollFree: []string{"800"}, premium: []string{"809"}},
+	"KZ": {code: 7, trunk: "8", minLength: 10, maxLength: 10, mobile: []string{"70", "77"}, tollFree: []string{"800"}},
+	"TR": {code: 90, trunk: "0", minLength: 10, maxLength: 10, mobile: []string{"5"}, tollFree: []string{"800"}, premium: []string{"900"}},
+	"IL": {code: 972, trunk: "0", minLength: 8, maxLength: 9, mobile: []string{"5"}, tollFree: []string{"1800"}},
+	"AE": {code: 971, trunk: "0", minLength: 8, maxLength: 9, mobile: []string{"5"}, tollFree: []string{"800"}, premium: []string{"900"}},
+	"SA": {code: 966, trunk: "0", minLength: 9, maxLength: 9, mobile: []string{"5"}, tollFree: []string{"800"}},
+	"EG": {code: 20, trunk: "0", minLength: 8, maxLength: 10, mobile: []string{"1"}, tollFree: []string{"800"}},
+	"MA": {code: 212, trunk: "0", minLength: 9, maxLength: 9, mobile: []string{"6", "7"}, tollFree: []string{"80"}},
+	"NG": {code: 234, trunk: "0", minLength: 8, maxLength: 10, mobile: []string{"70", "80", "81", "90", "91"}, tollFree: []string{"800"}},
+	"KE": {code: 254, trunk: "0", minLength: 9, maxLength: 9, mobile: []string{"1", "7"}, tollFree: []string{"800"}},
+	"ZA": {code: 27, trunk: "0", minLength: 9, maxLength: 9, mobile: []string{"6", "7", "81", "82", "83", "84"}, tollFree: []string{"80"}, premium: []string{"86"}},
+	"IN": {code: 91, trunk: "0", minLength: 10, maxLength: 10, mobile: []string{"6", "7", "8", "9"}, tollFree: []string{"1800"}},
+	"PK": {code: 92, trunk: "0", minLength: 9, maxLength: 10, mobile: []string{"3"}, tollFree: []string{"800"}},
+	"CN": {code: 86, trunk: "0", minLength: 9, maxLength: 11, mobile: []string{"13", "14", "15", "16", "17", "18", "19"}, tollFree: []string{"400", "800"}},
+	"HK": {code: 852, minLength: 8, maxLength: 8, mobile: []string{"5", "6", "9"}, tollFree: []string{"800"}},
+	"JP": {code: 81, trunk: "0", minLength: 9, maxLength: 10, mobile: []string{"70", "80", "90"}, tollFree: []string{"120", "800"}},
+	"KR": {code: 82, trunk: "0", minLength: 8, maxLength: 10, mobile: []string{"10"}, tollFree: []string{"80"}},
+	"SG": {code: 65, minLength: 8, maxLength: 10, mobile: []string{"8", "9"}, tollFree: []string{"800", "1800"}},
+	"MY": {code: 60, trunk: "0", minLength: 8, maxLength: 10, mobile: []string{"1"}, tollFree: []string{"1800"}},
+	"ID": {code: 62, trunk: "0", minLength: 8, maxLength: 12, mobile: []string{"8"}, tollFree: []string{"800"}},
+	"PH": {code: 63, trunk: "0", minLength: 8, maxLength: 10, mobile: []string{"9"}, tollFree: []string{"1800"}},
+	"TH": {code: 66, trunk: "0", minLength: 8, maxLength: 9, mobile: []string{"6", "8", "9"}, tollFree: []string{"1800"}},
+	"VN": {code: 84, trunk: "0", minLength: 9, maxLength: 10, mobile: []string{"3", "5", "7", "8", "9"}, tollFree: []string{"1800"}},
+	"AU": {code: 61, trunk: "0", minLength: 9, maxLength: 9, mobile: []string{"4"}, tollFree: []string{"1800"}, premium: []string{"190"}},
+	"NZ": {code: 64, trunk: "0", minLength: 8, maxLength: 10, mobile: []string{"2"}, tollFree: []string{"800"}, premium: []string{"900"}},
+	"BR": {code: 55, trunk: "0", minLength: 10, maxLength: 11, tollFree: []string{"800"}},
+	"MX": {code: 52, minLength: 10, maxLength: 10, tollFree: []string{"800"}, premium: []string{"900"}},
+	"AR": {code: 54, trunk: "0", minLength: 10, maxLength: 11, mobile: []string{"9"}, tollFree: []string{"800"}},
+	"CL": {code: 56, minLength: 9, maxLength: 9, mobile: []string{"9"}, tollFree: []string{"800"}},
+	"CO": {code: 57, minLength: 10, maxLength: 10, mobile: []string{"3"}, tollFree: []string{"1800"}},
+	"PE": {code: 51, minLength: 8, maxLength: 9, mobile: []string{"9"}, tollFree: []string{"800"}},
+}
+
+// mainRegions is the region assumed for calling codes shared by several
+// countries when the number does not identify one
+var mainRegions = map[int]string{1: "US", 7: "RU"}
+
+// nanpRegions maps North American area codes outside the United States to
+// their country
+var nanpRegions = map[string]string{
+	"204": "CA", "226": "CA", "236": "CA", "249": "CA", "250": "CA", "263": "CA",
+	"289": "CA", "306": "CA", "343": "CA", "354": "CA", "365": "CA", "367": "CA",
+	"368": "CA", "382": "CA", "403": "CA", "416": "CA", "418": "CA", "428": "CA",
+	"431": "CA", "437": "CA", "438": "CA", "450": "CA", "468": "CA", "474": "CA",
+	"506": "CA", "514": "CA", "519": "CA", "548": "CA", "579": "CA", "581": "CA",
+	"584": "CA", "587": "CA", "604": "CA", "613": "CA", "639": "CA", "647": "CA",
+	"672": "CA", "683": "CA", "705": "CA", "709": "CA", "742": "CA", "753": "CA",
+	"778": "CA", "780": "CA", "782": "CA", "807": "CA", "819": "CA", "825": "CA",
+	"867": "CA", "873": "CA", "879": "CA", "902": "CA", "905": "CA",
+	"242": "BS", "246": "BB", "264": "AI", "268": "AG", "284": "VG", "340": "VI",
+	"345": "KY", "441": "BM", "473": "GD", "649": "TC", "658": "JM", "664": "MS",
+	"670": "MP", "671": "GU", "684": "AS", "721": "SX", "758": "LC", "767": "DM",
+	"784": "VC", "787": "PR", "809": "DO", "829": "DO", "849": "DO", "868": "TT",
+	"869": "KN", "876": "JM", "939": "PR",
+}
@@ -0,0 +1,26 @@
+package cufinder
+
+import "github.com/cufinder/cufinder-go/phone"
+
+// phoneParser holds the client's offline phone settings
+type phoneParser struct {
+	region  string
+	offline bool
+}
+
+// parse parses a number with the configured default region
+func (p phoneParser) parse(raw string) (*phone.Number, bool) {
+	n, err := phone.Parse(raw, p.region)
+	if err != nil {
+		return nil, false
+	}
+	return &n, true
+}
+
+// canonical replaces a phone number with its E.164 form, keeping any
+// extension. Numbers that cannot be parsed are left unchanged.
+func (p phoneParser) canonical(value *string) {
+	if n, ok := p.parse(*value); ok {
+		*value = n.String()
+	}
+}
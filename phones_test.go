@@ -0,0 +1,84 @@
+package cufinder
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cufinder/cufinder-go/phone"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPhones(t *testing.T) {
+	var calls int
+	var lastForm map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		require.NoError(t, r.ParseForm())
+		lastForm = r.PostForm
+
+		body := map[string]interface{}{"credit_count": 1}
+		switch r.URL.Path {
+		case "/nao":
+			body["phone"] = "+14155550100"
+		case "/ntp":
+			body["phones"] = []string{"(415) 555-0100", "+44 20 7946 0018 ext 12", "unlisted"}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(body)
+	}))
+	defer server.Close()
+
+	t.Run("Pre-normalizes NAO Input", func(t *testing.T) {
+		sdk := NewSDKWithConfig(ClientConfig{APIKey: "test-api-key", BaseURL: server.URL, PhoneRegion: "US"})
+
+		result, err := sdk.NAO("(415) 555-0100")
+		require.NoError(t, err)
+		assert.Equal(t, "+14155550100", lastForm["phone"][0])
+		assert.False(t, result.Offline)
+		require.NotNil(t, result.Number)
+		assert.Equal(t, "US", result.Number.Region)
+
+		_, err = sdk.NAO("(415) 555-0100 ext. 123")
+		require.NoError(t, err)
+		assert.Equal(t, "+14155550100 ext. 123", lastForm["phone"][0])
+
+		_, err = sdk.NAO("call reception")
+		require.NoError(t, err)
+		assert.Equal(t, "call reception", lastForm["phone"][0])
+	})
+
+	t.Run("Answers NAO Offline", func(t *testing.T) {
+		sdk := NewSDKWithConfig(ClientConfig{APIKey: "test-api-key", BaseURL: server.URL, OfflinePhones: true})
+		before := calls
+
+		result, err := sdk.NAO("+44 (0)20 7946 0018")
+		require.NoError(t, err)
+		assert.True(t, result.Offline)
+		assert.Equal(t, "+442079460018", result.Phone)
+		assert.Equal(t, phone.FixedLine, result.Number.Type)
+		assert.Equal(t, before, calls)
+
+		result, err = sdk.NAO("+44 20 7946 0018 ext 12")
+		require.NoError(t, err)
+		assert.Equal(t, "+442079460018 ext. 12", result.Phone)
+		assert.Equal(t, "12", result.Number.Extension)
+		assert.Equal(t, before, calls)
+
+		// Without a region national numbers still go to the API
+		result, err = sdk.NAO("415 555 0100")
+		require.NoError(t, err)
+		assert.False(t, result.Offline)
+		assert.Equal(t, before+1, calls)
+	})
+
+	t.Run("Normalizes NTP Phones", func(t *testing.T) {
+		sdk := NewSDKWithConfig(ClientConfig{APIKey: "test-api-key", BaseURL: server.URL, PhoneRegion: "US"})
+
+		result, err := sdk.NTP("TechCorp")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"+14155550100", "+442079460018 ext. 12", "unlisted"}, result.Phones)
+	})
+}
@@ -0,0 +1,7822 @@
+// Code generated by go generate ./proto. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: cufinder/v1/cufinder.proto
+
+package cufinderv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CufParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyName   string                 `protobuf:"bytes,1,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	CountryCode   string                 `protobuf:"bytes,2,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CufParams) Reset() {
+	*x = CufParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CufParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CufParams) ProtoMessage() {}
+
+func (x *CufParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CufParams.ProtoReflect.Descriptor instead.
+func (*CufParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CufParams) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *CufParams) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+type CufResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Domain          string                 `protobuf:"bytes,5,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CufResponse) Reset() {
+	*x = CufResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CufResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CufResponse) ProtoMessage() {}
+
+func (x *CufResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CufResponse.ProtoReflect.Descriptor instead.
+func (*CufResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CufResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *CufResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *CufResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *CufResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *CufResponse) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type LcufParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyName   string                 `protobuf:"bytes,1,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LcufParams) Reset() {
+	*x = LcufParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LcufParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LcufParams) ProtoMessage() {}
+
+func (x *LcufParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LcufParams.ProtoReflect.Descriptor instead.
+func (*LcufParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LcufParams) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+type LcufResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	LinkedinUrl     string                 `protobuf:"bytes,5,opt,name=linkedin_url,json=linkedinUrl,proto3" json:"linkedin_url,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LcufResponse) Reset() {
+	*x = LcufResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LcufResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LcufResponse) ProtoMessage() {}
+
+func (x *LcufResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LcufResponse.ProtoReflect.Descriptor instead.
+func (*LcufResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LcufResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *LcufResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *LcufResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *LcufResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *LcufResponse) GetLinkedinUrl() string {
+	if x != nil {
+		return x.LinkedinUrl
+	}
+	return ""
+}
+
+type DtcParams struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CompanyWebsite string                 `protobuf:"bytes,1,opt,name=company_website,json=companyWebsite,proto3" json:"company_website,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DtcParams) Reset() {
+	*x = DtcParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DtcParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DtcParams) ProtoMessage() {}
+
+func (x *DtcParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DtcParams.ProtoReflect.Descriptor instead.
+func (*DtcParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DtcParams) GetCompanyWebsite() string {
+	if x != nil {
+		return x.CompanyWebsite
+	}
+	return ""
+}
+
+type DtcResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	CompanyName     string                 `protobuf:"bytes,5,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DtcResponse) Reset() {
+	*x = DtcResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DtcResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DtcResponse) ProtoMessage() {}
+
+func (x *DtcResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DtcResponse.ProtoReflect.Descriptor instead.
+func (*DtcResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DtcResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *DtcResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *DtcResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *DtcResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *DtcResponse) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+type DteParams struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CompanyWebsite string                 `protobuf:"bytes,1,opt,name=company_website,json=companyWebsite,proto3" json:"company_website,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DteParams) Reset() {
+	*x = DteParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DteParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DteParams) ProtoMessage() {}
+
+func (x *DteParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DteParams.ProtoReflect.Descriptor instead.
+func (*DteParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DteParams) GetCompanyWebsite() string {
+	if x != nil {
+		return x.CompanyWebsite
+	}
+	return ""
+}
+
+type DteResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Emails          []string               `protobuf:"bytes,5,rep,name=emails,proto3" json:"emails,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DteResponse) Reset() {
+	*x = DteResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DteResponse) ProtoMessage() {}
+
+func (x *DteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DteResponse.ProtoReflect.Descriptor instead.
+func (*DteResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DteResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *DteResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *DteResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *DteResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *DteResponse) GetEmails() []string {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
+}
+
+type NtpParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyName   string                 `protobuf:"bytes,1,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NtpParams) Reset() {
+	*x = NtpParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NtpParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NtpParams) ProtoMessage() {}
+
+func (x *NtpParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NtpParams.ProtoReflect.Descriptor instead.
+func (*NtpParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *NtpParams) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+type NtpResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Phones          []string               `protobuf:"bytes,5,rep,name=phones,proto3" json:"phones,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NtpResponse) Reset() {
+	*x = NtpResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NtpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NtpResponse) ProtoMessage() {}
+
+func (x *NtpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NtpResponse.ProtoReflect.Descriptor instead.
+func (*NtpResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *NtpResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *NtpResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *NtpResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *NtpResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *NtpResponse) GetPhones() []string {
+	if x != nil {
+		return x.Phones
+	}
+	return nil
+}
+
+type EppParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkedinUrl   string                 `protobuf:"bytes,1,opt,name=linkedin_url,json=linkedinUrl,proto3" json:"linkedin_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EppParams) Reset() {
+	*x = EppParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EppParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EppParams) ProtoMessage() {}
+
+func (x *EppParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EppParams.ProtoReflect.Descriptor instead.
+func (*EppParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EppParams) GetLinkedinUrl() string {
+	if x != nil {
+		return x.LinkedinUrl
+	}
+	return ""
+}
+
+type EppResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Person          *EppPerson             `protobuf:"bytes,5,opt,name=person,proto3" json:"person,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EppResponse) Reset() {
+	*x = EppResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EppResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EppResponse) ProtoMessage() {}
+
+func (x *EppResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EppResponse.ProtoReflect.Descriptor instead.
+func (*EppResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EppResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *EppResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *EppResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *EppResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *EppResponse) GetPerson() *EppPerson {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+type RelParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelParams) Reset() {
+	*x = RelParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelParams) ProtoMessage() {}
+
+func (x *RelParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelParams.ProtoReflect.Descriptor instead.
+func (*RelParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RelParams) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type RelResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Person          *RelPerson             `protobuf:"bytes,5,opt,name=person,proto3" json:"person,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RelResponse) Reset() {
+	*x = RelResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelResponse) ProtoMessage() {}
+
+func (x *RelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelResponse.ProtoReflect.Descriptor instead.
+func (*RelResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RelResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *RelResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *RelResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *RelResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *RelResponse) GetPerson() *RelPerson {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+type FweParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkedinUrl   string                 `protobuf:"bytes,1,opt,name=linkedin_url,json=linkedinUrl,proto3" json:"linkedin_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FweParams) Reset() {
+	*x = FweParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FweParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FweParams) ProtoMessage() {}
+
+func (x *FweParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FweParams.ProtoReflect.Descriptor instead.
+func (*FweParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FweParams) GetLinkedinUrl() string {
+	if x != nil {
+		return x.LinkedinUrl
+	}
+	return ""
+}
+
+type FweResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	WorkEmail       string                 `protobuf:"bytes,5,opt,name=work_email,json=workEmail,proto3" json:"work_email,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FweResponse) Reset() {
+	*x = FweResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FweResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FweResponse) ProtoMessage() {}
+
+func (x *FweResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FweResponse.ProtoReflect.Descriptor instead.
+func (*FweResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FweResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *FweResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *FweResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *FweResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *FweResponse) GetWorkEmail() string {
+	if x != nil {
+		return x.WorkEmail
+	}
+	return ""
+}
+
+type TepParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullName      string                 `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Company       string                 `protobuf:"bytes,2,opt,name=company,proto3" json:"company,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TepParams) Reset() {
+	*x = TepParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TepParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TepParams) ProtoMessage() {}
+
+func (x *TepParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TepParams.ProtoReflect.Descriptor instead.
+func (*TepParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TepParams) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *TepParams) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+type TepResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Person          *TepPerson             `protobuf:"bytes,5,opt,name=person,proto3" json:"person,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TepResponse) Reset() {
+	*x = TepResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TepResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TepResponse) ProtoMessage() {}
+
+func (x *TepResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TepResponse.ProtoReflect.Descriptor instead.
+func (*TepResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TepResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *TepResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *TepResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *TepResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *TepResponse) GetPerson() *TepPerson {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+type FclParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FclParams) Reset() {
+	*x = FclParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FclParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FclParams) ProtoMessage() {}
+
+func (x *FclParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FclParams.ProtoReflect.Descriptor instead.
+func (*FclParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FclParams) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type FclResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Companies       []*FclCompany          `protobuf:"bytes,5,rep,name=companies,proto3" json:"companies,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FclResponse) Reset() {
+	*x = FclResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FclResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FclResponse) ProtoMessage() {}
+
+func (x *FclResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FclResponse.ProtoReflect.Descriptor instead.
+func (*FclResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FclResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *FclResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *FclResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *FclResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *FclResponse) GetCompanies() []*FclCompany {
+	if x != nil {
+		return x.Companies
+	}
+	return nil
+}
+
+type ElfParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ElfParams) Reset() {
+	*x = ElfParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ElfParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElfParams) ProtoMessage() {}
+
+func (x *ElfParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElfParams.ProtoReflect.Descriptor instead.
+func (*ElfParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ElfParams) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type ElfResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	FundraisingInfo *ElfFundraising        `protobuf:"bytes,5,opt,name=fundraising_info,json=fundraisingInfo,proto3" json:"fundraising_info,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ElfResponse) Reset() {
+	*x = ElfResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ElfResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElfResponse) ProtoMessage() {}
+
+func (x *ElfResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElfResponse.ProtoReflect.Descriptor instead.
+func (*ElfResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ElfResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *ElfResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *ElfResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *ElfResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *ElfResponse) GetFundraisingInfo() *ElfFundraising {
+	if x != nil {
+		return x.FundraisingInfo
+	}
+	return nil
+}
+
+type CarParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CarParams) Reset() {
+	*x = CarParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CarParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarParams) ProtoMessage() {}
+
+func (x *CarParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarParams.ProtoReflect.Descriptor instead.
+func (*CarParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CarParams) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type CarResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	AnnualRevenue   string                 `protobuf:"bytes,5,opt,name=annual_revenue,json=annualRevenue,proto3" json:"annual_revenue,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CarResponse) Reset() {
+	*x = CarResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarResponse) ProtoMessage() {}
+
+func (x *CarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarResponse.ProtoReflect.Descriptor instead.
+func (*CarResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CarResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *CarResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *CarResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *CarResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *CarResponse) GetAnnualRevenue() string {
+	if x != nil {
+		return x.AnnualRevenue
+	}
+	return ""
+}
+
+type FccParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FccParams) Reset() {
+	*x = FccParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FccParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FccParams) ProtoMessage() {}
+
+func (x *FccParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FccParams.ProtoReflect.Descriptor instead.
+func (*FccParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FccParams) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type FccResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Subsidiaries    []string               `protobuf:"bytes,5,rep,name=subsidiaries,proto3" json:"subsidiaries,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FccResponse) Reset() {
+	*x = FccResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FccResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FccResponse) ProtoMessage() {}
+
+func (x *FccResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FccResponse.ProtoReflect.Descriptor instead.
+func (*FccResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FccResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *FccResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *FccResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *FccResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *FccResponse) GetSubsidiaries() []string {
+	if x != nil {
+		return x.Subsidiaries
+	}
+	return nil
+}
+
+type FtsParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FtsParams) Reset() {
+	*x = FtsParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FtsParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FtsParams) ProtoMessage() {}
+
+func (x *FtsParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FtsParams.ProtoReflect.Descriptor instead.
+func (*FtsParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FtsParams) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type FtsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Technologies    []string               `protobuf:"bytes,5,rep,name=technologies,proto3" json:"technologies,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FtsResponse) Reset() {
+	*x = FtsResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FtsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FtsResponse) ProtoMessage() {}
+
+func (x *FtsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FtsResponse.ProtoReflect.Descriptor instead.
+func (*FtsResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FtsResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *FtsResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *FtsResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *FtsResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *FtsResponse) GetTechnologies() []string {
+	if x != nil {
+		return x.Technologies
+	}
+	return nil
+}
+
+type EncParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncParams) Reset() {
+	*x = EncParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncParams) ProtoMessage() {}
+
+func (x *EncParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncParams.ProtoReflect.Descriptor instead.
+func (*EncParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *EncParams) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type EncResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Company         *EncCompany            `protobuf:"bytes,5,opt,name=company,proto3" json:"company,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EncResponse) Reset() {
+	*x = EncResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncResponse) ProtoMessage() {}
+
+func (x *EncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncResponse.ProtoReflect.Descriptor instead.
+func (*EncResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *EncResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *EncResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *EncResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *EncResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *EncResponse) GetCompany() *EncCompany {
+	if x != nil {
+		return x.Company
+	}
+	return nil
+}
+
+type CecParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CecParams) Reset() {
+	*x = CecParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CecParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CecParams) ProtoMessage() {}
+
+func (x *CecParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CecParams.ProtoReflect.Descriptor instead.
+func (*CecParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CecParams) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type CecResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Countries       *structpb.Value        `protobuf:"bytes,5,opt,name=countries,proto3" json:"countries,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CecResponse) Reset() {
+	*x = CecResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CecResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CecResponse) ProtoMessage() {}
+
+func (x *CecResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CecResponse.ProtoReflect.Descriptor instead.
+func (*CecResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CecResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *CecResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *CecResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *CecResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *CecResponse) GetCountries() *structpb.Value {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+type CloParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloParams) Reset() {
+	*x = CloParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloParams) ProtoMessage() {}
+
+func (x *CloParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloParams.ProtoReflect.Descriptor instead.
+func (*CloParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CloParams) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type CloResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Locations       []*CloLocation         `protobuf:"bytes,5,rep,name=locations,proto3" json:"locations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CloResponse) Reset() {
+	*x = CloResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloResponse) ProtoMessage() {}
+
+func (x *CloResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloResponse.ProtoReflect.Descriptor instead.
+func (*CloResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CloResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *CloResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *CloResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *CloResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *CloResponse) GetLocations() []*CloLocation {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+type CseParams struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Country           string                 `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	State             string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	City              string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	FollowersCountMin *int64                 `protobuf:"varint,5,opt,name=followers_count_min,json=followersCountMin,proto3,oneof" json:"followers_count_min,omitempty"`
+	FollowersCountMax *int64                 `protobuf:"varint,6,opt,name=followers_count_max,json=followersCountMax,proto3,oneof" json:"followers_count_max,omitempty"`
+	Industry          string                 `protobuf:"bytes,7,opt,name=industry,proto3" json:"industry,omitempty"`
+	EmployeeSize      string                 `protobuf:"bytes,8,opt,name=employee_size,json=employeeSize,proto3" json:"employee_size,omitempty"`
+	FoundedAfterYear  *int64                 `protobuf:"varint,9,opt,name=founded_after_year,json=foundedAfterYear,proto3,oneof" json:"founded_after_year,omitempty"`
+	FoundedBeforeYear *int64                 `protobuf:"varint,10,opt,name=founded_before_year,json=foundedBeforeYear,proto3,oneof" json:"founded_before_year,omitempty"`
+	FundingAmountMax  *int64                 `protobuf:"varint,11,opt,name=funding_amount_max,json=fundingAmountMax,proto3,oneof" json:"funding_amount_max,omitempty"`
+	FundingAmountMin  *int64                 `protobuf:"varint,12,opt,name=funding_amount_min,json=fundingAmountMin,proto3,oneof" json:"funding_amount_min,omitempty"`
+	ProductsServices  []string               `protobuf:"bytes,13,rep,name=products_services,json=productsServices,proto3" json:"products_services,omitempty"`
+	IsSchool          *bool                  `protobuf:"varint,14,opt,name=is_school,json=isSchool,proto3,oneof" json:"is_school,omitempty"`
+	AnnualRevenueMin  *int64                 `protobuf:"varint,15,opt,name=annual_revenue_min,json=annualRevenueMin,proto3,oneof" json:"annual_revenue_min,omitempty"`
+	AnnualRevenueMax  *int64                 `protobuf:"varint,16,opt,name=annual_revenue_max,json=annualRevenueMax,proto3,oneof" json:"annual_revenue_max,omitempty"`
+	Page              int64                  `protobuf:"varint,17,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CseParams) Reset() {
+	*x = CseParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CseParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CseParams) ProtoMessage() {}
+
+func (x *CseParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CseParams.ProtoReflect.Descriptor instead.
+func (*CseParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CseParams) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CseParams) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *CseParams) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CseParams) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *CseParams) GetFollowersCountMin() int64 {
+	if x != nil && x.FollowersCountMin != nil {
+		return *x.FollowersCountMin
+	}
+	return 0
+}
+
+func (x *CseParams) GetFollowersCountMax() int64 {
+	if x != nil && x.FollowersCountMax != nil {
+		return *x.FollowersCountMax
+	}
+	return 0
+}
+
+func (x *CseParams) GetIndustry() string {
+	if x != nil {
+		return x.Industry
+	}
+	return ""
+}
+
+func (x *CseParams) GetEmployeeSize() string {
+	if x != nil {
+		return x.EmployeeSize
+	}
+	return ""
+}
+
+func (x *CseParams) GetFoundedAfterYear() int64 {
+	if x != nil && x.FoundedAfterYear != nil {
+		return *x.FoundedAfterYear
+	}
+	return 0
+}
+
+func (x *CseParams) GetFoundedBeforeYear() int64 {
+	if x != nil && x.FoundedBeforeYear != nil {
+		return *x.FoundedBeforeYear
+	}
+	return 0
+}
+
+func (x *CseParams) GetFundingAmountMax() int64 {
+	if x != nil && x.FundingAmountMax != nil {
+		return *x.FundingAmountMax
+	}
+	return 0
+}
+
+func (x *CseParams) GetFundingAmountMin() int64 {
+	if x != nil && x.FundingAmountMin != nil {
+		return *x.FundingAmountMin
+	}
+	return 0
+}
+
+func (x *CseParams) GetProductsServices() []string {
+	if x != nil {
+		return x.ProductsServices
+	}
+	return nil
+}
+
+func (x *CseParams) GetIsSchool() bool {
+	if x != nil && x.IsSchool != nil {
+		return *x.IsSchool
+	}
+	return false
+}
+
+func (x *CseParams) GetAnnualRevenueMin() int64 {
+	if x != nil && x.AnnualRevenueMin != nil {
+		return *x.AnnualRevenueMin
+	}
+	return 0
+}
+
+func (x *CseParams) GetAnnualRevenueMax() int64 {
+	if x != nil && x.AnnualRevenueMax != nil {
+		return *x.AnnualRevenueMax
+	}
+	return 0
+}
+
+func (x *CseParams) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type CseResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Companies       []*Company             `protobuf:"bytes,5,rep,name=companies,proto3" json:"companies,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CseResponse) Reset() {
+	*x = CseResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CseResponse) ProtoMessage() {}
+
+func (x *CseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CseResponse.ProtoReflect.Descriptor instead.
+func (*CseResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CseResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *CseResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *CseResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *CseResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *CseResponse) GetCompanies() []*Company {
+	if x != nil {
+		return x.Companies
+	}
+	return nil
+}
+
+type PseParams struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	FullName                string                 `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Country                 string                 `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	State                   string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	City                    string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	JobTitleRole            string                 `protobuf:"bytes,5,opt,name=job_title_role,json=jobTitleRole,proto3" json:"job_title_role,omitempty"`
+	JobTitleLevel           string                 `protobuf:"bytes,6,opt,name=job_title_level,json=jobTitleLevel,proto3" json:"job_title_level,omitempty"`
+	CompanyCountry          string                 `protobuf:"bytes,7,opt,name=company_country,json=companyCountry,proto3" json:"company_country,omitempty"`
+	CompanyState            string                 `protobuf:"bytes,8,opt,name=company_state,json=companyState,proto3" json:"company_state,omitempty"`
+	CompanyCity             string                 `protobuf:"bytes,9,opt,name=company_city,json=companyCity,proto3" json:"company_city,omitempty"`
+	CompanyName             string                 `protobuf:"bytes,10,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	CompanyLinkedinUrl      string                 `protobuf:"bytes,11,opt,name=company_linkedin_url,json=companyLinkedinUrl,proto3" json:"company_linkedin_url,omitempty"`
+	CompanyIndustry         string                 `protobuf:"bytes,12,opt,name=company_industry,json=companyIndustry,proto3" json:"company_industry,omitempty"`
+	CompanyEmployeeSize     string                 `protobuf:"bytes,13,opt,name=company_employee_size,json=companyEmployeeSize,proto3" json:"company_employee_size,omitempty"`
+	CompanyProductsServices []string               `protobuf:"bytes,14,rep,name=company_products_services,json=companyProductsServices,proto3" json:"company_products_services,omitempty"`
+	CompanyAnnualRevenueMin *int64                 `protobuf:"varint,15,opt,name=company_annual_revenue_min,json=companyAnnualRevenueMin,proto3,oneof" json:"company_annual_revenue_min,omitempty"`
+	CompanyAnnualRevenueMax *int64                 `protobuf:"varint,16,opt,name=company_annual_revenue_max,json=companyAnnualRevenueMax,proto3,oneof" json:"company_annual_revenue_max,omitempty"`
+	Page                    int64                  `protobuf:"varint,17,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *PseParams) Reset() {
+	*x = PseParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PseParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PseParams) ProtoMessage() {}
+
+func (x *PseParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PseParams.ProtoReflect.Descriptor instead.
+func (*PseParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PseParams) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *PseParams) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *PseParams) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *PseParams) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *PseParams) GetJobTitleRole() string {
+	if x != nil {
+		return x.JobTitleRole
+	}
+	return ""
+}
+
+func (x *PseParams) GetJobTitleLevel() string {
+	if x != nil {
+		return x.JobTitleLevel
+	}
+	return ""
+}
+
+func (x *PseParams) GetCompanyCountry() string {
+	if x != nil {
+		return x.CompanyCountry
+	}
+	return ""
+}
+
+func (x *PseParams) GetCompanyState() string {
+	if x != nil {
+		return x.CompanyState
+	}
+	return ""
+}
+
+func (x *PseParams) GetCompanyCity() string {
+	if x != nil {
+		return x.CompanyCity
+	}
+	return ""
+}
+
+func (x *PseParams) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *PseParams) GetCompanyLinkedinUrl() string {
+	if x != nil {
+		return x.CompanyLinkedinUrl
+	}
+	return ""
+}
+
+func (x *PseParams) GetCompanyIndustry() string {
+	if x != nil {
+		return x.CompanyIndustry
+	}
+	return ""
+}
+
+func (x *PseParams) GetCompanyEmployeeSize() string {
+	if x != nil {
+		return x.CompanyEmployeeSize
+	}
+	return ""
+}
+
+func (x *PseParams) GetCompanyProductsServices() []string {
+	if x != nil {
+		return x.CompanyProductsServices
+	}
+	return nil
+}
+
+func (x *PseParams) GetCompanyAnnualRevenueMin() int64 {
+	if x != nil && x.CompanyAnnualRevenueMin != nil {
+		return *x.CompanyAnnualRevenueMin
+	}
+	return 0
+}
+
+func (x *PseParams) GetCompanyAnnualRevenueMax() int64 {
+	if x != nil && x.CompanyAnnualRevenueMax != nil {
+		return *x.CompanyAnnualRevenueMax
+	}
+	return 0
+}
+
+func (x *PseParams) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type PseResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Peoples         []*Person              `protobuf:"bytes,5,rep,name=peoples,proto3" json:"peoples,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PseResponse) Reset() {
+	*x = PseResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PseResponse) ProtoMessage() {}
+
+func (x *PseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PseResponse.ProtoReflect.Descriptor instead.
+func (*PseResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PseResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *PseResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *PseResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *PseResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *PseResponse) GetPeoples() []*Person {
+	if x != nil {
+		return x.Peoples
+	}
+	return nil
+}
+
+type LbsParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Country       string                 `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	City          string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	Industry      string                 `protobuf:"bytes,5,opt,name=industry,proto3" json:"industry,omitempty"`
+	Page          int64                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LbsParams) Reset() {
+	*x = LbsParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LbsParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LbsParams) ProtoMessage() {}
+
+func (x *LbsParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LbsParams.ProtoReflect.Descriptor instead.
+func (*LbsParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LbsParams) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LbsParams) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *LbsParams) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *LbsParams) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *LbsParams) GetIndustry() string {
+	if x != nil {
+		return x.Industry
+	}
+	return ""
+}
+
+func (x *LbsParams) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type LbsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Companies       []*Company             `protobuf:"bytes,5,rep,name=companies,proto3" json:"companies,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LbsResponse) Reset() {
+	*x = LbsResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LbsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LbsResponse) ProtoMessage() {}
+
+func (x *LbsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LbsResponse.ProtoReflect.Descriptor instead.
+func (*LbsResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *LbsResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *LbsResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *LbsResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *LbsResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *LbsResponse) GetCompanies() []*Company {
+	if x != nil {
+		return x.Companies
+	}
+	return nil
+}
+
+type BcdParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BcdParams) Reset() {
+	*x = BcdParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BcdParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BcdParams) ProtoMessage() {}
+
+func (x *BcdParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BcdParams.ProtoReflect.Descriptor instead.
+func (*BcdParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BcdParams) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type BcdResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Customers       []string               `protobuf:"bytes,5,rep,name=customers,proto3" json:"customers,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BcdResponse) Reset() {
+	*x = BcdResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BcdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BcdResponse) ProtoMessage() {}
+
+func (x *BcdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BcdResponse.ProtoReflect.Descriptor instead.
+func (*BcdResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BcdResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *BcdResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *BcdResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *BcdResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *BcdResponse) GetCustomers() []string {
+	if x != nil {
+		return x.Customers
+	}
+	return nil
+}
+
+type CcpParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CcpParams) Reset() {
+	*x = CcpParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CcpParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CcpParams) ProtoMessage() {}
+
+func (x *CcpParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CcpParams.ProtoReflect.Descriptor instead.
+func (*CcpParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CcpParams) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type CcpResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	CareersPageUrl  string                 `protobuf:"bytes,5,opt,name=careers_page_url,json=careersPageUrl,proto3" json:"careers_page_url,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CcpResponse) Reset() {
+	*x = CcpResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CcpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CcpResponse) ProtoMessage() {}
+
+func (x *CcpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CcpResponse.ProtoReflect.Descriptor instead.
+func (*CcpResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CcpResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *CcpResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *CcpResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *CcpResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *CcpResponse) GetCareersPageUrl() string {
+	if x != nil {
+		return x.CareersPageUrl
+	}
+	return ""
+}
+
+type IscParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IscParams) Reset() {
+	*x = IscParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IscParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IscParams) ProtoMessage() {}
+
+func (x *IscParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IscParams.ProtoReflect.Descriptor instead.
+func (*IscParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *IscParams) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type IscResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	IsSaas          string                 `protobuf:"bytes,5,opt,name=is_saas,json=isSaas,proto3" json:"is_saas,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *IscResponse) Reset() {
+	*x = IscResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IscResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IscResponse) ProtoMessage() {}
+
+func (x *IscResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IscResponse.ProtoReflect.Descriptor instead.
+func (*IscResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *IscResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *IscResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *IscResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *IscResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *IscResponse) GetIsSaas() string {
+	if x != nil {
+		return x.IsSaas
+	}
+	return ""
+}
+
+type CbcParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CbcParams) Reset() {
+	*x = CbcParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CbcParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CbcParams) ProtoMessage() {}
+
+func (x *CbcParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CbcParams.ProtoReflect.Descriptor instead.
+func (*CbcParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CbcParams) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type CbcResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	BusinessType    string                 `protobuf:"bytes,5,opt,name=business_type,json=businessType,proto3" json:"business_type,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CbcResponse) Reset() {
+	*x = CbcResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CbcResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CbcResponse) ProtoMessage() {}
+
+func (x *CbcResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CbcResponse.ProtoReflect.Descriptor instead.
+func (*CbcResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CbcResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *CbcResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *CbcResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *CbcResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *CbcResponse) GetBusinessType() string {
+	if x != nil {
+		return x.BusinessType
+	}
+	return ""
+}
+
+type CscParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CscParams) Reset() {
+	*x = CscParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CscParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CscParams) ProtoMessage() {}
+
+func (x *CscParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CscParams.ProtoReflect.Descriptor instead.
+func (*CscParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CscParams) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type CscResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Query            *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount      int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData         *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel  int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	MissionStatement string                 `protobuf:"bytes,5,opt,name=mission_statement,json=missionStatement,proto3" json:"mission_statement,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CscResponse) Reset() {
+	*x = CscResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CscResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CscResponse) ProtoMessage() {}
+
+func (x *CscResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CscResponse.ProtoReflect.Descriptor instead.
+func (*CscResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CscResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *CscResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *CscResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *CscResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *CscResponse) GetMissionStatement() string {
+	if x != nil {
+		return x.MissionStatement
+	}
+	return ""
+}
+
+type CsnParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CsnParams) Reset() {
+	*x = CsnParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CsnParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CsnParams) ProtoMessage() {}
+
+func (x *CsnParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CsnParams.ProtoReflect.Descriptor instead.
+func (*CsnParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CsnParams) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type CsnResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	CompanySnapshot *CsnSnapshotInfo       `protobuf:"bytes,5,opt,name=company_snapshot,json=companySnapshot,proto3" json:"company_snapshot,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CsnResponse) Reset() {
+	*x = CsnResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CsnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CsnResponse) ProtoMessage() {}
+
+func (x *CsnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CsnResponse.ProtoReflect.Descriptor instead.
+func (*CsnResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CsnResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *CsnResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *CsnResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *CsnResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *CsnResponse) GetCompanySnapshot() *CsnSnapshotInfo {
+	if x != nil {
+		return x.CompanySnapshot
+	}
+	return nil
+}
+
+type NaoParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NaoParams) Reset() {
+	*x = NaoParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NaoParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NaoParams) ProtoMessage() {}
+
+func (x *NaoParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NaoParams.ProtoReflect.Descriptor instead.
+func (*NaoParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *NaoParams) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type NaoResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Phone           string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NaoResponse) Reset() {
+	*x = NaoResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NaoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NaoResponse) ProtoMessage() {}
+
+func (x *NaoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NaoResponse.ProtoReflect.Descriptor instead.
+func (*NaoResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *NaoResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *NaoResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *NaoResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *NaoResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *NaoResponse) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type NaaParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NaaParams) Reset() {
+	*x = NaaParams{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NaaParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NaaParams) ProtoMessage() {}
+
+func (x *NaaParams) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NaaParams.ProtoReflect.Descriptor instead.
+func (*NaaParams) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *NaaParams) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type NaaResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           *structpb.Value        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CreditCount     int64                  `protobuf:"varint,2,opt,name=credit_count,json=creditCount,proto3" json:"credit_count,omitempty"`
+	MetaData        *structpb.Struct       `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	ConfidenceLevel int64                  `protobuf:"varint,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Address         string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NaaResponse) Reset() {
+	*x = NaaResponse{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NaaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NaaResponse) ProtoMessage() {}
+
+func (x *NaaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NaaResponse.ProtoReflect.Descriptor instead.
+func (*NaaResponse) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *NaaResponse) GetQuery() *structpb.Value {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *NaaResponse) GetCreditCount() int64 {
+	if x != nil {
+		return x.CreditCount
+	}
+	return 0
+}
+
+func (x *NaaResponse) GetMetaData() *structpb.Struct {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *NaaResponse) GetConfidenceLevel() int64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *NaaResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type EncBatchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         uint32                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Response      *EncResponse           `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncBatchResult) Reset() {
+	*x = EncBatchResult{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncBatchResult) ProtoMessage() {}
+
+func (x *EncBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncBatchResult.ProtoReflect.Descriptor instead.
+func (*EncBatchResult) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *EncBatchResult) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *EncBatchResult) GetResponse() *EncResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *EncBatchResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type TepBatchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         uint32                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Response      *TepResponse           `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TepBatchResult) Reset() {
+	*x = TepBatchResult{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TepBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TepBatchResult) ProtoMessage() {}
+
+func (x *TepBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TepBatchResult.ProtoReflect.Descriptor instead.
+func (*TepBatchResult) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TepBatchResult) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TepBatchResult) GetResponse() *TepResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *TepBatchResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type EppBatchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         uint32                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Response      *EppResponse           `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EppBatchResult) Reset() {
+	*x = EppBatchResult{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EppBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EppBatchResult) ProtoMessage() {}
+
+func (x *EppBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EppBatchResult.ProtoReflect.Descriptor instead.
+func (*EppBatchResult) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *EppBatchResult) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *EppBatchResult) GetResponse() *EppResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *EppBatchResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CsePagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Params        *CseParams             `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	MaxPages      uint32                 `protobuf:"varint,2,opt,name=max_pages,json=maxPages,proto3" json:"max_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CsePagesRequest) Reset() {
+	*x = CsePagesRequest{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CsePagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CsePagesRequest) ProtoMessage() {}
+
+func (x *CsePagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CsePagesRequest.ProtoReflect.Descriptor instead.
+func (*CsePagesRequest) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CsePagesRequest) GetParams() *CseParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *CsePagesRequest) GetMaxPages() uint32 {
+	if x != nil {
+		return x.MaxPages
+	}
+	return 0
+}
+
+type PsePagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Params        *PseParams             `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	MaxPages      uint32                 `protobuf:"varint,2,opt,name=max_pages,json=maxPages,proto3" json:"max_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PsePagesRequest) Reset() {
+	*x = PsePagesRequest{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PsePagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PsePagesRequest) ProtoMessage() {}
+
+func (x *PsePagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PsePagesRequest.ProtoReflect.Descriptor instead.
+func (*PsePagesRequest) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *PsePagesRequest) GetParams() *PseParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *PsePagesRequest) GetMaxPages() uint32 {
+	if x != nil {
+		return x.MaxPages
+	}
+	return 0
+}
+
+type LbsPagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Params        *LbsParams             `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	MaxPages      uint32                 `protobuf:"varint,2,opt,name=max_pages,json=maxPages,proto3" json:"max_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LbsPagesRequest) Reset() {
+	*x = LbsPagesRequest{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LbsPagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LbsPagesRequest) ProtoMessage() {}
+
+func (x *LbsPagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LbsPagesRequest.ProtoReflect.Descriptor instead.
+func (*LbsPagesRequest) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *LbsPagesRequest) GetParams() *LbsParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *LbsPagesRequest) GetMaxPages() uint32 {
+	if x != nil {
+		return x.MaxPages
+	}
+	return 0
+}
+
+type EppPerson struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	FullName           string                 `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	FirstName          string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName           string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	LinkedinUrl        string                 `protobuf:"bytes,4,opt,name=linkedin_url,json=linkedinUrl,proto3" json:"linkedin_url,omitempty"`
+	Summary            string                 `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
+	LinkedinFollowers  int64                  `protobuf:"varint,6,opt,name=linkedin_followers,json=linkedinFollowers,proto3" json:"linkedin_followers,omitempty"`
+	Facebook           string                 `protobuf:"bytes,7,opt,name=facebook,proto3" json:"facebook,omitempty"`
+	Twitter            string                 `protobuf:"bytes,8,opt,name=twitter,proto3" json:"twitter,omitempty"`
+	Avatar             string                 `protobuf:"bytes,9,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Country            string                 `protobuf:"bytes,10,opt,name=country,proto3" json:"country,omitempty"`
+	State              string                 `protobuf:"bytes,11,opt,name=state,proto3" json:"state,omitempty"`
+	City               string                 `protobuf:"bytes,12,opt,name=city,proto3" json:"city,omitempty"`
+	JobTitle           string                 `protobuf:"bytes,13,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
+	JobTitleCategories []string               `protobuf:"bytes,14,rep,name=job_title_categories,json=jobTitleCategories,proto3" json:"job_title_categories,omitempty"`
+	CompanyName        string                 `protobuf:"bytes,15,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	CompanyLinkedin    string                 `protobuf:"bytes,16,opt,name=company_linkedin,json=companyLinkedin,proto3" json:"company_linkedin,omitempty"`
+	CompanyWebsite     string                 `protobuf:"bytes,17,opt,name=company_website,json=companyWebsite,proto3" json:"company_website,omitempty"`
+	CompanySize        string                 `protobuf:"bytes,18,opt,name=company_size,json=companySize,proto3" json:"company_size,omitempty"`
+	CompanyIndustry    string                 `protobuf:"bytes,19,opt,name=company_industry,json=companyIndustry,proto3" json:"company_industry,omitempty"`
+	CompanyFacebook    string                 `protobuf:"bytes,20,opt,name=company_facebook,json=companyFacebook,proto3" json:"company_facebook,omitempty"`
+	CompanyTwitter     string                 `protobuf:"bytes,21,opt,name=company_twitter,json=companyTwitter,proto3" json:"company_twitter,omitempty"`
+	CompanyCountry     string                 `protobuf:"bytes,22,opt,name=company_country,json=companyCountry,proto3" json:"company_country,omitempty"`
+	CompanyState       string                 `protobuf:"bytes,23,opt,name=company_state,json=companyState,proto3" json:"company_state,omitempty"`
+	CompanyCity        string                 `protobuf:"bytes,24,opt,name=company_city,json=companyCity,proto3" json:"company_city,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *EppPerson) Reset() {
+	*x = EppPerson{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EppPerson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EppPerson) ProtoMessage() {}
+
+func (x *EppPerson) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EppPerson.ProtoReflect.Descriptor instead.
+func (*EppPerson) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *EppPerson) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *EppPerson) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *EppPerson) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *EppPerson) GetLinkedinUrl() string {
+	if x != nil {
+		return x.LinkedinUrl
+	}
+	return ""
+}
+
+func (x *EppPerson) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *EppPerson) GetLinkedinFollowers() int64 {
+	if x != nil {
+		return x.LinkedinFollowers
+	}
+	return 0
+}
+
+func (x *EppPerson) GetFacebook() string {
+	if x != nil {
+		return x.Facebook
+	}
+	return ""
+}
+
+func (x *EppPerson) GetTwitter() string {
+	if x != nil {
+		return x.Twitter
+	}
+	return ""
+}
+
+func (x *EppPerson) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *EppPerson) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *EppPerson) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *EppPerson) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *EppPerson) GetJobTitle() string {
+	if x != nil {
+		return x.JobTitle
+	}
+	return ""
+}
+
+func (x *EppPerson) GetJobTitleCategories() []string {
+	if x != nil {
+		return x.JobTitleCategories
+	}
+	return nil
+}
+
+func (x *EppPerson) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *EppPerson) GetCompanyLinkedin() string {
+	if x != nil {
+		return x.CompanyLinkedin
+	}
+	return ""
+}
+
+func (x *EppPerson) GetCompanyWebsite() string {
+	if x != nil {
+		return x.CompanyWebsite
+	}
+	return ""
+}
+
+func (x *EppPerson) GetCompanySize() string {
+	if x != nil {
+		return x.CompanySize
+	}
+	return ""
+}
+
+func (x *EppPerson) GetCompanyIndustry() string {
+	if x != nil {
+		return x.CompanyIndustry
+	}
+	return ""
+}
+
+func (x *EppPerson) GetCompanyFacebook() string {
+	if x != nil {
+		return x.CompanyFacebook
+	}
+	return ""
+}
+
+func (x *EppPerson) GetCompanyTwitter() string {
+	if x != nil {
+		return x.CompanyTwitter
+	}
+	return ""
+}
+
+func (x *EppPerson) GetCompanyCountry() string {
+	if x != nil {
+		return x.CompanyCountry
+	}
+	return ""
+}
+
+func (x *EppPerson) GetCompanyState() string {
+	if x != nil {
+		return x.CompanyState
+	}
+	return ""
+}
+
+func (x *EppPerson) GetCompanyCity() string {
+	if x != nil {
+		return x.CompanyCity
+	}
+	return ""
+}
+
+type RelPerson struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	FullName           string                 `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	FirstName          string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName           string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	LinkedinUrl        string                 `protobuf:"bytes,4,opt,name=linkedin_url,json=linkedinUrl,proto3" json:"linkedin_url,omitempty"`
+	Summary            string                 `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
+	LinkedinFollowers  string                 `protobuf:"bytes,6,opt,name=linkedin_followers,json=linkedinFollowers,proto3" json:"linkedin_followers,omitempty"`
+	Facebook           string                 `protobuf:"bytes,7,opt,name=facebook,proto3" json:"facebook,omitempty"`
+	Twitter            string                 `protobuf:"bytes,8,opt,name=twitter,proto3" json:"twitter,omitempty"`
+	Avatar             string                 `protobuf:"bytes,9,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Country            string                 `protobuf:"bytes,10,opt,name=country,proto3" json:"country,omitempty"`
+	State              string                 `protobuf:"bytes,11,opt,name=state,proto3" json:"state,omitempty"`
+	City               string                 `protobuf:"bytes,12,opt,name=city,proto3" json:"city,omitempty"`
+	JobTitle           string                 `protobuf:"bytes,13,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
+	JobTitleCategories []string               `protobuf:"bytes,14,rep,name=job_title_categories,json=jobTitleCategories,proto3" json:"job_title_categories,omitempty"`
+	CompanyName        string                 `protobuf:"bytes,15,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	CompanyLinkedin    string                 `protobuf:"bytes,16,opt,name=company_linkedin,json=companyLinkedin,proto3" json:"company_linkedin,omitempty"`
+	CompanyWebsite     string                 `protobuf:"bytes,17,opt,name=company_website,json=companyWebsite,proto3" json:"company_website,omitempty"`
+	CompanySize        string                 `protobuf:"bytes,18,opt,name=company_size,json=companySize,proto3" json:"company_size,omitempty"`
+	CompanyIndustry    string                 `protobuf:"bytes,19,opt,name=company_industry,json=companyIndustry,proto3" json:"company_industry,omitempty"`
+	CompanyFacebook    string                 `protobuf:"bytes,20,opt,name=company_facebook,json=companyFacebook,proto3" json:"company_facebook,omitempty"`
+	CompanyTwitter     string                 `protobuf:"bytes,21,opt,name=company_twitter,json=companyTwitter,proto3" json:"company_twitter,omitempty"`
+	CompanyCountry     string                 `protobuf:"bytes,22,opt,name=company_country,json=companyCountry,proto3" json:"company_country,omitempty"`
+	CompanyState       string                 `protobuf:"bytes,23,opt,name=company_state,json=companyState,proto3" json:"company_state,omitempty"`
+	CompanyCity        string                 `protobuf:"bytes,24,opt,name=company_city,json=companyCity,proto3" json:"company_city,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RelPerson) Reset() {
+	*x = RelPerson{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelPerson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelPerson) ProtoMessage() {}
+
+func (x *RelPerson) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelPerson.ProtoReflect.Descriptor instead.
+func (*RelPerson) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RelPerson) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *RelPerson) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *RelPerson) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *RelPerson) GetLinkedinUrl() string {
+	if x != nil {
+		return x.LinkedinUrl
+	}
+	return ""
+}
+
+func (x *RelPerson) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *RelPerson) GetLinkedinFollowers() string {
+	if x != nil {
+		return x.LinkedinFollowers
+	}
+	return ""
+}
+
+func (x *RelPerson) GetFacebook() string {
+	if x != nil {
+		return x.Facebook
+	}
+	return ""
+}
+
+func (x *RelPerson) GetTwitter() string {
+	if x != nil {
+		return x.Twitter
+	}
+	return ""
+}
+
+func (x *RelPerson) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *RelPerson) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *RelPerson) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *RelPerson) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *RelPerson) GetJobTitle() string {
+	if x != nil {
+		return x.JobTitle
+	}
+	return ""
+}
+
+func (x *RelPerson) GetJobTitleCategories() []string {
+	if x != nil {
+		return x.JobTitleCategories
+	}
+	return nil
+}
+
+func (x *RelPerson) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *RelPerson) GetCompanyLinkedin() string {
+	if x != nil {
+		return x.CompanyLinkedin
+	}
+	return ""
+}
+
+func (x *RelPerson) GetCompanyWebsite() string {
+	if x != nil {
+		return x.CompanyWebsite
+	}
+	return ""
+}
+
+func (x *RelPerson) GetCompanySize() string {
+	if x != nil {
+		return x.CompanySize
+	}
+	return ""
+}
+
+func (x *RelPerson) GetCompanyIndustry() string {
+	if x != nil {
+		return x.CompanyIndustry
+	}
+	return ""
+}
+
+func (x *RelPerson) GetCompanyFacebook() string {
+	if x != nil {
+		return x.CompanyFacebook
+	}
+	return ""
+}
+
+func (x *RelPerson) GetCompanyTwitter() string {
+	if x != nil {
+		return x.CompanyTwitter
+	}
+	return ""
+}
+
+func (x *RelPerson) GetCompanyCountry() string {
+	if x != nil {
+		return x.CompanyCountry
+	}
+	return ""
+}
+
+func (x *RelPerson) GetCompanyState() string {
+	if x != nil {
+		return x.CompanyState
+	}
+	return ""
+}
+
+func (x *RelPerson) GetCompanyCity() string {
+	if x != nil {
+		return x.CompanyCity
+	}
+	return ""
+}
+
+type TepPerson struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	FullName           string                 `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	FirstName          string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName           string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	LinkedinUrl        string                 `protobuf:"bytes,4,opt,name=linkedin_url,json=linkedinUrl,proto3" json:"linkedin_url,omitempty"`
+	Summary            string                 `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
+	LinkedinFollowers  int64                  `protobuf:"varint,6,opt,name=linkedin_followers,json=linkedinFollowers,proto3" json:"linkedin_followers,omitempty"`
+	Facebook           string                 `protobuf:"bytes,7,opt,name=facebook,proto3" json:"facebook,omitempty"`
+	Twitter            string                 `protobuf:"bytes,8,opt,name=twitter,proto3" json:"twitter,omitempty"`
+	Avatar             string                 `protobuf:"bytes,9,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Country            string                 `protobuf:"bytes,10,opt,name=country,proto3" json:"country,omitempty"`
+	State              string                 `protobuf:"bytes,11,opt,name=state,proto3" json:"state,omitempty"`
+	City               string                 `protobuf:"bytes,12,opt,name=city,proto3" json:"city,omitempty"`
+	JobTitle           string                 `protobuf:"bytes,13,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
+	JobTitleCategories []string               `protobuf:"bytes,14,rep,name=job_title_categories,json=jobTitleCategories,proto3" json:"job_title_categories,omitempty"`
+	CompanyName        string                 `protobuf:"bytes,15,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	CompanyLinkedin    string                 `protobuf:"bytes,16,opt,name=company_linkedin,json=companyLinkedin,proto3" json:"company_linkedin,omitempty"`
+	CompanyWebsite     string                 `protobuf:"bytes,17,opt,name=company_website,json=companyWebsite,proto3" json:"company_website,omitempty"`
+	CompanySize        string                 `protobuf:"bytes,18,opt,name=company_size,json=companySize,proto3" json:"company_size,omitempty"`
+	CompanyIndustry    string                 `protobuf:"bytes,19,opt,name=company_industry,json=companyIndustry,proto3" json:"company_industry,omitempty"`
+	CompanyFacebook    string                 `protobuf:"bytes,20,opt,name=company_facebook,json=companyFacebook,proto3" json:"company_facebook,omitempty"`
+	CompanyTwitter     string                 `protobuf:"bytes,21,opt,name=company_twitter,json=companyTwitter,proto3" json:"company_twitter,omitempty"`
+	CompanyCountry     string                 `protobuf:"bytes,22,opt,name=company_country,json=companyCountry,proto3" json:"company_country,omitempty"`
+	CompanyState       string                 `protobuf:"bytes,23,opt,name=company_state,json=companyState,proto3" json:"company_state,omitempty"`
+	CompanyCity        string                 `protobuf:"bytes,24,opt,name=company_city,json=companyCity,proto3" json:"company_city,omitempty"`
+	Email              string                 `protobuf:"bytes,25,opt,name=email,proto3" json:"email,omitempty"`
+	Phone              string                 `protobuf:"bytes,26,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TepPerson) Reset() {
+	*x = TepPerson{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TepPerson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TepPerson) ProtoMessage() {}
+
+func (x *TepPerson) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TepPerson.ProtoReflect.Descriptor instead.
+func (*TepPerson) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *TepPerson) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *TepPerson) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *TepPerson) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *TepPerson) GetLinkedinUrl() string {
+	if x != nil {
+		return x.LinkedinUrl
+	}
+	return ""
+}
+
+func (x *TepPerson) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *TepPerson) GetLinkedinFollowers() int64 {
+	if x != nil {
+		return x.LinkedinFollowers
+	}
+	return 0
+}
+
+func (x *TepPerson) GetFacebook() string {
+	if x != nil {
+		return x.Facebook
+	}
+	return ""
+}
+
+func (x *TepPerson) GetTwitter() string {
+	if x != nil {
+		return x.Twitter
+	}
+	return ""
+}
+
+func (x *TepPerson) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *TepPerson) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *TepPerson) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *TepPerson) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *TepPerson) GetJobTitle() string {
+	if x != nil {
+		return x.JobTitle
+	}
+	return ""
+}
+
+func (x *TepPerson) GetJobTitleCategories() []string {
+	if x != nil {
+		return x.JobTitleCategories
+	}
+	return nil
+}
+
+func (x *TepPerson) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *TepPerson) GetCompanyLinkedin() string {
+	if x != nil {
+		return x.CompanyLinkedin
+	}
+	return ""
+}
+
+func (x *TepPerson) GetCompanyWebsite() string {
+	if x != nil {
+		return x.CompanyWebsite
+	}
+	return ""
+}
+
+func (x *TepPerson) GetCompanySize() string {
+	if x != nil {
+		return x.CompanySize
+	}
+	return ""
+}
+
+func (x *TepPerson) GetCompanyIndustry() string {
+	if x != nil {
+		return x.CompanyIndustry
+	}
+	return ""
+}
+
+func (x *TepPerson) GetCompanyFacebook() string {
+	if x != nil {
+		return x.CompanyFacebook
+	}
+	return ""
+}
+
+func (x *TepPerson) GetCompanyTwitter() string {
+	if x != nil {
+		return x.CompanyTwitter
+	}
+	return ""
+}
+
+func (x *TepPerson) GetCompanyCountry() string {
+	if x != nil {
+		return x.CompanyCountry
+	}
+	return ""
+}
+
+func (x *TepPerson) GetCompanyState() string {
+	if x != nil {
+		return x.CompanyState
+	}
+	return ""
+}
+
+func (x *TepPerson) GetCompanyCity() string {
+	if x != nil {
+		return x.CompanyCity
+	}
+	return ""
+}
+
+func (x *TepPerson) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *TepPerson) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type FclCompany struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Website        string                 `protobuf:"bytes,2,opt,name=website,proto3" json:"website,omitempty"`
+	EmployeeCount  int64                  `protobuf:"varint,3,opt,name=employee_count,json=employeeCount,proto3" json:"employee_count,omitempty"`
+	Size           string                 `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
+	Industry       string                 `protobuf:"bytes,5,opt,name=industry,proto3" json:"industry,omitempty"`
+	Description    string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	LinkedinUrl    string                 `protobuf:"bytes,7,opt,name=linkedin_url,json=linkedinUrl,proto3" json:"linkedin_url,omitempty"`
+	Domain         string                 `protobuf:"bytes,8,opt,name=domain,proto3" json:"domain,omitempty"`
+	Country        string                 `protobuf:"bytes,9,opt,name=country,proto3" json:"country,omitempty"`
+	State          string                 `protobuf:"bytes,10,opt,name=state,proto3" json:"state,omitempty"`
+	City           string                 `protobuf:"bytes,11,opt,name=city,proto3" json:"city,omitempty"`
+	Address        string                 `protobuf:"bytes,12,opt,name=address,proto3" json:"address,omitempty"`
+	FoundedYear    string                 `protobuf:"bytes,13,opt,name=founded_year,json=foundedYear,proto3" json:"founded_year,omitempty"`
+	LogoUrl        string                 `protobuf:"bytes,14,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	FollowersCount int64                  `protobuf:"varint,15,opt,name=followers_count,json=followersCount,proto3" json:"followers_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FclCompany) Reset() {
+	*x = FclCompany{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FclCompany) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FclCompany) ProtoMessage() {}
+
+func (x *FclCompany) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FclCompany.ProtoReflect.Descriptor instead.
+func (*FclCompany) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *FclCompany) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FclCompany) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *FclCompany) GetEmployeeCount() int64 {
+	if x != nil {
+		return x.EmployeeCount
+	}
+	return 0
+}
+
+func (x *FclCompany) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *FclCompany) GetIndustry() string {
+	if x != nil {
+		return x.Industry
+	}
+	return ""
+}
+
+func (x *FclCompany) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FclCompany) GetLinkedinUrl() string {
+	if x != nil {
+		return x.LinkedinUrl
+	}
+	return ""
+}
+
+func (x *FclCompany) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *FclCompany) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *FclCompany) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *FclCompany) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *FclCompany) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *FclCompany) GetFoundedYear() string {
+	if x != nil {
+		return x.FoundedYear
+	}
+	return ""
+}
+
+func (x *FclCompany) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *FclCompany) GetFollowersCount() int64 {
+	if x != nil {
+		return x.FollowersCount
+	}
+	return 0
+}
+
+type ElfFundraising struct {
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	FundingLastRoundType         string                 `protobuf:"bytes,1,opt,name=funding_last_round_type,json=fundingLastRoundType,proto3" json:"funding_last_round_type,omitempty"`
+	FundingAmmountCurrencyCode   string                 `protobuf:"bytes,2,opt,name=funding_ammount_currency_code,json=fundingAmmountCurrencyCode,proto3" json:"funding_ammount_currency_code,omitempty"`
+	FundingMoneyRaised           string                 `protobuf:"bytes,3,opt,name=funding_money_raised,json=fundingMoneyRaised,proto3" json:"funding_money_raised,omitempty"`
+	FundingLastRoundInvestorsUrl string                 `protobuf:"bytes,4,opt,name=funding_last_round_investors_url,json=fundingLastRoundInvestorsUrl,proto3" json:"funding_last_round_investors_url,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ElfFundraising) Reset() {
+	*x = ElfFundraising{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ElfFundraising) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElfFundraising) ProtoMessage() {}
+
+func (x *ElfFundraising) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElfFundraising.ProtoReflect.Descriptor instead.
+func (*ElfFundraising) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ElfFundraising) GetFundingLastRoundType() string {
+	if x != nil {
+		return x.FundingLastRoundType
+	}
+	return ""
+}
+
+func (x *ElfFundraising) GetFundingAmmountCurrencyCode() string {
+	if x != nil {
+		return x.FundingAmmountCurrencyCode
+	}
+	return ""
+}
+
+func (x *ElfFundraising) GetFundingMoneyRaised() string {
+	if x != nil {
+		return x.FundingMoneyRaised
+	}
+	return ""
+}
+
+func (x *ElfFundraising) GetFundingLastRoundInvestorsUrl() string {
+	if x != nil {
+		return x.FundingLastRoundInvestorsUrl
+	}
+	return ""
+}
+
+type EncCompany struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Website        string                 `protobuf:"bytes,2,opt,name=website,proto3" json:"website,omitempty"`
+	EmployeeCount  int64                  `protobuf:"varint,3,opt,name=employee_count,json=employeeCount,proto3" json:"employee_count,omitempty"`
+	Size           string                 `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
+	Industry       string                 `protobuf:"bytes,5,opt,name=industry,proto3" json:"industry,omitempty"`
+	Description    string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	LinkedinUrl    string                 `protobuf:"bytes,7,opt,name=linkedin_url,json=linkedinUrl,proto3" json:"linkedin_url,omitempty"`
+	Type           string                 `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`
+	Domain         string                 `protobuf:"bytes,9,opt,name=domain,proto3" json:"domain,omitempty"`
+	Country        string                 `protobuf:"bytes,10,opt,name=country,proto3" json:"country,omitempty"`
+	State          string                 `protobuf:"bytes,11,opt,name=state,proto3" json:"state,omitempty"`
+	City           string                 `protobuf:"bytes,12,opt,name=city,proto3" json:"city,omitempty"`
+	Address        string                 `protobuf:"bytes,13,opt,name=address,proto3" json:"address,omitempty"`
+	FoundedYear    string                 `protobuf:"bytes,14,opt,name=founded_year,json=foundedYear,proto3" json:"founded_year,omitempty"`
+	LogoUrl        string                 `protobuf:"bytes,15,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	FollowersCount int64                  `protobuf:"varint,16,opt,name=followers_count,json=followersCount,proto3" json:"followers_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EncCompany) Reset() {
+	*x = EncCompany{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncCompany) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncCompany) ProtoMessage() {}
+
+func (x *EncCompany) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncCompany.ProtoReflect.Descriptor instead.
+func (*EncCompany) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *EncCompany) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EncCompany) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *EncCompany) GetEmployeeCount() int64 {
+	if x != nil {
+		return x.EmployeeCount
+	}
+	return 0
+}
+
+func (x *EncCompany) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *EncCompany) GetIndustry() string {
+	if x != nil {
+		return x.Industry
+	}
+	return ""
+}
+
+func (x *EncCompany) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *EncCompany) GetLinkedinUrl() string {
+	if x != nil {
+		return x.LinkedinUrl
+	}
+	return ""
+}
+
+func (x *EncCompany) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EncCompany) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *EncCompany) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *EncCompany) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *EncCompany) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *EncCompany) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EncCompany) GetFoundedYear() string {
+	if x != nil {
+		return x.FoundedYear
+	}
+	return ""
+}
+
+func (x *EncCompany) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *EncCompany) GetFollowersCount() int64 {
+	if x != nil {
+		return x.FollowersCount
+	}
+	return 0
+}
+
+type CloLocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Country       string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,4,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Line1         string                 `protobuf:"bytes,5,opt,name=line1,proto3" json:"line1,omitempty"`
+	Line2         string                 `protobuf:"bytes,6,opt,name=line2,proto3" json:"line2,omitempty"`
+	Latitude      string                 `protobuf:"bytes,7,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     string                 `protobuf:"bytes,8,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloLocation) Reset() {
+	*x = CloLocation{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloLocation) ProtoMessage() {}
+
+func (x *CloLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloLocation.ProtoReflect.Descriptor instead.
+func (*CloLocation) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CloLocation) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *CloLocation) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CloLocation) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *CloLocation) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *CloLocation) GetLine1() string {
+	if x != nil {
+		return x.Line1
+	}
+	return ""
+}
+
+func (x *CloLocation) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
+}
+
+func (x *CloLocation) GetLatitude() string {
+	if x != nil {
+		return x.Latitude
+	}
+	return ""
+}
+
+func (x *CloLocation) GetLongitude() string {
+	if x != nil {
+		return x.Longitude
+	}
+	return ""
+}
+
+type Company struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Domain        string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	LinkedinUrl   string                 `protobuf:"bytes,3,opt,name=linkedin_url,json=linkedinUrl,proto3" json:"linkedin_url,omitempty"`
+	Industry      string                 `protobuf:"bytes,4,opt,name=industry,proto3" json:"industry,omitempty"`
+	Overview      string                 `protobuf:"bytes,5,opt,name=overview,proto3" json:"overview,omitempty"`
+	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	Size          string                 `protobuf:"bytes,7,opt,name=size,proto3" json:"size,omitempty"`
+	MainLocation  *MainLocation          `protobuf:"bytes,8,opt,name=main_location,json=mainLocation,proto3" json:"main_location,omitempty"`
+	Location      string                 `protobuf:"bytes,9,opt,name=location,proto3" json:"location,omitempty"`
+	Description   string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	Founded       int64                  `protobuf:"varint,11,opt,name=founded,proto3" json:"founded,omitempty"`
+	Revenue       string                 `protobuf:"bytes,12,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	Employees     *CompanyEmployees      `protobuf:"bytes,13,opt,name=employees,proto3" json:"employees,omitempty"`
+	Website       string                 `protobuf:"bytes,14,opt,name=website,proto3" json:"website,omitempty"`
+	Phone         string                 `protobuf:"bytes,15,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email         string                 `protobuf:"bytes,16,opt,name=email,proto3" json:"email,omitempty"`
+	Social        *CompanySocial         `protobuf:"bytes,17,opt,name=social,proto3" json:"social,omitempty"`
+	Technologies  []string               `protobuf:"bytes,18,rep,name=technologies,proto3" json:"technologies,omitempty"`
+	Subsidiaries  []string               `protobuf:"bytes,19,rep,name=subsidiaries,proto3" json:"subsidiaries,omitempty"`
+	Headquarters  string                 `protobuf:"bytes,20,opt,name=headquarters,proto3" json:"headquarters,omitempty"`
+	Country       string                 `protobuf:"bytes,21,opt,name=country,proto3" json:"country,omitempty"`
+	State         string                 `protobuf:"bytes,22,opt,name=state,proto3" json:"state,omitempty"`
+	City          string                 `protobuf:"bytes,23,opt,name=city,proto3" json:"city,omitempty"`
+	ZipCode       string                 `protobuf:"bytes,24,opt,name=zip_code,json=zipCode,proto3" json:"zip_code,omitempty"`
+	Address       string                 `protobuf:"bytes,25,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Company) Reset() {
+	*x = Company{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Company) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Company) ProtoMessage() {}
+
+func (x *Company) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Company.ProtoReflect.Descriptor instead.
+func (*Company) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Company) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Company) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *Company) GetLinkedinUrl() string {
+	if x != nil {
+		return x.LinkedinUrl
+	}
+	return ""
+}
+
+func (x *Company) GetIndustry() string {
+	if x != nil {
+		return x.Industry
+	}
+	return ""
+}
+
+func (x *Company) GetOverview() string {
+	if x != nil {
+		return x.Overview
+	}
+	return ""
+}
+
+func (x *Company) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Company) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *Company) GetMainLocation() *MainLocation {
+	if x != nil {
+		return x.MainLocation
+	}
+	return nil
+}
+
+func (x *Company) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Company) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Company) GetFounded() int64 {
+	if x != nil {
+		return x.Founded
+	}
+	return 0
+}
+
+func (x *Company) GetRevenue() string {
+	if x != nil {
+		return x.Revenue
+	}
+	return ""
+}
+
+func (x *Company) GetEmployees() *CompanyEmployees {
+	if x != nil {
+		return x.Employees
+	}
+	return nil
+}
+
+func (x *Company) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *Company) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Company) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Company) GetSocial() *CompanySocial {
+	if x != nil {
+		return x.Social
+	}
+	return nil
+}
+
+func (x *Company) GetTechnologies() []string {
+	if x != nil {
+		return x.Technologies
+	}
+	return nil
+}
+
+func (x *Company) GetSubsidiaries() []string {
+	if x != nil {
+		return x.Subsidiaries
+	}
+	return nil
+}
+
+func (x *Company) GetHeadquarters() string {
+	if x != nil {
+		return x.Headquarters
+	}
+	return ""
+}
+
+func (x *Company) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Company) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Company) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Company) GetZipCode() string {
+	if x != nil {
+		return x.ZipCode
+	}
+	return ""
+}
+
+func (x *Company) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type Person struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FirstName      string                 `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName       string                 `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	FullName       string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Logo           string                 `protobuf:"bytes,4,opt,name=logo,proto3" json:"logo,omitempty"`
+	Overview       string                 `protobuf:"bytes,5,opt,name=overview,proto3" json:"overview,omitempty"`
+	Experience     *structpb.Value        `protobuf:"bytes,6,opt,name=experience,proto3" json:"experience,omitempty"`
+	Connections    *PeopleConnections     `protobuf:"bytes,7,opt,name=connections,proto3" json:"connections,omitempty"`
+	Interests      []string               `protobuf:"bytes,8,rep,name=interests,proto3" json:"interests,omitempty"`
+	Skills         []string               `protobuf:"bytes,9,rep,name=skills,proto3" json:"skills,omitempty"`
+	Educations     []*PeopleEducation     `protobuf:"bytes,10,rep,name=educations,proto3" json:"educations,omitempty"`
+	Experiences    []*PeopleExperience    `protobuf:"bytes,11,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	Certifications []*PeopleCertification `protobuf:"bytes,12,rep,name=certifications,proto3" json:"certifications,omitempty"`
+	Company        *Company               `protobuf:"bytes,13,opt,name=company,proto3" json:"company,omitempty"`
+	Location       *PeopleLocation        `protobuf:"bytes,14,opt,name=location,proto3" json:"location,omitempty"`
+	CurrentJob     *PeopleCurrentJob      `protobuf:"bytes,15,opt,name=current_job,json=currentJob,proto3" json:"current_job,omitempty"`
+	Social         *PeopleSocial          `protobuf:"bytes,16,opt,name=social,proto3" json:"social,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Person) Reset() {
+	*x = Person{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Person) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Person) ProtoMessage() {}
+
+func (x *Person) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Person.ProtoReflect.Descriptor instead.
+func (*Person) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Person) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Person) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *Person) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Person) GetLogo() string {
+	if x != nil {
+		return x.Logo
+	}
+	return ""
+}
+
+func (x *Person) GetOverview() string {
+	if x != nil {
+		return x.Overview
+	}
+	return ""
+}
+
+func (x *Person) GetExperience() *structpb.Value {
+	if x != nil {
+		return x.Experience
+	}
+	return nil
+}
+
+func (x *Person) GetConnections() *PeopleConnections {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+func (x *Person) GetInterests() []string {
+	if x != nil {
+		return x.Interests
+	}
+	return nil
+}
+
+func (x *Person) GetSkills() []string {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *Person) GetEducations() []*PeopleEducation {
+	if x != nil {
+		return x.Educations
+	}
+	return nil
+}
+
+func (x *Person) GetExperiences() []*PeopleExperience {
+	if x != nil {
+		return x.Experiences
+	}
+	return nil
+}
+
+func (x *Person) GetCertifications() []*PeopleCertification {
+	if x != nil {
+		return x.Certifications
+	}
+	return nil
+}
+
+func (x *Person) GetCompany() *Company {
+	if x != nil {
+		return x.Company
+	}
+	return nil
+}
+
+func (x *Person) GetLocation() *PeopleLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Person) GetCurrentJob() *PeopleCurrentJob {
+	if x != nil {
+		return x.CurrentJob
+	}
+	return nil
+}
+
+func (x *Person) GetSocial() *PeopleSocial {
+	if x != nil {
+		return x.Social
+	}
+	return nil
+}
+
+type CsnSnapshotInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Icp              string                 `protobuf:"bytes,1,opt,name=icp,proto3" json:"icp,omitempty"`
+	TargetIndustries []string               `protobuf:"bytes,2,rep,name=target_industries,json=targetIndustries,proto3" json:"target_industries,omitempty"`
+	TargetPersonas   []string               `protobuf:"bytes,3,rep,name=target_personas,json=targetPersonas,proto3" json:"target_personas,omitempty"`
+	ValueProposition string                 `protobuf:"bytes,4,opt,name=value_proposition,json=valueProposition,proto3" json:"value_proposition,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CsnSnapshotInfo) Reset() {
+	*x = CsnSnapshotInfo{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CsnSnapshotInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CsnSnapshotInfo) ProtoMessage() {}
+
+func (x *CsnSnapshotInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CsnSnapshotInfo.ProtoReflect.Descriptor instead.
+func (*CsnSnapshotInfo) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CsnSnapshotInfo) GetIcp() string {
+	if x != nil {
+		return x.Icp
+	}
+	return ""
+}
+
+func (x *CsnSnapshotInfo) GetTargetIndustries() []string {
+	if x != nil {
+		return x.TargetIndustries
+	}
+	return nil
+}
+
+func (x *CsnSnapshotInfo) GetTargetPersonas() []string {
+	if x != nil {
+		return x.TargetPersonas
+	}
+	return nil
+}
+
+func (x *CsnSnapshotInfo) GetValueProposition() string {
+	if x != nil {
+		return x.ValueProposition
+	}
+	return ""
+}
+
+type MainLocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Geo           string                 `protobuf:"bytes,1,opt,name=geo,proto3" json:"geo,omitempty"`
+	Country       string                 `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	City          string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	Address       string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Continent     string                 `protobuf:"bytes,6,opt,name=continent,proto3" json:"continent,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,7,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MainLocation) Reset() {
+	*x = MainLocation{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MainLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MainLocation) ProtoMessage() {}
+
+func (x *MainLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MainLocation.ProtoReflect.Descriptor instead.
+func (*MainLocation) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *MainLocation) GetGeo() string {
+	if x != nil {
+		return x.Geo
+	}
+	return ""
+}
+
+func (x *MainLocation) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *MainLocation) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *MainLocation) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *MainLocation) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *MainLocation) GetContinent() string {
+	if x != nil {
+		return x.Continent
+	}
+	return ""
+}
+
+func (x *MainLocation) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+type CompanyEmployees struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Range         string                 `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompanyEmployees) Reset() {
+	*x = CompanyEmployees{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanyEmployees) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanyEmployees) ProtoMessage() {}
+
+func (x *CompanyEmployees) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanyEmployees.ProtoReflect.Descriptor instead.
+func (*CompanyEmployees) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CompanyEmployees) GetRange() string {
+	if x != nil {
+		return x.Range
+	}
+	return ""
+}
+
+func (x *CompanyEmployees) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type CompanySocial struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Facebook      string                 `protobuf:"bytes,1,opt,name=facebook,proto3" json:"facebook,omitempty"`
+	Linkedin      string                 `protobuf:"bytes,2,opt,name=linkedin,proto3" json:"linkedin,omitempty"`
+	Twitter       string                 `protobuf:"bytes,3,opt,name=twitter,proto3" json:"twitter,omitempty"`
+	Youtube       string                 `protobuf:"bytes,4,opt,name=youtube,proto3" json:"youtube,omitempty"`
+	Instagram     string                 `protobuf:"bytes,5,opt,name=instagram,proto3" json:"instagram,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompanySocial) Reset() {
+	*x = CompanySocial{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanySocial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanySocial) ProtoMessage() {}
+
+func (x *CompanySocial) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanySocial.ProtoReflect.Descriptor instead.
+func (*CompanySocial) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CompanySocial) GetFacebook() string {
+	if x != nil {
+		return x.Facebook
+	}
+	return ""
+}
+
+func (x *CompanySocial) GetLinkedin() string {
+	if x != nil {
+		return x.Linkedin
+	}
+	return ""
+}
+
+func (x *CompanySocial) GetTwitter() string {
+	if x != nil {
+		return x.Twitter
+	}
+	return ""
+}
+
+func (x *CompanySocial) GetYoutube() string {
+	if x != nil {
+		return x.Youtube
+	}
+	return ""
+}
+
+func (x *CompanySocial) GetInstagram() string {
+	if x != nil {
+		return x.Instagram
+	}
+	return ""
+}
+
+type PeopleConnections struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	HasWorkEmail     bool                   `protobuf:"varint,1,opt,name=has_work_email,json=hasWorkEmail,proto3" json:"has_work_email,omitempty"`
+	HasPersonalEmail bool                   `protobuf:"varint,2,opt,name=has_personal_email,json=hasPersonalEmail,proto3" json:"has_personal_email,omitempty"`
+	HasPhone         bool                   `protobuf:"varint,3,opt,name=has_phone,json=hasPhone,proto3" json:"has_phone,omitempty"`
+	WorkEmail        string                 `protobuf:"bytes,4,opt,name=work_email,json=workEmail,proto3" json:"work_email,omitempty"`
+	PersonalEmail    string                 `protobuf:"bytes,5,opt,name=personal_email,json=personalEmail,proto3" json:"personal_email,omitempty"`
+	Phone            string                 `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`
+	IsAcceptAll      bool                   `protobuf:"varint,7,opt,name=is_accept_all,json=isAcceptAll,proto3" json:"is_accept_all,omitempty"`
+	IsAcceptEmail    bool                   `protobuf:"varint,8,opt,name=is_accept_email,json=isAcceptEmail,proto3" json:"is_accept_email,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PeopleConnections) Reset() {
+	*x = PeopleConnections{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeopleConnections) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeopleConnections) ProtoMessage() {}
+
+func (x *PeopleConnections) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeopleConnections.ProtoReflect.Descriptor instead.
+func (*PeopleConnections) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PeopleConnections) GetHasWorkEmail() bool {
+	if x != nil {
+		return x.HasWorkEmail
+	}
+	return false
+}
+
+func (x *PeopleConnections) GetHasPersonalEmail() bool {
+	if x != nil {
+		return x.HasPersonalEmail
+	}
+	return false
+}
+
+func (x *PeopleConnections) GetHasPhone() bool {
+	if x != nil {
+		return x.HasPhone
+	}
+	return false
+}
+
+func (x *PeopleConnections) GetWorkEmail() string {
+	if x != nil {
+		return x.WorkEmail
+	}
+	return ""
+}
+
+func (x *PeopleConnections) GetPersonalEmail() string {
+	if x != nil {
+		return x.PersonalEmail
+	}
+	return ""
+}
+
+func (x *PeopleConnections) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *PeopleConnections) GetIsAcceptAll() bool {
+	if x != nil {
+		return x.IsAcceptAll
+	}
+	return false
+}
+
+func (x *PeopleConnections) GetIsAcceptEmail() bool {
+	if x != nil {
+		return x.IsAcceptEmail
+	}
+	return false
+}
+
+type PeopleEducation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	School        *PeopleEducationSchool `protobuf:"bytes,1,opt,name=school,proto3" json:"school,omitempty"`
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	Gpa           string                 `protobuf:"bytes,4,opt,name=gpa,proto3" json:"gpa,omitempty"`
+	Degrees       []string               `protobuf:"bytes,5,rep,name=degrees,proto3" json:"degrees,omitempty"`
+	Majors        []string               `protobuf:"bytes,6,rep,name=majors,proto3" json:"majors,omitempty"`
+	Minors        []string               `protobuf:"bytes,7,rep,name=minors,proto3" json:"minors,omitempty"`
+	Summary       string                 `protobuf:"bytes,8,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeopleEducation) Reset() {
+	*x = PeopleEducation{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeopleEducation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeopleEducation) ProtoMessage() {}
+
+func (x *PeopleEducation) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeopleEducation.ProtoReflect.Descriptor instead.
+func (*PeopleEducation) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *PeopleEducation) GetSchool() *PeopleEducationSchool {
+	if x != nil {
+		return x.School
+	}
+	return nil
+}
+
+func (x *PeopleEducation) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *PeopleEducation) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *PeopleEducation) GetGpa() string {
+	if x != nil {
+		return x.Gpa
+	}
+	return ""
+}
+
+func (x *PeopleEducation) GetDegrees() []string {
+	if x != nil {
+		return x.Degrees
+	}
+	return nil
+}
+
+func (x *PeopleEducation) GetMajors() []string {
+	if x != nil {
+		return x.Majors
+	}
+	return nil
+}
+
+func (x *PeopleEducation) GetMinors() []string {
+	if x != nil {
+		return x.Minors
+	}
+	return nil
+}
+
+func (x *PeopleEducation) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+type PeopleExperience struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Company       *PeopleExperienceCompany `protobuf:"bytes,1,opt,name=company,json=Company,proto3" json:"company,omitempty"`
+	LocationNames []string                 `protobuf:"bytes,2,rep,name=location_names,json=LocationNames,proto3" json:"location_names,omitempty"`
+	EndDate       string                   `protobuf:"bytes,3,opt,name=end_date,json=EndDate,proto3" json:"end_date,omitempty"`
+	StartDate     string                   `protobuf:"bytes,4,opt,name=start_date,json=StartDate,proto3" json:"start_date,omitempty"`
+	Title         *PeopleExperienceTitle   `protobuf:"bytes,5,opt,name=title,json=Title,proto3" json:"title,omitempty"`
+	IsPrimary     bool                     `protobuf:"varint,6,opt,name=is_primary,json=IsPrimary,proto3" json:"is_primary,omitempty"`
+	Summary       string                   `protobuf:"bytes,7,opt,name=summary,json=Summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeopleExperience) Reset() {
+	*x = PeopleExperience{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeopleExperience) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeopleExperience) ProtoMessage() {}
+
+func (x *PeopleExperience) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeopleExperience.ProtoReflect.Descriptor instead.
+func (*PeopleExperience) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *PeopleExperience) GetCompany() *PeopleExperienceCompany {
+	if x != nil {
+		return x.Company
+	}
+	return nil
+}
+
+func (x *PeopleExperience) GetLocationNames() []string {
+	if x != nil {
+		return x.LocationNames
+	}
+	return nil
+}
+
+func (x *PeopleExperience) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *PeopleExperience) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *PeopleExperience) GetTitle() *PeopleExperienceTitle {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *PeopleExperience) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+func (x *PeopleExperience) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+type PeopleCertification struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Certification  string                 `protobuf:"bytes,1,opt,name=certification,proto3" json:"certification,omitempty"`
+	Issuer         string                 `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	IssueDate      string                 `protobuf:"bytes,3,opt,name=issue_date,json=issueDate,proto3" json:"issue_date,omitempty"`
+	ExpirationDate string                 `protobuf:"bytes,4,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PeopleCertification) Reset() {
+	*x = PeopleCertification{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeopleCertification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeopleCertification) ProtoMessage() {}
+
+func (x *PeopleCertification) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeopleCertification.ProtoReflect.Descriptor instead.
+func (*PeopleCertification) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *PeopleCertification) GetCertification() string {
+	if x != nil {
+		return x.Certification
+	}
+	return ""
+}
+
+func (x *PeopleCertification) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *PeopleCertification) GetIssueDate() string {
+	if x != nil {
+		return x.IssueDate
+	}
+	return ""
+}
+
+func (x *PeopleCertification) GetExpirationDate() string {
+	if x != nil {
+		return x.ExpirationDate
+	}
+	return ""
+}
+
+type PeopleLocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Country       string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeopleLocation) Reset() {
+	*x = PeopleLocation{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeopleLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeopleLocation) ProtoMessage() {}
+
+func (x *PeopleLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeopleLocation.ProtoReflect.Descriptor instead.
+func (*PeopleLocation) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *PeopleLocation) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *PeopleLocation) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *PeopleLocation) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+type PeopleCurrentJob struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Level         string                 `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	Categories    []*JobTitleCategory    `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeopleCurrentJob) Reset() {
+	*x = PeopleCurrentJob{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeopleCurrentJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeopleCurrentJob) ProtoMessage() {}
+
+func (x *PeopleCurrentJob) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeopleCurrentJob.ProtoReflect.Descriptor instead.
+func (*PeopleCurrentJob) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *PeopleCurrentJob) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PeopleCurrentJob) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *PeopleCurrentJob) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *PeopleCurrentJob) GetCategories() []*JobTitleCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type PeopleSocial struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	LinkedinUsername    string                 `protobuf:"bytes,1,opt,name=linkedin_username,json=linkedinUsername,proto3" json:"linkedin_username,omitempty"`
+	LinkedinConnections int64                  `protobuf:"varint,2,opt,name=linkedin_connections,json=linkedinConnections,proto3" json:"linkedin_connections,omitempty"`
+	Linkedin            string                 `protobuf:"bytes,3,opt,name=linkedin,proto3" json:"linkedin,omitempty"`
+	Twitter             string                 `protobuf:"bytes,4,opt,name=twitter,proto3" json:"twitter,omitempty"`
+	Facebook            string                 `protobuf:"bytes,5,opt,name=facebook,proto3" json:"facebook,omitempty"`
+	Github              string                 `protobuf:"bytes,6,opt,name=github,proto3" json:"github,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PeopleSocial) Reset() {
+	*x = PeopleSocial{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeopleSocial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeopleSocial) ProtoMessage() {}
+
+func (x *PeopleSocial) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeopleSocial.ProtoReflect.Descriptor instead.
+func (*PeopleSocial) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *PeopleSocial) GetLinkedinUsername() string {
+	if x != nil {
+		return x.LinkedinUsername
+	}
+	return ""
+}
+
+func (x *PeopleSocial) GetLinkedinConnections() int64 {
+	if x != nil {
+		return x.LinkedinConnections
+	}
+	return 0
+}
+
+func (x *PeopleSocial) GetLinkedin() string {
+	if x != nil {
+		return x.Linkedin
+	}
+	return ""
+}
+
+func (x *PeopleSocial) GetTwitter() string {
+	if x != nil {
+		return x.Twitter
+	}
+	return ""
+}
+
+func (x *PeopleSocial) GetFacebook() string {
+	if x != nil {
+		return x.Facebook
+	}
+	return ""
+}
+
+func (x *PeopleSocial) GetGithub() string {
+	if x != nil {
+		return x.Github
+	}
+	return ""
+}
+
+type PeopleEducationSchool struct {
+	state             protoimpl.MessageState         `protogen:"open.v1"`
+	Name              string                         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type              string                         `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Id                string                         `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Location          *PeopleEducationSchoolLocation `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	LinkedinUrl       string                         `protobuf:"bytes,5,opt,name=linkedin_url,json=linkedinUrl,proto3" json:"linkedin_url,omitempty"`
+	FacebookUrl       string                         `protobuf:"bytes,6,opt,name=facebook_url,json=facebookUrl,proto3" json:"facebook_url,omitempty"`
+	TwitterUrl        string                         `protobuf:"bytes,7,opt,name=twitter_url,json=twitterUrl,proto3" json:"twitter_url,omitempty"`
+	LinkedinId        string                         `protobuf:"bytes,8,opt,name=linkedin_id,json=linkedinId,proto3" json:"linkedin_id,omitempty"`
+	Website           string                         `protobuf:"bytes,9,opt,name=website,proto3" json:"website,omitempty"`
+	Domain            string                         `protobuf:"bytes,10,opt,name=domain,proto3" json:"domain,omitempty"`
+	JobCompanyIdMongo string                         `protobuf:"bytes,11,opt,name=job_company_id_mongo,json=jobCompanyIdMongo,proto3" json:"job_company_id_mongo,omitempty"`
+	UniversityIdMongo string                         `protobuf:"bytes,12,opt,name=university_id_mongo,json=universityIdMongo,proto3" json:"university_id_mongo,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PeopleEducationSchool) Reset() {
+	*x = PeopleEducationSchool{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeopleEducationSchool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeopleEducationSchool) ProtoMessage() {}
+
+func (x *PeopleEducationSchool) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeopleEducationSchool.ProtoReflect.Descriptor instead.
+func (*PeopleEducationSchool) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *PeopleEducationSchool) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PeopleEducationSchool) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PeopleEducationSchool) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PeopleEducationSchool) GetLocation() *PeopleEducationSchoolLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *PeopleEducationSchool) GetLinkedinUrl() string {
+	if x != nil {
+		return x.LinkedinUrl
+	}
+	return ""
+}
+
+func (x *PeopleEducationSchool) GetFacebookUrl() string {
+	if x != nil {
+		return x.FacebookUrl
+	}
+	return ""
+}
+
+func (x *PeopleEducationSchool) GetTwitterUrl() string {
+	if x != nil {
+		return x.TwitterUrl
+	}
+	return ""
+}
+
+func (x *PeopleEducationSchool) GetLinkedinId() string {
+	if x != nil {
+		return x.LinkedinId
+	}
+	return ""
+}
+
+func (x *PeopleEducationSchool) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *PeopleEducationSchool) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *PeopleEducationSchool) GetJobCompanyIdMongo() string {
+	if x != nil {
+		return x.JobCompanyIdMongo
+	}
+	return ""
+}
+
+func (x *PeopleEducationSchool) GetUniversityIdMongo() string {
+	if x != nil {
+		return x.UniversityIdMongo
+	}
+	return ""
+}
+
+type PeopleExperienceCompany struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LinkedinUrl   string                 `protobuf:"bytes,2,opt,name=linkedin_url,json=linkedinUrl,proto3" json:"linkedin_url,omitempty"`
+	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	Size          string                 `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
+	Industry      string                 `protobuf:"bytes,5,opt,name=industry,proto3" json:"industry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeopleExperienceCompany) Reset() {
+	*x = PeopleExperienceCompany{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeopleExperienceCompany) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeopleExperienceCompany) ProtoMessage() {}
+
+func (x *PeopleExperienceCompany) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeopleExperienceCompany.ProtoReflect.Descriptor instead.
+func (*PeopleExperienceCompany) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *PeopleExperienceCompany) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PeopleExperienceCompany) GetLinkedinUrl() string {
+	if x != nil {
+		return x.LinkedinUrl
+	}
+	return ""
+}
+
+func (x *PeopleExperienceCompany) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *PeopleExperienceCompany) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *PeopleExperienceCompany) GetIndustry() string {
+	if x != nil {
+		return x.Industry
+	}
+	return ""
+}
+
+type PeopleExperienceTitle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	SubRole       string                 `protobuf:"bytes,3,opt,name=sub_role,json=subRole,proto3" json:"sub_role,omitempty"`
+	Levels        []string               `protobuf:"bytes,4,rep,name=levels,proto3" json:"levels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeopleExperienceTitle) Reset() {
+	*x = PeopleExperienceTitle{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeopleExperienceTitle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeopleExperienceTitle) ProtoMessage() {}
+
+func (x *PeopleExperienceTitle) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeopleExperienceTitle.ProtoReflect.Descriptor instead.
+func (*PeopleExperienceTitle) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *PeopleExperienceTitle) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PeopleExperienceTitle) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *PeopleExperienceTitle) GetSubRole() string {
+	if x != nil {
+		return x.SubRole
+	}
+	return ""
+}
+
+func (x *PeopleExperienceTitle) GetLevels() []string {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+type JobTitleCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	SuperCategory string                 `protobuf:"bytes,2,opt,name=super_category,json=superCategory,proto3" json:"super_category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobTitleCategory) Reset() {
+	*x = JobTitleCategory{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobTitleCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobTitleCategory) ProtoMessage() {}
+
+func (x *JobTitleCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobTitleCategory.ProtoReflect.Descriptor instead.
+func (*JobTitleCategory) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *JobTitleCategory) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *JobTitleCategory) GetSuperCategory() string {
+	if x != nil {
+		return x.SuperCategory
+	}
+	return ""
+}
+
+type PeopleEducationSchoolLocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Locality      string                 `protobuf:"bytes,2,opt,name=locality,proto3" json:"locality,omitempty"`
+	Region        string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	Country       string                 `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	Continent     string                 `protobuf:"bytes,5,opt,name=continent,proto3" json:"continent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeopleEducationSchoolLocation) Reset() {
+	*x = PeopleEducationSchoolLocation{}
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeopleEducationSchoolLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeopleEducationSchoolLocation) ProtoMessage() {}
+
+func (x *PeopleEducationSchoolLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_cufinder_v1_cufinder_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeopleEducationSchoolLocation.ProtoReflect.Descriptor instead.
+func (*PeopleEducationSchoolLocation) Descriptor() ([]byte, []int) {
+	return file_cufinder_v1_cufinder_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *PeopleEducationSchoolLocation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PeopleEducationSchoolLocation) GetLocality() string {
+	if x != nil {
+		return x.Locality
+	}
+	return ""
+}
+
+func (x *PeopleEducationSchoolLocation) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *PeopleEducationSchoolLocation) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *PeopleEducationSchoolLocation) GetContinent() string {
+	if x != nil {
+		return x.Continent
+	}
+	return ""
+}
+
+var File_cufinder_v1_cufinder_proto protoreflect.FileDescriptor
+
+const file_cufinder_v1_cufinder_proto_rawDesc = "" +
+	"\n" +
+	"\x1acufinder/v1/cufinder.proto\x12\vcufinder.v1\x1a\x1cgoogle/protobuf/struct.proto\"Q\n" +
+	"\tCufParams\x12!\n" +
+	"\fcompany_name\x18\x01 \x01(\tR\vcompanyName\x12!\n" +
+	"\fcountry_code\x18\x02 \x01(\tR\vcountryCode\"\xd7\x01\n" +
+	"\vCufResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12\x16\n" +
+	"\x06domain\x18\x05 \x01(\tR\x06domain\"/\n" +
+	"\n" +
+	"LcufParams\x12!\n" +
+	"\fcompany_name\x18\x01 \x01(\tR\vcompanyName\"\xe3\x01\n" +
+	"\fLcufResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12!\n" +
+	"\flinkedin_url\x18\x05 \x01(\tR\vlinkedinUrl\"4\n" +
+	"\tDtcParams\x12'\n" +
+	"\x0fcompany_website\x18\x01 \x01(\tR\x0ecompanyWebsite\"\xe2\x01\n" +
+	"\vDtcResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12!\n" +
+	"\fcompany_name\x18\x05 \x01(\tR\vcompanyName\"4\n" +
+	"\tDteParams\x12'\n" +
+	"\x0fcompany_website\x18\x01 \x01(\tR\x0ecompanyWebsite\"\xd7\x01\n" +
+	"\vDteResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12\x16\n" +
+	"\x06emails\x18\x05 \x03(\tR\x06emails\".\n" +
+	"\tNtpParams\x12!\n" +
+	"\fcompany_name\x18\x01 \x01(\tR\vcompanyName\"\xd7\x01\n" +
+	"\vNtpResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12\x16\n" +
+	"\x06phones\x18\x05 \x03(\tR\x06phones\".\n" +
+	"\tEppParams\x12!\n" +
+	"\flinkedin_url\x18\x01 \x01(\tR\vlinkedinUrl\"\xef\x01\n" +
+	"\vEppResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12.\n" +
+	"\x06person\x18\x05 \x01(\v2\x16.cufinder.v1.EppPersonR\x06person\"!\n" +
+	"\tRelParams\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"\xef\x01\n" +
+	"\vRelResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12.\n" +
+	"\x06person\x18\x05 \x01(\v2\x16.cufinder.v1.RelPersonR\x06person\".\n" +
+	"\tFweParams\x12!\n" +
+	"\flinkedin_url\x18\x01 \x01(\tR\vlinkedinUrl\"\xde\x01\n" +
+	"\vFweResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12\x1d\n" +
+	"\n" +
+	"work_email\x18\x05 \x01(\tR\tworkEmail\"B\n" +
+	"\tTepParams\x12\x1b\n" +
+	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12\x18\n" +
+	"\acompany\x18\x02 \x01(\tR\acompany\"\xef\x01\n" +
+	"\vTepResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12.\n" +
+	"\x06person\x18\x05 \x01(\v2\x16.cufinder.v1.TepPersonR\x06person\"!\n" +
+	"\tFclParams\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"\xf6\x01\n" +
+	"\vFclResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x125\n" +
+	"\tcompanies\x18\x05 \x03(\v2\x17.cufinder.v1.FclCompanyR\tcompanies\"!\n" +
+	"\tElfParams\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"\x87\x02\n" +
+	"\vElfResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12F\n" +
+	"\x10fundraising_info\x18\x05 \x01(\v2\x1b.cufinder.v1.ElfFundraisingR\x0ffundraisingInfo\"!\n" +
+	"\tCarParams\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"\xe6\x01\n" +
+	"\vCarResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12%\n" +
+	"\x0eannual_revenue\x18\x05 \x01(\tR\rannualRevenue\"!\n" +
+	"\tFccParams\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"\xe3\x01\n" +
+	"\vFccResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12\"\n" +
+	"\fsubsidiaries\x18\x05 \x03(\tR\fsubsidiaries\"!\n" +
+	"\tFtsParams\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"\xe3\x01\n" +
+	"\vFtsResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12\"\n" +
+	"\ftechnologies\x18\x05 \x03(\tR\ftechnologies\"!\n" +
+	"\tEncParams\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"\xf2\x01\n" +
+	"\vEncResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x121\n" +
+	"\acompany\x18\x05 \x01(\v2\x17.cufinder.v1.EncCompanyR\acompany\"!\n" +
+	"\tCecParams\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"\xf5\x01\n" +
+	"\vCecResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x124\n" +
+	"\tcountries\x18\x05 \x01(\v2\x16.google.protobuf.ValueR\tcountries\"!\n" +
+	"\tCloParams\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"\xf7\x01\n" +
+	"\vCloResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x126\n" +
+	"\tlocations\x18\x05 \x03(\v2\x18.cufinder.v1.CloLocationR\tlocations\"\xee\x06\n" +
+	"\tCseParams\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\acountry\x18\x02 \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x12\n" +
+	"\x04city\x18\x04 \x01(\tR\x04city\x123\n" +
+	"\x13followers_count_min\x18\x05 \x01(\x03H\x00R\x11followersCountMin\x88\x01\x01\x123\n" +
+	"\x13followers_count_max\x18\x06 \x01(\x03H\x01R\x11followersCountMax\x88\x01\x01\x12\x1a\n" +
+	"\bindustry\x18\a \x01(\tR\bindustry\x12#\n" +
+	"\remployee_size\x18\b \x01(\tR\femployeeSize\x121\n" +
+	"\x12founded_after_year\x18\t \x01(\x03H\x02R\x10foundedAfterYear\x88\x01\x01\x123\n" +
+	"\x13founded_before_year\x18\n" +
+	" \x01(\x03H\x03R\x11foundedBeforeYear\x88\x01\x01\x121\n" +
+	"\x12funding_amount_max\x18\v \x01(\x03H\x04R\x10fundingAmountMax\x88\x01\x01\x121\n" +
+	"\x12funding_amount_min\x18\f \x01(\x03H\x05R\x10fundingAmountMin\x88\x01\x01\x12+\n" +
+	"\x11products_services\x18\r \x03(\tR\x10productsServices\x12 \n" +
+	"\tis_school\x18\x0e \x01(\bH\x06R\bisSchool\x88\x01\x01\x121\n" +
+	"\x12annual_revenue_min\x18\x0f \x01(\x03H\aR\x10annualRevenueMin\x88\x01\x01\x121\n" +
+	"\x12annual_revenue_max\x18\x10 \x01(\x03H\bR\x10annualRevenueMax\x88\x01\x01\x12\x12\n" +
+	"\x04page\x18\x11 \x01(\x03R\x04pageB\x16\n" +
+	"\x14_followers_count_minB\x16\n" +
+	"\x14_followers_count_maxB\x15\n" +
+	"\x13_founded_after_yearB\x16\n" +
+	"\x14_founded_before_yearB\x15\n" +
+	"\x13_funding_amount_maxB\x15\n" +
+	"\x13_funding_amount_minB\f\n" +
+	"\n" +
+	"_is_schoolB\x15\n" +
+	"\x13_annual_revenue_minB\x15\n" +
+	"\x13_annual_revenue_max\"\xf3\x01\n" +
+	"\vCseResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x122\n" +
+	"\tcompanies\x18\x05 \x03(\v2\x14.cufinder.v1.CompanyR\tcompanies\"\xf1\x05\n" +
+	"\tPseParams\x12\x1b\n" +
+	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12\x18\n" +
+	"\acountry\x18\x02 \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x12\n" +
+	"\x04city\x18\x04 \x01(\tR\x04city\x12$\n" +
+	"\x0ejob_title_role\x18\x05 \x01(\tR\fjobTitleRole\x12&\n" +
+	"\x0fjob_title_level\x18\x06 \x01(\tR\rjobTitleLevel\x12'\n" +
+	"\x0fcompany_country\x18\a \x01(\tR\x0ecompanyCountry\x12#\n" +
+	"\rcompany_state\x18\b \x01(\tR\fcompanyState\x12!\n" +
+	"\fcompany_city\x18\t \x01(\tR\vcompanyCity\x12!\n" +
+	"\fcompany_name\x18\n" +
+	" \x01(\tR\vcompanyName\x120\n" +
+	"\x14company_linkedin_url\x18\v \x01(\tR\x12companyLinkedinUrl\x12)\n" +
+	"\x10company_industry\x18\f \x01(\tR\x0fcompanyIndustry\x122\n" +
+	"\x15company_employee_size\x18\r \x01(\tR\x13companyEmployeeSize\x12:\n" +
+	"\x19company_products_services\x18\x0e \x03(\tR\x17companyProductsServices\x12@\n" +
+	"\x1acompany_annual_revenue_min\x18\x0f \x01(\x03H\x00R\x17companyAnnualRevenueMin\x88\x01\x01\x12@\n" +
+	"\x1acompany_annual_revenue_max\x18\x10 \x01(\x03H\x01R\x17companyAnnualRevenueMax\x88\x01\x01\x12\x12\n" +
+	"\x04page\x18\x11 \x01(\x03R\x04pageB\x1d\n" +
+	"\x1b_company_annual_revenue_minB\x1d\n" +
+	"\x1b_company_annual_revenue_max\"\xee\x01\n" +
+	"\vPseResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12-\n" +
+	"\apeoples\x18\x05 \x03(\v2\x13.cufinder.v1.PersonR\apeoples\"\x93\x01\n" +
+	"\tLbsParams\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\acountry\x18\x02 \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x12\n" +
+	"\x04city\x18\x04 \x01(\tR\x04city\x12\x1a\n" +
+	"\bindustry\x18\x05 \x01(\tR\bindustry\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x03R\x04page\"\xf3\x01\n" +
+	"\vLbsResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x122\n" +
+	"\tcompanies\x18\x05 \x03(\v2\x14.cufinder.v1.CompanyR\tcompanies\"\x1d\n" +
+	"\tBcdParams\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\xdd\x01\n" +
+	"\vBcdResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12\x1c\n" +
+	"\tcustomers\x18\x05 \x03(\tR\tcustomers\"\x1d\n" +
+	"\tCcpParams\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\xe9\x01\n" +
+	"\vCcpResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12(\n" +
+	"\x10careers_page_url\x18\x05 \x01(\tR\x0ecareersPageUrl\"\x1d\n" +
+	"\tIscParams\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\xd8\x01\n" +
+	"\vIscResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12\x17\n" +
+	"\ais_saas\x18\x05 \x01(\tR\x06isSaas\"\x1d\n" +
+	"\tCbcParams\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\xe4\x01\n" +
+	"\vCbcResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12#\n" +
+	"\rbusiness_type\x18\x05 \x01(\tR\fbusinessType\"\x1d\n" +
+	"\tCscParams\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\xec\x01\n" +
+	"\vCscResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12+\n" +
+	"\x11mission_statement\x18\x05 \x01(\tR\x10missionStatement\"\x1d\n" +
+	"\tCsnParams\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\x88\x02\n" +
+	"\vCsnResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12G\n" +
+	"\x10company_snapshot\x18\x05 \x01(\v2\x1c.cufinder.v1.CsnSnapshotInfoR\x0fcompanySnapshot\"!\n" +
+	"\tNaoParams\x12\x14\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\"\xd5\x01\n" +
+	"\vNaoResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12\x14\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\"%\n" +
+	"\tNaaParams\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\"\xd9\x01\n" +
+	"\vNaaResponse\x12,\n" +
+	"\x05query\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05query\x12!\n" +
+	"\fcredit_count\x18\x02 \x01(\x03R\vcreditCount\x124\n" +
+	"\tmeta_data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetaData\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x03R\x0fconfidenceLevel\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\"r\n" +
+	"\x0eEncBatchResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\rR\x05index\x124\n" +
+	"\bresponse\x18\x02 \x01(\v2\x18.cufinder.v1.EncResponseR\bresponse\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"r\n" +
+	"\x0eTepBatchResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\rR\x05index\x124\n" +
+	"\bresponse\x18\x02 \x01(\v2\x18.cufinder.v1.TepResponseR\bresponse\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"r\n" +
+	"\x0eEppBatchResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\rR\x05index\x124\n" +
+	"\bresponse\x18\x02 \x01(\v2\x18.cufinder.v1.EppResponseR\bresponse\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"^\n" +
+	"\x0fCsePagesRequest\x12.\n" +
+	"\x06params\x18\x01 \x01(\v2\x16.cufinder.v1.CseParamsR\x06params\x12\x1b\n" +
+	"\tmax_pages\x18\x02 \x01(\rR\bmaxPages\"^\n" +
+	"\x0fPsePagesRequest\x12.\n" +
+	"\x06params\x18\x01 \x01(\v2\x16.cufinder.v1.PseParamsR\x06params\x12\x1b\n" +
+	"\tmax_pages\x18\x02 \x01(\rR\bmaxPages\"^\n" +
+	"\x0fLbsPagesRequest\x12.\n" +
+	"\x06params\x18\x01 \x01(\v2\x16.cufinder.v1.LbsParamsR\x06params\x12\x1b\n" +
+	"\tmax_pages\x18\x02 \x01(\rR\bmaxPages\"\xbb\x06\n" +
+	"\tEppPerson\x12\x1b\n" +
+	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\x12!\n" +
+	"\flinkedin_url\x18\x04 \x01(\tR\vlinkedinUrl\x12\x18\n" +
+	"\asummary\x18\x05 \x01(\tR\asummary\x12-\n" +
+	"\x12linkedin_followers\x18\x06 \x01(\x03R\x11linkedinFollowers\x12\x1a\n" +
+	"\bfacebook\x18\a \x01(\tR\bfacebook\x12\x18\n" +
+	"\atwitter\x18\b \x01(\tR\atwitter\x12\x16\n" +
+	"\x06avatar\x18\t \x01(\tR\x06avatar\x12\x18\n" +
+	"\acountry\x18\n" +
+	" \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\v \x01(\tR\x05state\x12\x12\n" +
+	"\x04city\x18\f \x01(\tR\x04city\x12\x1b\n" +
+	"\tjob_title\x18\r \x01(\tR\bjobTitle\x120\n" +
+	"\x14job_title_categories\x18\x0e \x03(\tR\x12jobTitleCategories\x12!\n" +
+	"\fcompany_name\x18\x0f \x01(\tR\vcompanyName\x12)\n" +
+	"\x10company_linkedin\x18\x10 \x01(\tR\x0fcompanyLinkedin\x12'\n" +
+	"\x0fcompany_website\x18\x11 \x01(\tR\x0ecompanyWebsite\x12!\n" +
+	"\fcompany_size\x18\x12 \x01(\tR\vcompanySize\x12)\n" +
+	"\x10company_industry\x18\x13 \x01(\tR\x0fcompanyIndustry\x12)\n" +
+	"\x10company_facebook\x18\x14 \x01(\tR\x0fcompanyFacebook\x12'\n" +
+	"\x0fcompany_twitter\x18\x15 \x01(\tR\x0ecompanyTwitter\x12'\n" +
+	"\x0fcompany_country\x18\x16 \x01(\tR\x0ecompanyCountry\x12#\n" +
+	"\rcompany_state\x18\x17 \x01(\tR\fcompanyState\x12!\n" +
+	"\fcompany_city\x18\x18 \x01(\tR\vcompanyCity\"\xbb\x06\n" +
+	"\tRelPerson\x12\x1b\n" +
+	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\x12!\n" +
+	"\flinkedin_url\x18\x04 \x01(\tR\vlinkedinUrl\x12\x18\n" +
+	"\asummary\x18\x05 \x01(\tR\asummary\x12-\n" +
+	"\x12linkedin_followers\x18\x06 \x01(\tR\x11linkedinFollowers\x12\x1a\n" +
+	"\bfacebook\x18\a \x01(\tR\bfacebook\x12\x18\n" +
+	"\atwitter\x18\b \x01(\tR\atwitter\x12\x16\n" +
+	"\x06avatar\x18\t \x01(\tR\x06avatar\x12\x18\n" +
+	"\acountry\x18\n" +
+	" \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\v \x01(\tR\x05state\x12\x12\n" +
+	"\x04city\x18\f \x01(\tR\x04city\x12\x1b\n" +
+	"\tjob_title\x18\r \x01(\tR\bjobTitle\x120\n" +
+	"\x14job_title_categories\x18\x0e \x03(\tR\x12jobTitleCategories\x12!\n" +
+	"\fcompany_name\x18\x0f \x01(\tR\vcompanyName\x12)\n" +
+	"\x10company_linkedin\x18\x10 \x01(\tR\x0fcompanyLinkedin\x12'\n" +
+	"\x0fcompany_website\x18\x11 \x01(\tR\x0ecompanyWebsite\x12!\n" +
+	"\fcompany_size\x18\x12 \x01(\tR\vcompanySize\x12)\n" +
+	"\x10company_industry\x18\x13 \x01(\tR\x0fcompanyIndustry\x12)\n" +
+	"\x10company_facebook\x18\x14 \x01(\tR\x0fcompanyFacebook\x12'\n" +
+	"\x0fcompany_twitter\x18\x15 \x01(\tR\x0ecompanyTwitter\x12'\n" +
+	"\x0fcompany_country\x18\x16 \x01(\tR\x0ecompanyCountry\x12#\n" +
+	"\rcompany_state\x18\x17 \x01(\tR\fcompanyState\x12!\n" +
+	"\fcompany_city\x18\x18 \x01(\tR\vcompanyCity\"\xe7\x06\n" +
+	"\tTepPerson\x12\x1b\n" +
+	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\x12!\n" +
+	"\flinkedin_url\x18\x04 \x01(\tR\vlinkedinUrl\x12\x18\n" +
+	"\asummary\x18\x05 \x01(\tR\asummary\x12-\n" +
+	"\x12linkedin_followers\x18\x06 \x01(\x03R\x11linkedinFollowers\x12\x1a\n" +
+	"\bfacebook\x18\a \x01(\tR\bfacebook\x12\x18\n" +
+	"\atwitter\x18\b \x01(\tR\atwitter\x12\x16\n" +
+	"\x06avatar\x18\t \x01(\tR\x06avatar\x12\x18\n" +
+	"\acountry\x18\n" +
+	" \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\v \x01(\tR\x05state\x12\x12\n" +
+	"\x04city\x18\f \x01(\tR\x04city\x12\x1b\n" +
+	"\tjob_title\x18\r \x01(\tR\bjobTitle\x120\n" +
+	"\x14job_title_categories\x18\x0e \x03(\tR\x12jobTitleCategories\x12!\n" +
+	"\fcompany_name\x18\x0f \x01(\tR\vcompanyName\x12)\n" +
+	"\x10company_linkedin\x18\x10 \x01(\tR\x0fcompanyLinkedin\x12'\n" +
+	"\x0fcompany_website\x18\x11 \x01(\tR\x0ecompanyWebsite\x12!\n" +
+	"\fcompany_size\x18\x12 \x01(\tR\vcompanySize\x12)\n" +
+	"\x10company_industry\x18\x13 \x01(\tR\x0fcompanyIndustry\x12)\n" +
+	"\x10company_facebook\x18\x14 \x01(\tR\x0fcompanyFacebook\x12'\n" +
+	"\x0fcompany_twitter\x18\x15 \x01(\tR\x0ecompanyTwitter\x12'\n" +
+	"\x0fcompany_country\x18\x16 \x01(\tR\x0ecompanyCountry\x12#\n" +
+	"\rcompany_state\x18\x17 \x01(\tR\fcompanyState\x12!\n" +
+	"\fcompany_city\x18\x18 \x01(\tR\vcompanyCity\x12\x14\n" +
+	"\x05email\x18\x19 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x1a \x01(\tR\x05phone\"\xb3\x03\n" +
+	"\n" +
+	"FclCompany\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\awebsite\x18\x02 \x01(\tR\awebsite\x12%\n" +
+	"\x0eemployee_count\x18\x03 \x01(\x03R\remployeeCount\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\tR\x04size\x12\x1a\n" +
+	"\bindustry\x18\x05 \x01(\tR\bindustry\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12!\n" +
+	"\flinkedin_url\x18\a \x01(\tR\vlinkedinUrl\x12\x16\n" +
+	"\x06domain\x18\b \x01(\tR\x06domain\x12\x18\n" +
+	"\acountry\x18\t \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\n" +
+	" \x01(\tR\x05state\x12\x12\n" +
+	"\x04city\x18\v \x01(\tR\x04city\x12\x18\n" +
+	"\aaddress\x18\f \x01(\tR\aaddress\x12!\n" +
+	"\ffounded_year\x18\r \x01(\tR\vfoundedYear\x12\x19\n" +
+	"\blogo_url\x18\x0e \x01(\tR\alogoUrl\x12'\n" +
+	"\x0ffollowers_count\x18\x0f \x01(\x03R\x0efollowersCount\"\x84\x02\n" +
+	"\x0eElfFundraising\x125\n" +
+	"\x17funding_last_round_type\x18\x01 \x01(\tR\x14fundingLastRoundType\x12A\n" +
+	"\x1dfunding_ammount_currency_code\x18\x02 \x01(\tR\x1afundingAmmountCurrencyCode\x120\n" +
+	"\x14funding_money_raised\x18\x03 \x01(\tR\x12fundingMoneyRaised\x12F\n" +
+	" funding_last_round_investors_url\x18\x04 \x01(\tR\x1cfundingLastRoundInvestorsUrl\"\xc7\x03\n" +
+	"\n" +
+	"EncCompany\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\awebsite\x18\x02 \x01(\tR\awebsite\x12%\n" +
+	"\x0eemployee_count\x18\x03 \x01(\x03R\remployeeCount\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\tR\x04size\x12\x1a\n" +
+	"\bindustry\x18\x05 \x01(\tR\bindustry\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12!\n" +
+	"\flinkedin_url\x18\a \x01(\tR\vlinkedinUrl\x12\x12\n" +
+	"\x04type\x18\b \x01(\tR\x04type\x12\x16\n" +
+	"\x06domain\x18\t \x01(\tR\x06domain\x12\x18\n" +
+	"\acountry\x18\n" +
+	" \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\v \x01(\tR\x05state\x12\x12\n" +
+	"\x04city\x18\f \x01(\tR\x04city\x12\x18\n" +
+	"\aaddress\x18\r \x01(\tR\aaddress\x12!\n" +
+	"\ffounded_year\x18\x0e \x01(\tR\vfoundedYear\x12\x19\n" +
+	"\blogo_url\x18\x0f \x01(\tR\alogoUrl\x12'\n" +
+	"\x0ffollowers_count\x18\x10 \x01(\x03R\x0efollowersCount\"\xd8\x01\n" +
+	"\vCloLocation\x12\x18\n" +
+	"\acountry\x18\x01 \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x1f\n" +
+	"\vpostal_code\x18\x04 \x01(\tR\n" +
+	"postalCode\x12\x14\n" +
+	"\x05line1\x18\x05 \x01(\tR\x05line1\x12\x14\n" +
+	"\x05line2\x18\x06 \x01(\tR\x05line2\x12\x1a\n" +
+	"\blatitude\x18\a \x01(\tR\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\b \x01(\tR\tlongitude\"\x86\x06\n" +
+	"\aCompany\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06domain\x18\x02 \x01(\tR\x06domain\x12!\n" +
+	"\flinkedin_url\x18\x03 \x01(\tR\vlinkedinUrl\x12\x1a\n" +
+	"\bindustry\x18\x04 \x01(\tR\bindustry\x12\x1a\n" +
+	"\boverview\x18\x05 \x01(\tR\boverview\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12\x12\n" +
+	"\x04size\x18\a \x01(\tR\x04size\x12>\n" +
+	"\rmain_location\x18\b \x01(\v2\x19.cufinder.v1.MainLocationR\fmainLocation\x12\x1a\n" +
+	"\blocation\x18\t \x01(\tR\blocation\x12 \n" +
+	"\vdescription\x18\n" +
+	" \x01(\tR\vdescription\x12\x18\n" +
+	"\afounded\x18\v \x01(\x03R\afounded\x12\x18\n" +
+	"\arevenue\x18\f \x01(\tR\arevenue\x12;\n" +
+	"\temployees\x18\r \x01(\v2\x1d.cufinder.v1.CompanyEmployeesR\temployees\x12\x18\n" +
+	"\awebsite\x18\x0e \x01(\tR\awebsite\x12\x14\n" +
+	"\x05phone\x18\x0f \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x10 \x01(\tR\x05email\x122\n" +
+	"\x06social\x18\x11 \x01(\v2\x1a.cufinder.v1.CompanySocialR\x06social\x12\"\n" +
+	"\ftechnologies\x18\x12 \x03(\tR\ftechnologies\x12\"\n" +
+	"\fsubsidiaries\x18\x13 \x03(\tR\fsubsidiaries\x12\"\n" +
+	"\fheadquarters\x18\x14 \x01(\tR\fheadquarters\x12\x18\n" +
+	"\acountry\x18\x15 \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\x16 \x01(\tR\x05state\x12\x12\n" +
+	"\x04city\x18\x17 \x01(\tR\x04city\x12\x19\n" +
+	"\bzip_code\x18\x18 \x01(\tR\azipCode\x12\x18\n" +
+	"\aaddress\x18\x19 \x01(\tR\aaddress\"\xe6\x05\n" +
+	"\x06Person\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x01 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x02 \x01(\tR\blastName\x12\x1b\n" +
+	"\tfull_name\x18\x03 \x01(\tR\bfullName\x12\x12\n" +
+	"\x04logo\x18\x04 \x01(\tR\x04logo\x12\x1a\n" +
+	"\boverview\x18\x05 \x01(\tR\boverview\x126\n" +
+	"\n" +
+	"experience\x18\x06 \x01(\v2\x16.google.protobuf.ValueR\n" +
+	"experience\x12@\n" +
+	"\vconnections\x18\a \x01(\v2\x1e.cufinder.v1.PeopleConnectionsR\vconnections\x12\x1c\n" +
+	"\tinterests\x18\b \x03(\tR\tinterests\x12\x16\n" +
+	"\x06skills\x18\t \x03(\tR\x06skills\x12<\n" +
+	"\n" +
+	"educations\x18\n" +
+	" \x03(\v2\x1c.cufinder.v1.PeopleEducationR\n" +
+	"educations\x12?\n" +
+	"\vexperiences\x18\v \x03(\v2\x1d.cufinder.v1.PeopleExperienceR\vexperiences\x12H\n" +
+	"\x0ecertifications\x18\f \x03(\v2 .cufinder.v1.PeopleCertificationR\x0ecertifications\x12.\n" +
+	"\acompany\x18\r \x01(\v2\x14.cufinder.v1.CompanyR\acompany\x127\n" +
+	"\blocation\x18\x0e \x01(\v2\x1b.cufinder.v1.PeopleLocationR\blocation\x12>\n" +
+	"\vcurrent_job\x18\x0f \x01(\v2\x1d.cufinder.v1.PeopleCurrentJobR\n" +
+	"currentJob\x121\n" +
+	"\x06social\x18\x10 \x01(\v2\x19.cufinder.v1.PeopleSocialR\x06social\"\xa6\x01\n" +
+	"\x0fCsnSnapshotInfo\x12\x10\n" +
+	"\x03icp\x18\x01 \x01(\tR\x03icp\x12+\n" +
+	"\x11target_industries\x18\x02 \x03(\tR\x10targetIndustries\x12'\n" +
+	"\x0ftarget_personas\x18\x03 \x03(\tR\x0etargetPersonas\x12+\n" +
+	"\x11value_proposition\x18\x04 \x01(\tR\x10valueProposition\"\xbd\x01\n" +
+	"\fMainLocation\x12\x10\n" +
+	"\x03geo\x18\x01 \x01(\tR\x03geo\x12\x18\n" +
+	"\acountry\x18\x02 \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x12\n" +
+	"\x04city\x18\x04 \x01(\tR\x04city\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x1c\n" +
+	"\tcontinent\x18\x06 \x01(\tR\tcontinent\x12\x1f\n" +
+	"\vpostal_code\x18\a \x01(\tR\n" +
+	"postalCode\">\n" +
+	"\x10CompanyEmployees\x12\x14\n" +
+	"\x05range\x18\x01 \x01(\tR\x05range\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\x99\x01\n" +
+	"\rCompanySocial\x12\x1a\n" +
+	"\bfacebook\x18\x01 \x01(\tR\bfacebook\x12\x1a\n" +
+	"\blinkedin\x18\x02 \x01(\tR\blinkedin\x12\x18\n" +
+	"\atwitter\x18\x03 \x01(\tR\atwitter\x12\x18\n" +
+	"\ayoutube\x18\x04 \x01(\tR\ayoutube\x12\x1c\n" +
+	"\tinstagram\x18\x05 \x01(\tR\tinstagram\"\xac\x02\n" +
+	"\x11PeopleConnections\x12$\n" +
+	"\x0ehas_work_email\x18\x01 \x01(\bR\fhasWorkEmail\x12,\n" +
+	"\x12has_personal_email\x18\x02 \x01(\bR\x10hasPersonalEmail\x12\x1b\n" +
+	"\thas_phone\x18\x03 \x01(\bR\bhasPhone\x12\x1d\n" +
+	"\n" +
+	"work_email\x18\x04 \x01(\tR\tworkEmail\x12%\n" +
+	"\x0epersonal_email\x18\x05 \x01(\tR\rpersonalEmail\x12\x14\n" +
+	"\x05phone\x18\x06 \x01(\tR\x05phone\x12\"\n" +
+	"\ris_accept_all\x18\a \x01(\bR\visAcceptAll\x12&\n" +
+	"\x0fis_accept_email\x18\b \x01(\bR\risAcceptEmail\"\xfd\x01\n" +
+	"\x0fPeopleEducation\x12:\n" +
+	"\x06school\x18\x01 \x01(\v2\".cufinder.v1.PeopleEducationSchoolR\x06school\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x10\n" +
+	"\x03gpa\x18\x04 \x01(\tR\x03gpa\x12\x18\n" +
+	"\adegrees\x18\x05 \x03(\tR\adegrees\x12\x16\n" +
+	"\x06majors\x18\x06 \x03(\tR\x06majors\x12\x16\n" +
+	"\x06minors\x18\a \x03(\tR\x06minors\x12\x18\n" +
+	"\asummary\x18\b \x01(\tR\asummary\"\xa6\x02\n" +
+	"\x10PeopleExperience\x12>\n" +
+	"\acompany\x18\x01 \x01(\v2$.cufinder.v1.PeopleExperienceCompanyR\aCompany\x12%\n" +
+	"\x0elocation_names\x18\x02 \x03(\tR\rLocationNames\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aEndDate\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tStartDate\x128\n" +
+	"\x05title\x18\x05 \x01(\v2\".cufinder.v1.PeopleExperienceTitleR\x05Title\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x06 \x01(\bR\tIsPrimary\x12\x18\n" +
+	"\asummary\x18\a \x01(\tR\aSummary\"\x9b\x01\n" +
+	"\x13PeopleCertification\x12$\n" +
+	"\rcertification\x18\x01 \x01(\tR\rcertification\x12\x16\n" +
+	"\x06issuer\x18\x02 \x01(\tR\x06issuer\x12\x1d\n" +
+	"\n" +
+	"issue_date\x18\x03 \x01(\tR\tissueDate\x12'\n" +
+	"\x0fexpiration_date\x18\x04 \x01(\tR\x0eexpirationDate\"T\n" +
+	"\x0ePeopleLocation\x12\x18\n" +
+	"\acountry\x18\x01 \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\"\x91\x01\n" +
+	"\x10PeopleCurrentJob\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\tR\x05level\x12=\n" +
+	"\n" +
+	"categories\x18\x04 \x03(\v2\x1d.cufinder.v1.JobTitleCategoryR\n" +
+	"categories\"\xd8\x01\n" +
+	"\fPeopleSocial\x12+\n" +
+	"\x11linkedin_username\x18\x01 \x01(\tR\x10linkedinUsername\x121\n" +
+	"\x14linkedin_connections\x18\x02 \x01(\x03R\x13linkedinConnections\x12\x1a\n" +
+	"\blinkedin\x18\x03 \x01(\tR\blinkedin\x12\x18\n" +
+	"\atwitter\x18\x04 \x01(\tR\atwitter\x12\x1a\n" +
+	"\bfacebook\x18\x05 \x01(\tR\bfacebook\x12\x16\n" +
+	"\x06github\x18\x06 \x01(\tR\x06github\"\xb2\x03\n" +
+	"\x15PeopleEducationSchool\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\x12F\n" +
+	"\blocation\x18\x04 \x01(\v2*.cufinder.v1.PeopleEducationSchoolLocationR\blocation\x12!\n" +
+	"\flinkedin_url\x18\x05 \x01(\tR\vlinkedinUrl\x12!\n" +
+	"\ffacebook_url\x18\x06 \x01(\tR\vfacebookUrl\x12\x1f\n" +
+	"\vtwitter_url\x18\a \x01(\tR\n" +
+	"twitterUrl\x12\x1f\n" +
+	"\vlinkedin_id\x18\b \x01(\tR\n" +
+	"linkedinId\x12\x18\n" +
+	"\awebsite\x18\t \x01(\tR\awebsite\x12\x16\n" +
+	"\x06domain\x18\n" +
+	" \x01(\tR\x06domain\x12/\n" +
+	"\x14job_company_id_mongo\x18\v \x01(\tR\x11jobCompanyIdMongo\x12.\n" +
+	"\x13university_id_mongo\x18\f \x01(\tR\x11universityIdMongo\"\x98\x01\n" +
+	"\x17PeopleExperienceCompany\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\flinkedin_url\x18\x02 \x01(\tR\vlinkedinUrl\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\tR\x04size\x12\x1a\n" +
+	"\bindustry\x18\x05 \x01(\tR\bindustry\"r\n" +
+	"\x15PeopleExperienceTitle\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x19\n" +
+	"\bsub_role\x18\x03 \x01(\tR\asubRole\x12\x16\n" +
+	"\x06levels\x18\x04 \x03(\tR\x06levels\"U\n" +
+	"\x10JobTitleCategory\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12%\n" +
+	"\x0esuper_category\x18\x02 \x01(\tR\rsuperCategory\"\x9f\x01\n" +
+	"\x1dPeopleEducationSchoolLocation\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\blocality\x18\x02 \x01(\tR\blocality\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12\x18\n" +
+	"\acountry\x18\x04 \x01(\tR\acountry\x12\x1c\n" +
+	"\tcontinent\x18\x05 \x01(\tR\tcontinent2\xad\x10\n" +
+	"\bCufinder\x127\n" +
+	"\x03Cuf\x12\x16.cufinder.v1.CufParams\x1a\x18.cufinder.v1.CufResponse\x12:\n" +
+	"\x04Lcuf\x12\x17.cufinder.v1.LcufParams\x1a\x19.cufinder.v1.LcufResponse\x127\n" +
+	"\x03Dtc\x12\x16.cufinder.v1.DtcParams\x1a\x18.cufinder.v1.DtcResponse\x127\n" +
+	"\x03Dte\x12\x16.cufinder.v1.DteParams\x1a\x18.cufinder.v1.DteResponse\x127\n" +
+	"\x03Ntp\x12\x16.cufinder.v1.NtpParams\x1a\x18.cufinder.v1.NtpResponse\x127\n" +
+	"\x03Epp\x12\x16.cufinder.v1.EppParams\x1a\x18.cufinder.v1.EppResponse\x127\n" +
+	"\x03Rel\x12\x16.cufinder.v1.RelParams\x1a\x18.cufinder.v1.RelResponse\x127\n" +
+	"\x03Fwe\x12\x16.cufinder.v1.FweParams\x1a\x18.cufinder.v1.FweResponse\x127\n" +
+	"\x03Tep\x12\x16.cufinder.v1.TepParams\x1a\x18.cufinder.v1.TepResponse\x127\n" +
+	"\x03Fcl\x12\x16.cufinder.v1.FclParams\x1a\x18.cufinder.v1.FclResponse\x127\n" +
+	"\x03Elf\x12\x16.cufinder.v1.ElfParams\x1a\x18.cufinder.v1.ElfResponse\x127\n" +
+	"\x03Car\x12\x16.cufinder.v1.CarParams\x1a\x18.cufinder.v1.CarResponse\x127\n" +
+	"\x03Fcc\x12\x16.cufinder.v1.FccParams\x1a\x18.cufinder.v1.FccResponse\x127\n" +
+	"\x03Fts\x12\x16.cufinder.v1.FtsParams\x1a\x18.cufinder.v1.FtsResponse\x127\n" +
+	"\x03Enc\x12\x16.cufinder.v1.EncParams\x1a\x18.cufinder.v1.EncResponse\x127\n" +
+	"\x03Cec\x12\x16.cufinder.v1.CecParams\x1a\x18.cufinder.v1.CecResponse\x127\n" +
+	"\x03Clo\x12\x16.cufinder.v1.CloParams\x1a\x18.cufinder.v1.CloResponse\x127\n" +
+	"\x03Cse\x12\x16.cufinder.v1.CseParams\x1a\x18.cufinder.v1.CseResponse\x127\n" +
+	"\x03Pse\x12\x16.cufinder.v1.PseParams\x1a\x18.cufinder.v1.PseResponse\x127\n" +
+	"\x03Lbs\x12\x16.cufinder.v1.LbsParams\x1a\x18.cufinder.v1.LbsResponse\x127\n" +
+	"\x03Bcd\x12\x16.cufinder.v1.BcdParams\x1a\x18.cufinder.v1.BcdResponse\x127\n" +
+	"\x03Ccp\x12\x16.cufinder.v1.CcpParams\x1a\x18.cufinder.v1.CcpResponse\x127\n" +
+	"\x03Isc\x12\x16.cufinder.v1.IscParams\x1a\x18.cufinder.v1.IscResponse\x127\n" +
+	"\x03Cbc\x12\x16.cufinder.v1.CbcParams\x1a\x18.cufinder.v1.CbcResponse\x127\n" +
+	"\x03Csc\x12\x16.cufinder.v1.CscParams\x1a\x18.cufinder.v1.CscResponse\x127\n" +
+	"\x03Csn\x12\x16.cufinder.v1.CsnParams\x1a\x18.cufinder.v1.CsnResponse\x127\n" +
+	"\x03Nao\x12\x16.cufinder.v1.NaoParams\x1a\x18.cufinder.v1.NaoResponse\x127\n" +
+	"\x03Naa\x12\x16.cufinder.v1.NaaParams\x1a\x18.cufinder.v1.NaaResponse\x12O\n" +
+	"\x14BatchEnrichCompanies\x12\x16.cufinder.v1.EncParams\x1a\x1b.cufinder.v1.EncBatchResult(\x010\x01\x12L\n" +
+	"\x11BatchEnrichPeople\x12\x16.cufinder.v1.TepParams\x1a\x1b.cufinder.v1.TepBatchResult(\x010\x01\x12N\n" +
+	"\x13BatchEnrichProfiles\x12\x16.cufinder.v1.EppParams\x1a\x1b.cufinder.v1.EppBatchResult(\x010\x01\x12N\n" +
+	"\x12SearchCompanyPages\x12\x1c.cufinder.v1.CsePagesRequest\x1a\x18.cufinder.v1.CseResponse0\x01\x12M\n" +
+	"\x11SearchPeoplePages\x12\x1c.cufinder.v1.PsePagesRequest\x1a\x18.cufinder.v1.PseResponse0\x01\x12T\n" +
+	"\x18SearchLocalBusinessPages\x12\x1c.cufinder.v1.LbsPagesRequest\x1a\x18.cufinder.v1.LbsResponse0\x01B>Z<github.com/cufinder/cufinder-go/proto/cufinder/v1;cufinderv1b\x06proto3"
+
+var (
+	file_cufinder_v1_cufinder_proto_rawDescOnce sync.Once
+	file_cufinder_v1_cufinder_proto_rawDescData []byte
+)
+
+func file_cufinder_v1_cufinder_proto_rawDescGZIP() []byte {
+	file_cufinder_v1_cufinder_proto_rawDescOnce.Do(func() {
+		file_cufinder_v1_cufinder_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cufinder_v1_cufinder_proto_rawDesc), len(file_cufinder_v1_cufinder_proto_rawDesc)))
+	})
+	return file_cufinder_v1_cufinder_proto_rawDescData
+}
+
+var file_cufinder_v1_cufinder_proto_msgTypes = make([]protoimpl.MessageInfo, 87)
+var file_cufinder_v1_cufinder_proto_goTypes = []any{
+	(*CufParams)(nil),                     // 0: cufinder.v1.CufParams
+	(*CufResponse)(nil),                   // 1: cufinder.v1.CufResponse
+	(*LcufParams)(nil),                    // 2: cufinder.v1.LcufParams
+	(*LcufResponse)(nil),                  // 3: cufinder.v1.LcufResponse
+	(*DtcParams)(nil),                     // 4: cufinder.v1.DtcParams
+	(*DtcResponse)(nil),                   // 5: cufinder.v1.DtcResponse
+	(*DteParams)(nil),                     // 6: cufinder.v1.DteParams
+	(*DteResponse)(nil),                   // 7: cufinder.v1.DteResponse
+	(*NtpParams)(nil),                     // 8: cufinder.v1.NtpParams
+	(*NtpResponse)(nil),                   // 9: cufinder.v1.NtpResponse
+	(*EppParams)(nil),                     // 10: cufinder.v1.EppParams
+	(*EppResponse)(nil),                   // 11: cufinder.v1.EppResponse
+	(*RelParams)(nil),                     // 12: cufinder.v1.RelParams
+	(*RelResponse)(nil),                   // 13: cufinder.v1.RelResponse
+	(*FweParams)(nil),                     // 14: cufinder.v1.FweParams
+	(*FweResponse)(nil),                   // 15: cufinder.v1.FweResponse
+	(*TepParams)(nil),                     // 16: cufinder.v1.TepParams
+	(*TepResponse)(nil),                   // 17: cufinder.v1.TepResponse
+	(*FclParams)(nil),                     // 18: cufinder.v1.FclParams
+	(*FclResponse)(nil),                   // 19: cufinder.v1.FclResponse
+	(*ElfParams)(nil),                     // 20: cufinder.v1.ElfParams
+	(*ElfResponse)(nil),                   // 21: cufinder.v1.ElfResponse
+	(*CarParams)(nil),                     // 22: cufinder.v1.CarParams
+	(*CarResponse)(nil),                   // 23: cufinder.v1.CarResponse
+	(*FccParams)(nil),                     // 24: cufinder.v1.FccParams
+	(*FccResponse)(nil),                   // 25: cufinder.v1.FccResponse
+	(*FtsParams)(nil),                     // 26: cufinder.v1.FtsParams
+	(*FtsResponse)(nil),                   // 27: cufinder.v1.FtsResponse
+	(*EncParams)(nil),                     // 28: cufinder.v1.EncParams
+	(*EncResponse)(nil),                   // 29: cufinder.v1.EncResponse
+	(*CecParams)(nil),                     // 30: cufinder.v1.CecParams
+	(*CecResponse)(nil),                   // 31: cufinder.v1.CecResponse
+	(*CloParams)(nil),                     // 32: cufinder.v1.CloParams
+	(*CloResponse)(nil),                   // 33: cufinder.v1.CloResponse
+	(*CseParams)(nil),                     // 34: cufinder.v1.CseParams
+	(*CseResponse)(nil),                   // 35: cufinder.v1.CseResponse
+	(*PseParams)(nil),                     // 36: cufinder.v1.PseParams
+	(*PseResponse)(nil),                   // 37: cufinder.v1.PseResponse
+	(*LbsParams)(nil),                     // 38: cufinder.v1.LbsParams
+	(*LbsResponse)(nil),                   // 39: cufinder.v1.LbsResponse
+	(*BcdParams)(nil),                     // 40: cufinder.v1.BcdParams
+	(*BcdResponse)(nil),                   // 41: cufinder.v1.BcdResponse
+	(*CcpParams)(nil),                     // 42: cufinder.v1.CcpParams
+	(*CcpResponse)(nil),                   // 43: cufinder.v1.CcpResponse
+	(*IscParams)(nil),                     // 44: cufinder.v1.IscParams
+	(*IscResponse)(nil),                   // 45: cufinder.v1.IscResponse
+	(*CbcParams)(nil),                     // 46: cufinder.v1.CbcParams
+	(*CbcResponse)(nil),                   // 47: cufinder.v1.CbcResponse
+	(*CscParams)(nil),                     // 48: cufinder.v1.CscParams
+	(*CscResponse)(nil),                   // 49: cufinder.v1.CscResponse
+	(*CsnParams)(nil),                     // 50: cufinder.v1.CsnParams
+	(*CsnResponse)(nil),                   // 51: cufinder.v1.CsnResponse
+	(*NaoParams)(nil),                     // 52: cufinder.v1.NaoParams
+	(*NaoResponse)(nil),                   // 53: cufinder.v1.NaoResponse
+	(*NaaParams)(nil),                     // 54: cufinder.v1.NaaParams
+	(*NaaResponse)(nil),                   // 55: cufinder.v1.NaaResponse
+	(*EncBatchResult)(nil),                // 56: cufinder.v1.EncBatchResult
+	(*TepBatchResult)(nil),                // 57: cufinder.v1.TepBatchResult
+	(*EppBatchResult)(nil),                // 58: cufinder.v1.EppBatchResult
+	(*CsePagesRequest)(nil),               // 59: cufinder.v1.CsePagesRequest
+	(*PsePagesRequest)(nil),               // 60: cufinder.v1.PsePagesRequest
+	(*LbsPagesRequest)(nil),               // 61: cufinder.v1.LbsPagesRequest
+	(*EppPerson)(nil),                     // 62: cufinder.v1.EppPerson
+	(*RelPerson)(nil),                     // 63: cufinder.v1.RelPerson
+	(*TepPerson)(nil),                     // 64: cufinder.v1.TepPerson
+	(*FclCompany)(nil),                    // 65: cufinder.v1.FclCompany
+	(*ElfFundraising)(nil),                // 66: cufinder.v1.ElfFundraising
+	(*EncCompany)(nil),                    // 67: cufinder.v1.EncCompany
+	(*CloLocation)(nil),                   // 68: cufinder.v1.CloLocation
+	(*Company)(nil),                       // 69: cufinder.v1.Company
+	(*Person)(nil),                        // 70: cufinder.v1.Person
+	(*CsnSnapshotInfo)(nil),               // 71: cufinder.v1.CsnSnapshotInfo
+	(*MainLocation)(nil),                  // 72: cufinder.v1.MainLocation
+	(*CompanyEmployees)(nil),              // 73: cufinder.v1.CompanyEmployees
+	(*CompanySocial)(nil),                 // 74: cufinder.v1.CompanySocial
+	(*PeopleConnections)(nil),             // 75: cufinder.v1.PeopleConnections
+	(*PeopleEducation)(nil),               // 76: cufinder.v1.PeopleEducation
+	(*PeopleExperience)(nil),              // 77: cufinder.v1.PeopleExperience
+	(*PeopleCertification)(nil),           // 78: cufinder.v1.PeopleCertification
+	(*PeopleLocation)(nil),                // 79: cufinder.v1.PeopleLocation
+	(*PeopleCurrentJob)(nil),              // 80: cufinder.v1.PeopleCurrentJob
+	(*PeopleSocial)(nil),                  // 81: cufinder.v1.PeopleSocial
+	(*PeopleEducationSchool)(nil),         // 82: cufinder.v1.PeopleEducationSchool
+	(*PeopleExperienceCompany)(nil),       // 83: cufinder.v1.PeopleExperienceCompany
+	(*PeopleExperienceTitle)(nil),         // 84: cufinder.v1.PeopleExperienceTitle
+	(*JobTitleCategory)(nil),              // 85: cufinder.v1.JobTitleCategory
+	(*PeopleEducationSchoolLocation)(nil), // 86: cufinder.v1.PeopleEducationSchoolLocation
+	(*structpb.Value)(nil),                // 87: google.protobuf.Value
+	(*structpb.Struct)(nil),               // 88: google.protobuf.Struct
+}
+var file_cufinder_v1_cufinder_proto_depIdxs = []int32{
+	87,  // 0: cufinder.v1.CufResponse.query:type_name -> google.protobuf.Value
+	88,  // 1: cufinder.v1.CufResponse.meta_data:type_name -> google.protobuf.Struct
+	87,  // 2: cufinder.v1.LcufResponse.query:type_name -> google.protobuf.Value
+	88,  // 3: cufinder.v1.LcufResponse.meta_data:type_name -> google.protobuf.Struct
+	87,  // 4: cufinder.v1.DtcResponse.query:type_name -> google.protobuf.Value
+	88,  // 5: cufinder.v1.DtcResponse.meta_data:type_name -> google.protobuf.Struct
+	87,  // 6: cufinder.v1.DteResponse.query:type_name -> google.protobuf.Value
+	88,  // 7: cufinder.v1.DteResponse.meta_data:type_name -> google.protobuf.Struct
+	87,  // 8: cufinder.v1.NtpResponse.query:type_name -> google.protobuf.Value
+	88,  // 9: cufinder.v1.NtpResponse.meta_data:type_name -> google.protobuf.Struct
+	87,  // 10: cufinder.v1.EppResponse.query:type_name -> google.protobuf.Value
+	88,  // 11: cufinder.v1.EppResponse.meta_data:type_name -> google.protobuf.Struct
+	62,  // 12: cufinder.v1.EppResponse.person:type_name -> cufinder.v1.EppPerson
+	87,  // 13: cufinder.v1.RelResponse.query:type_name -> google.protobuf.Value
+	88,  // 14: cufinder.v1.RelResponse.meta_data:type_name -> google.protobuf.Struct
+	63,  // 15: cufinder.v1.RelResponse.person:type_name -> cufinder.v1.RelPerson
+	87,  // 16: cufinder.v1.FweResponse.query:type_name -> google.protobuf.Value
+	88,  // 17: cufinder.v1.FweResponse.meta_data:type_name -> google.protobuf.Struct
+	87,  // 18: cufinder.v1.TepResponse.query:type_name -> google.protobuf.Value
+	88,  // 19: cufinder.v1.TepResponse.meta_data:type_name -> google.protobuf.Struct
+	64,  // 20: cufinder.v1.TepResponse.person:type_name -> cufinder.v1.TepPerson
+	87,  // 21: cufinder.v1.FclResponse.query:type_name -> google.protobuf.Value
+	88,  // 22: cufinder.v1.FclResponse.meta_data:type_name -> google.protobuf.Struct
+	65,  // 23: cufinder.v1.FclResponse.companies:type_name -> cufinder.v1.FclCompany
+	87,  // 24: cufinder.v1.ElfResponse.query:type_name -> google.protobuf.Value
+	88,  // 25: cufinder.v1.ElfResponse.meta_data:type_name -> google.protobuf.Struct
+	66,  // 26: cufinder.v1.ElfResponse.fundraising_info:type_name -> cufinder.v1.ElfFundraising
+	87,  // 27: cufinder.v1.CarResponse.query:type_name -> google.protobuf.Value
+	88,  // 28: cufinder.v1.CarResponse.meta_data:type_name -> google.protobuf.Struct
+	87,  // 29: cufinder.v1.FccResponse.query:type_name -> google.protobuf.Value
+	88,  // 30: cufinder.v1.FccResponse.meta_data:type_name -> google.protobuf.Struct
+	87,  // 31: cufinder.v1.FtsResponse.query:type_name -> google.protobuf.Value
+	88,  // 32: cufinder.v1.FtsResponse.meta_data:type_name -> google.protobuf.Struct
+	87,  // 33: cufinder.v1.EncResponse.query:type_name -> google.protobuf.Value
+	88,  // 34: cufinder.v1.EncResponse.meta_data:type_name -> google.protobuf.Struct
+	67,  // 35: cufinder.v1.EncResponse.company:type_name -> cufinder.v1.EncCompany
+	87,  // 36: cufinder.v1.CecResponse.query:type_name -> google.protobuf.Value
+	88,  // 37: cufinder.v1.CecResponse.meta_data:type_name -> google.protobuf.Struct
+	87,  // 38: cufinder.v1.CecResponse.countries:type_name -> google.protobuf.Value
+	87,  // 39: cufinder.v1.CloResponse.query:type_name -> google.protobuf.Value
+	88,  // 40: cufinder.v1.CloResponse.meta_data:type_name -> google.protobuf.Struct
+	68,  // 41: cufinder.v1.CloResponse.locations:type_name -> cufinder.v1.CloLocation
+	87,  // 42: cufinder.v1.CseResponse.query:type_name -> google.protobuf.Value
+	88,  // 43: cufinder.v1.CseResponse.meta_data:type_name -> google.protobuf.Struct
+	69,  // 44: cufinder.v1.CseResponse.companies:type_name -> cufinder.v1.Company
+	87,  // 45: cufinder.v1.PseResponse.query:type_name -> google.protobuf.Value
+	88,  // 46: cufinder.v1.PseResponse.meta_data:type_name -> google.protobuf.Struct
+	70,  // 47: cufinder.v1.PseResponse.peoples:type_name -> cufinder.v1.Person
+	87,  // 48: cufinder.v1.LbsResponse.query:type_name -> google.protobuf.Value
+	88,  // 49: cufinder.v1.LbsResponse.meta_data:type_name -> google.protobuf.Struct
+	69,  // 50: cufinder.v1.LbsResponse.companies:type_name -> cufinder.v1.Company
+	87,  // 51: cufinder.v1.BcdResponse.query:type_name -> google.protobuf.Value
+	88,  // 52: cufinder.v1.BcdResponse.meta_data:type_name -> google.protobuf.Struct
+	87,  // 53: cufinder.v1.CcpResponse.query:type_name -> google.protobuf.Value
+	88,  // 54: cufinder.v1.CcpResponse.meta_data:type_name -> google.protobuf.Struct
+	87,  // 55: cufinder.v1.IscResponse.query:type_name -> google.protobuf.Value
+	88,  // 56: cufinder.v1.IscResponse.meta_data:type_name -> google.protobuf.Struct
+	87,  // 57: cufinder.v1.CbcResponse.query:type_name -> google.protobuf.Value
+	88,  // 58: cufinder.v1.CbcResponse.meta_data:type_name -> google.protobuf.Struct
+	87,  // 59: cufinder.v1.CscResponse.query:type_name -> google.protobuf.Value
+	88,  // 60: cufinder.v1.CscResponse.meta_data:type_name -> google.protobuf.Struct
+	87,  // 61: cufinder.v1.CsnResponse.query:type_name -> google.protobuf.Value
+	88,  // 62: cufinder.v1.CsnResponse.meta_data:type_name -> google.protobuf.Struct
+	71,  // 63: cufinder.v1.CsnResponse.company_snapshot:type_name -> cufinder.v1.CsnSnapshotInfo
+	87,  // 64: cufinder.v1.NaoResponse.query:type_name -> google.protobuf.Value
+	88,  // 65: cufinder.v1.NaoResponse.meta_data:type_name -> google.protobuf.Struct
+	87,  // 66: cufinder.v1.NaaResponse.query:type_name -> google.protobuf.Value
+	88,  // 67: cufinder.v1.NaaResponse.meta_data:type_name -> google.protobuf.Struct
+	29,  // 68: cufinder.v1.EncBatchResult.response:type_name -> cufinder.v1.EncResponse
+	17,  // 69: cufinder.v1.TepBatchResult.response:type_name -> cufinder.v1.TepResponse
+	11,  // 70: cufinder.v1.EppBatchResult.response:type_name -> cufinder.v1.EppResponse
+	34,  // 71: cufinder.v1.CsePagesRequest.params:type_name -> cufinder.v1.CseParams
+	36,  // 72: cufinder.v1.PsePagesRequest.params:type_name -> cufinder.v1.PseParams
+	38,  // 73: cufinder.v1.LbsPagesRequest.params:type_name -> cufinder.v1.LbsParams
+	72,  // 74: cufinder.v1.Company.main_location:type_name -> cufinder.v1.MainLocation
+	73,  // 75: cufinder.v1.Company.employees:type_name -> cufinder.v1.CompanyEmployees
+	74,  // 76: cufinder.v1.Company.social:type_name -> cufinder.v1.CompanySocial
+	87,  // 77: cufinder.v1.Person.experience:type_name -> google.protobuf.Value
+	75,  // 78: cufinder.v1.Person.connections:type_name -> cufinder.v1.PeopleConnections
+	76,  // 79: cufinder.v1.Person.educations:type_name -> cufinder.v1.PeopleEducation
+	77,  // 80: cufinder.v1.Person.experiences:type_name -> cufinder.v1.PeopleExperience
+	78,  // 81: cufinder.v1.Person.certifications:type_name -> cufinder.v1.PeopleCertification
+	69,  // 82: cufinder.v1.Person.company:type_name -> cufinder.v1.Company
+	79,  // 83: cufinder.v1.Person.location:type_name -> cufinder.v1.PeopleLocation
+	80,  // 84: cufinder.v1.Person.current_job:type_name -> cufinder.v1.PeopleCurrentJob
+	81,  // 85: cufinder.v1.Person.social:type_name -> cufinder.v1.PeopleSocial
+	82,  // 86: cufinder.v1.PeopleEducation.school:type_name -> cufinder.v1.PeopleEducationSchool
+	83,  // 87: cufinder.v1.PeopleExperience.company:type_name -> cufinder.v1.PeopleExperienceCompany
+	84,  // 88: cufinder.v1.PeopleExperience.title:type_name -> cufinder.v1.PeopleExperienceTitle
+	85,  // 89: cufinder.v1.PeopleCurrentJob.categories:type_name -> cufinder.v1.JobTitleCategory
+	86,  // 90: cufinder.v1.PeopleEducationSchool.location:type_name -> cufinder.v1.PeopleEducationSchoolLocation
+	0,   // 91: cufinder.v1.Cufinder.Cuf:input_type -> cufinder.v1.CufParams
+	2,   // 92: cufinder.v1.Cufinder.Lcuf:input_type -> cufinder.v1.LcufParams
+	4,   // 93: cufinder.v1.Cufinder.Dtc:input_type -> cufinder.v1.DtcParams
+	6,   // 94: cufinder.v1.Cufinder.Dte:input_type -> cufinder.v1.DteParams
+	8,   // 95: cufinder.v1.Cufinder.Ntp:input_type -> cufinder.v1.NtpParams
+	10,  // 96: cufinder.v1.Cufinder.Epp:input_type -> cufinder.v1.EppParams
+	12,  // 97: cufinder.v1.Cufinder.Rel:input_type -> cufinder.v1.RelParams
+	14,  // 98: cufinder.v1.Cufinder.Fwe:input_type -> cufinder.v1.FweParams
+	16,  // 99: cufinder.v1.Cufinder.Tep:input_type -> cufinder.v1.TepParams
+	18,  // 100: cufinder.v1.Cufinder.Fcl:input_type -> cufinder.v1.FclParams
+	20,  // 101: cufinder.v1.Cufinder.Elf:input_type -> cufinder.v1.ElfParams
+	22,  // 102: cufinder.v1.Cufinder.Car:input_type -> cufinder.v1.CarParams
+	24,  // 103: cufinder.v1.Cufinder.Fcc:input_type -> cufinder.v1.FccParams
+	26,  // 104: cufinder.v1.Cufinder.Fts:input_type -> cufinder.v1.FtsParams
+	28,  // 105: cufinder.v1.Cufinder.Enc:input_type -> cufinder.v1.EncParams
+	30,  // 106: cufinder.v1.Cufinder.Cec:input_type -> cufinder.v1.CecParams
+	32,  // 107: cufinder.v1.Cufinder.Clo:input_type -> cufinder.v1.CloParams
+	34,  // 108: cufinder.v1.Cufinder.Cse:input_type -> cufinder.v1.CseParams
+	36,  // 109: cufinder.v1.Cufinder.Pse:input_type -> cufinder.v1.PseParams
+	38,  // 110: cufinder.v1.Cufinder.Lbs:input_type -> cufinder.v1.LbsParams
+	40,  // 111: cufinder.v1.Cufinder.Bcd:input_type -> cufinder.v1.BcdParams
+	42,  // 112: cufinder.v1.Cufinder.Ccp:input_type -> cufinder.v1.CcpParams
+	44,  // 113: cufinder.v1.Cufinder.Isc:input_type -> cufinder.v1.IscParams
+	46,  // 114: cufinder.v1.Cufinder.Cbc:input_type -> cufinder.v1.CbcParams
+	48,  // 115: cufinder.v1.Cufinder.Csc:input_type -> cufinder.v1.CscParams
+	50,  // 116: cufinder.v1.Cufinder.Csn:input_type -> cufinder.v1.CsnParams
+	52,  // 117: cufinder.v1.Cufinder.Nao:input_type -> cufinder.v1.NaoParams
+	54,  // 118: cufinder.v1.Cufinder.Naa:input_type -> cufinder.v1.NaaParams
+	28,  // 119: cufinder.v1.Cufinder.BatchEnrichCompanies:input_type -> cufinder.v1.EncParams
+	16,  // 120: cufinder.v1.Cufinder.BatchEnrichPeople:input_type -> cufinder.v1.TepParams
+	10,  // 121: cufinder.v1.Cufinder.BatchEnrichProfiles:input_type -> cufinder.v1.EppParams
+	59,  // 122: cufinder.v1.Cufinder.SearchCompanyPages:input_type -> cufinder.v1.CsePagesRequest
+	60,  // 123: cufinder.v1.Cufinder.SearchPeoplePages:input_type -> cufinder.v1.PsePagesRequest
+	61,  // 124: cufinder.v1.Cufinder.SearchLocalBusinessPages:input_type -> cufinder.v1.LbsPagesRequest
+	1,   // 125: cufinder.v1.Cufinder.Cuf:output_type -> cufinder.v1.CufResponse
+	3,   // 126: cufinder.v1.Cufinder.Lcuf:output_type -> cufinder.v1.LcufResponse
+	5,   // 127: cufinder.v1.Cufinder.Dtc:output_type -> cufinder.v1.DtcResponse
+	7,   // 128: cufinder.v1.Cufinder.Dte:output_type -> cufinder.v1.DteResponse
+	9,   // 129: cufinder.v1.Cufinder.Ntp:output_type -> cufinder.v1.NtpResponse
+	11,  // 130: cufinder.v1.Cufinder.Epp:output_type -> cufinder.v1.EppResponse
+	13,  // 131: cufinder.v1.Cufinder.Rel:output_type -> cufinder.v1.RelResponse
+	15,  // 132: cufinder.v1.Cufinder.Fwe:output_type -> cufinder.v1.FweResponse
+	17,  // 133: cufinder.v1.Cufinder.Tep:output_type -> cufinder.v1.TepResponse
+	19,  // 134: cufinder.v1.Cufinder.Fcl:output_type -> cufinder.v1.FclResponse
+	21,  // 135: cufinder.v1.Cufinder.Elf:output_type -> cufinder.v1.ElfResponse
+	23,  // 136: cufinder.v1.Cufinder.Car:output_type -> cufinder.v1.CarResponse
+	25,  // 137: cufinder.v1.Cufinder.Fcc:output_type -> cufinder.v1.FccResponse
+	27,  // 138: cufinder.v1.Cufinder.Fts:output_type -> cufinder.v1.FtsResponse
+	29,  // 139: cufinder.v1.Cufinder.Enc:output_type -> cufinder.v1.EncResponse
+	31,  // 140: cufinder.v1.Cufinder.Cec:output_type -> cufinder.v1.CecResponse
+	33,  // 141: cufinder.v1.Cufinder.Clo:output_type -> cufinder.v1.CloResponse
+	35,  // 142: cufinder.v1.Cufinder.Cse:output_type -> cufinder.v1.CseResponse
+	37,  // 143: cufinder.v1.Cufinder.Pse:output_type -> cufinder.v1.PseResponse
+	39,  // 144: cufinder.v1.Cufinder.Lbs:output_type -> cufinder.v1.LbsResponse
+	41,  // 145: cufinder.v1.Cufinder.Bcd:output_type -> cufinder.v1.BcdResponse
+	43,  // 146: cufinder.v1.Cufinder.Ccp:output_type -> cufinder.v1.CcpResponse
+	45,  // 147: cufinder.v1.Cufinder.Isc:output_type -> cufinder.v1.IscResponse
+	47,  // 148: cufinder.v1.Cufinder.Cbc:output_type -> cufinder.v1.CbcResponse
+	49,  // 149: cufinder.v1.Cufinder.Csc:output_type -> cufinder.v1.CscResponse
+	51,  // 150: cufinder.v1.Cufinder.Csn:output_type -> cufinder.v1.CsnResponse
+	53,  // 151: cufinder.v1.Cufinder.Nao:output_type -> cufinder.v1.NaoResponse
+	55,  // 152: cufinder.v1.Cufinder.Naa:output_type -> cufinder.v1.NaaResponse
+	56,  // 153: cufinder.v1.Cufinder.BatchEnrichCompanies:output_type -> cufinder.v1.EncBatchResult
+	57,  // 154: cufinder.v1.Cufinder.BatchEnrichPeople:output_type -> cufinder.v1.TepBatchResult
+	58,  // 155: cufinder.v1.Cufinder.BatchEnrichProfiles:output_type -> cufinder.v1.EppBatchResult
+	35,  // 156: cufinder.v1.Cufinder.SearchCompanyPages:output_type -> cufinder.v1.CseResponse
+	37,  // 157: cufinder.v1.Cufinder.SearchPeoplePages:output_type -> cufinder.v1.PseResponse
+	39,  // 158: cufinder.v1.Cufinder.SearchLocalBusinessPages:output_type -> cufinder.v1.LbsResponse
+	125, // [125:159] is the sub-list for method output_type
+	91,  // [91:125] is the sub-list for method input_type
+	91,  // [91:91] is the sub-list for extension type_name
+	91,  // [91:91] is the sub-list for extension extendee
+	0,   // [0:91] is the sub-list for field type_name
+}
+
+func init() { file_cufinder_v1_cufinder_proto_init() }
+func file_cufinder_v1_cufinder_proto_init() {
+	if File_cufinder_v1_cufinder_proto != nil {
+		return
+	}
+	file_cufinder_v1_cufinder_proto_msgTypes[34].OneofWrappers = []any{}
+	file_cufinder_v1_cufinder_proto_msgTypes[36].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cufinder_v1_cufinder_proto_rawDesc), len(file_cufinder_v1_cufinder_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   87,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cufinder_v1_cufinder_proto_goTypes,
+		DependencyIndexes: file_cufinder_v1_cufinder_proto_depIdxs,
+		MessageInfos:      file_cufinder_v1_cufinder_proto_msgTypes,
+	}.Build()
+	File_cufinder_v1_cufinder_proto = out.File
+	file_cufinder_v1_cufinder_proto_goTypes = nil
+	file_cufinder_v1_cufinder_proto_depIdxs = nil
+}
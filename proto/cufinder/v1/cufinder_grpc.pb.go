@@ -0,0 +1,1453 @@
+// Code generated by go generate ./proto. DO NOT EDIT.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: cufinder/v1/cufinder.proto
+
+package cufinderv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Cufinder_Cuf_FullMethodName                      = "/cufinder.v1.Cufinder/Cuf"
+	Cufinder_Lcuf_FullMethodName                     = "/cufinder.v1.Cufinder/Lcuf"
+	Cufinder_Dtc_FullMethodName                      = "/cufinder.v1.Cufinder/Dtc"
+	Cufinder_Dte_FullMethodName                      = "/cufinder.v1.Cufinder/Dte"
+	Cufinder_Ntp_FullMethodName                      = "/cufinder.v1.Cufinder/Ntp"
+	Cufinder_Epp_FullMethodName                      = "/cufinder.v1.Cufinder/Epp"
+	Cufinder_Rel_FullMethodName                      = "/cufinder.v1.Cufinder/Rel"
+	Cufinder_Fwe_FullMethodName                      = "/cufinder.v1.Cufinder/Fwe"
+	Cufinder_Tep_FullMethodName                      = "/cufinder.v1.Cufinder/Tep"
+	Cufinder_Fcl_FullMethodName                      = "/cufinder.v1.Cufinder/Fcl"
+	Cufinder_Elf_FullMethodName                      = "/cufinder.v1.Cufinder/Elf"
+	Cufinder_Car_FullMethodName                      = "/cufinder.v1.Cufinder/Car"
+	Cufinder_Fcc_FullMethodName                      = "/cufinder.v1.Cufinder/Fcc"
+	Cufinder_Fts_FullMethodName                      = "/cufinder.v1.Cufinder/Fts"
+	Cufinder_Enc_FullMethodName                      = "/cufinder.v1.Cufinder/Enc"
+	Cufinder_Cec_FullMethodName                      = "/cufinder.v1.Cufinder/Cec"
+	Cufinder_Clo_FullMethodName                      = "/cufinder.v1.Cufinder/Clo"
+	Cufinder_Cse_FullMethodName                      = "/cufinder.v1.Cufinder/Cse"
+	Cufinder_Pse_FullMethodName                      = "/cufinder.v1.Cufinder/Pse"
+	Cufinder_Lbs_FullMethodName                      = "/cufinder.v1.Cufinder/Lbs"
+	Cufinder_Bcd_FullMethodName                      = "/cufinder.v1.Cufinder/Bcd"
+	Cufinder_Ccp_FullMethodName                      = "/cufinder.v1.Cufinder/Ccp"
+	Cufinder_Isc_FullMethodName                      = "/cufinder.v1.Cufinder/Isc"
+	Cufinder_Cbc_FullMethodName                      = "/cufinder.v1.Cufinder/Cbc"
+	Cufinder_Csc_FullMethodName                      = "/cufinder.v1.Cufinder/Csc"
+	Cufinder_Csn_FullMethodName                      = "/cufinder.v1.Cufinder/Csn"
+	Cufinder_Nao_FullMethodName                      = "/cufinder.v1.Cufinder/Nao"
+	Cufinder_Naa_FullMethodName                      = "/cufinder.v1.Cufinder/Naa"
+	Cufinder_BatchEnrichCompanies_FullMethodName     = "/cufinder.v1.Cufinder/BatchEnrichCompanies"
+	Cufinder_BatchEnrichPeople_FullMethodName        = "/cufinder.v1.Cufinder/BatchEnrichPeople"
+	Cufinder_BatchEnrichProfiles_FullMethodName      = "/cufinder.v1.Cufinder/BatchEnrichProfiles"
+	Cufinder_SearchCompanyPages_FullMethodName       = "/cufinder.v1.Cufinder/SearchCompanyPages"
+	Cufinder_SearchPeoplePages_FullMethodName        = "/cufinder.v1.Cufinder/SearchPeoplePages"
+	Cufinder_SearchLocalBusinessPages_FullMethodName = "/cufinder.v1.Cufinder/SearchLocalBusinessPages"
+)
+
+// CufinderClient is the client API for Cufinder service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Cufinder exposes every CUFinder API operation
+type CufinderClient interface {
+	// CUF - Get company domain from company name
+	Cuf(ctx context.Context, in *CufParams, opts ...grpc.CallOption) (*CufResponse, error)
+	// LCUF - Get LinkedIn URL from company name
+	Lcuf(ctx context.Context, in *LcufParams, opts ...grpc.CallOption) (*LcufResponse, error)
+	// DTC - Get company name from domain
+	Dtc(ctx context.Context, in *DtcParams, opts ...grpc.CallOption) (*DtcResponse, error)
+	// DTE - Get company emails from domain
+	Dte(ctx context.Context, in *DteParams, opts ...grpc.CallOption) (*DteResponse, error)
+	// NTP - Get company phones from company name
+	Ntp(ctx context.Context, in *NtpParams, opts ...grpc.CallOption) (*NtpResponse, error)
+	// EPP - Enrich LinkedIn profile
+	Epp(ctx context.Context, in *EppParams, opts ...grpc.CallOption) (*EppResponse, error)
+	// REL - Reverse email lookup
+	Rel(ctx context.Context, in *RelParams, opts ...grpc.CallOption) (*RelResponse, error)
+	// FWE - Get email from profile
+	Fwe(ctx context.Context, in *FweParams, opts ...grpc.CallOption) (*FweResponse, error)
+	// TEP - Enrich person information
+	Tep(ctx context.Context, in *TepParams, opts ...grpc.CallOption) (*TepResponse, error)
+	// FCL - Get company lookalikes
+	Fcl(ctx context.Context, in *FclParams, opts ...grpc.CallOption) (*FclResponse, error)
+	// ELF - Get company fundraising information
+	Elf(ctx context.Context, in *ElfParams, opts ...grpc.CallOption) (*ElfResponse, error)
+	// CAR - Get company revenue
+	Car(ctx context.Context, in *CarParams, opts ...grpc.CallOption) (*CarResponse, error)
+	// FCC - Get company subsidiaries
+	Fcc(ctx context.Context, in *FccParams, opts ...grpc.CallOption) (*FccResponse, error)
+	// FTS - Get company tech stack
+	Fts(ctx context.Context, in *FtsParams, opts ...grpc.CallOption) (*FtsResponse, error)
+	// ENC - Enrich company information
+	Enc(ctx context.Context, in *EncParams, opts ...grpc.CallOption) (*EncResponse, error)
+	// CEC - Get company employee countries
+	Cec(ctx context.Context, in *CecParams, opts ...grpc.CallOption) (*CecResponse, error)
+	// CLO - Get company locations
+	Clo(ctx context.Context, in *CloParams, opts ...grpc.CallOption) (*CloResponse, error)
+	// CSE - Search companies
+	Cse(ctx context.Context, in *CseParams, opts ...grpc.CallOption) (*CseResponse, error)
+	// PSE - Search people
+	Pse(ctx context.Context, in *PseParams, opts ...grpc.CallOption) (*PseResponse, error)
+	// LBS - Search local businesses
+	Lbs(ctx context.Context, in *LbsParams, opts ...grpc.CallOption) (*LbsResponse, error)
+	// BCD - B2B Customers Finder
+	Bcd(ctx context.Context, in *BcdParams, opts ...grpc.CallOption) (*BcdResponse, error)
+	// CCP - Company Career Page Finder
+	Ccp(ctx context.Context, in *CcpParams, opts ...grpc.CallOption) (*CcpResponse, error)
+	// ISC - Company Saas Checker
+	Isc(ctx context.Context, in *IscParams, opts ...grpc.CallOption) (*IscResponse, error)
+	// CBC - Company B2B or B2C Checker
+	Cbc(ctx context.Context, in *CbcParams, opts ...grpc.CallOption) (*CbcResponse, error)
+	// CSC - Company Mission Statement
+	Csc(ctx context.Context, in *CscParams, opts ...grpc.CallOption) (*CscResponse, error)
+	// CSN - Company Snapshot
+	Csn(ctx context.Context, in *CsnParams, opts ...grpc.CallOption) (*CsnResponse, error)
+	// NAO - Phone Number Normalizer
+	Nao(ctx context.Context, in *NaoParams, opts ...grpc.CallOption) (*NaoResponse, error)
+	// NAA - Address Normalizer
+	Naa(ctx context.Context, in *NaaParams, opts ...grpc.CallOption) (*NaaResponse, error)
+	// BatchEnrichCompanies runs ENC for every request on the stream. Results carry the
+	// index of their request and may arrive out of order.
+	BatchEnrichCompanies(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[EncParams, EncBatchResult], error)
+	// BatchEnrichPeople runs TEP for every request on the stream. Results carry the
+	// index of their request and may arrive out of order.
+	BatchEnrichPeople(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[TepParams, TepBatchResult], error)
+	// BatchEnrichProfiles runs EPP for every request on the stream. Results carry the
+	// index of their request and may arrive out of order.
+	BatchEnrichProfiles(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[EppParams, EppBatchResult], error)
+	// SearchCompanyPages streams CSE result pages, starting at params.page, until a
+	// page is empty or max_pages pages were sent.
+	SearchCompanyPages(ctx context.Context, in *CsePagesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CseResponse], error)
+	// SearchPeoplePages streams PSE result pages, starting at params.page, until a
+	// page is empty or max_pages pages were sent.
+	SearchPeoplePages(ctx context.Context, in *PsePagesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PseResponse], error)
+	// SearchLocalBusinessPages streams LBS result pages, starting at params.page, until a
+	// page is empty or max_pages pages were sent.
+	SearchLocalBusinessPages(ctx context.Context, in *LbsPagesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LbsResponse], error)
+}
+
+type cufinderClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCufinderClient(cc grpc.ClientConnInterface) CufinderClient {
+	return &cufinderClient{cc}
+}
+
+func (c *cufinderClient) Cuf(ctx context.Context, in *CufParams, opts ...grpc.CallOption) (*CufResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CufResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Cuf_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Lcuf(ctx context.Context, in *LcufParams, opts ...grpc.CallOption) (*LcufResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LcufResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Lcuf_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Dtc(ctx context.Context, in *DtcParams, opts ...grpc.CallOption) (*DtcResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DtcResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Dtc_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Dte(ctx context.Context, in *DteParams, opts ...grpc.CallOption) (*DteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DteResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Dte_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Ntp(ctx context.Context, in *NtpParams, opts ...grpc.CallOption) (*NtpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NtpResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Ntp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Epp(ctx context.Context, in *EppParams, opts ...grpc.CallOption) (*EppResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EppResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Epp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Rel(ctx context.Context, in *RelParams, opts ...grpc.CallOption) (*RelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RelResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Rel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Fwe(ctx context.Context, in *FweParams, opts ...grpc.CallOption) (*FweResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FweResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Fwe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Tep(ctx context.Context, in *TepParams, opts ...grpc.CallOption) (*TepResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TepResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Tep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Fcl(ctx context.Context, in *FclParams, opts ...grpc.CallOption) (*FclResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FclResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Fcl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Elf(ctx context.Context, in *ElfParams, opts ...grpc.CallOption) (*ElfResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ElfResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Elf_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Car(ctx context.Context, in *CarParams, opts ...grpc.CallOption) (*CarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CarResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Car_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Fcc(ctx context.Context, in *FccParams, opts ...grpc.CallOption) (*FccResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FccResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Fcc_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Fts(ctx context.Context, in *FtsParams, opts ...grpc.CallOption) (*FtsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FtsResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Fts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Enc(ctx context.Context, in *EncParams, opts ...grpc.CallOption) (*EncResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EncResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Enc_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Cec(ctx context.Context, in *CecParams, opts ...grpc.CallOption) (*CecResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CecResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Cec_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Clo(ctx context.Context, in *CloParams, opts ...grpc.CallOption) (*CloResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Clo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Cse(ctx context.Context, in *CseParams, opts ...grpc.CallOption) (*CseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CseResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Cse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Pse(ctx context.Context, in *PseParams, opts ...grpc.CallOption) (*PseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PseResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Pse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Lbs(ctx context.Context, in *LbsParams, opts ...grpc.CallOption) (*LbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LbsResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Lbs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Bcd(ctx context.Context, in *BcdParams, opts ...grpc.CallOption) (*BcdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BcdResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Bcd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Ccp(ctx context.Context, in *CcpParams, opts ...grpc.CallOption) (*CcpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CcpResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Ccp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Isc(ctx context.Context, in *IscParams, opts ...grpc.CallOption) (*IscResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IscResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Isc_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Cbc(ctx context.Context, in *CbcParams, opts ...grpc.CallOption) (*CbcResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CbcResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Cbc_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Csc(ctx context.Context, in *CscParams, opts ...grpc.CallOption) (*CscResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CscResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Csc_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Csn(ctx context.Context, in *CsnParams, opts ...grpc.CallOption) (*CsnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CsnResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Csn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Nao(ctx context.Context, in *NaoParams, opts ...grpc.CallOption) (*NaoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NaoResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Nao_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) Naa(ctx context.Context, in *NaaParams, opts ...grpc.CallOption) (*NaaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NaaResponse)
+	err := c.cc.Invoke(ctx, Cufinder_Naa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cufinderClient) BatchEnrichCompanies(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[EncParams, EncBatchResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Cufinder_ServiceDesc.Streams[0], Cufinder_BatchEnrichCompanies_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[EncParams, EncBatchResult]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cufinder_BatchEnrichCompaniesClient = grpc.BidiStreamingClient[EncParams, EncBatchResult]
+
+func (c *cufinderClient) BatchEnrichPeople(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[TepParams, TepBatchResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Cufinder_ServiceDesc.Streams[1], Cufinder_BatchEnrichPeople_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TepParams, TepBatchResult]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cufinder_BatchEnrichPeopleClient = grpc.BidiStreamingClient[TepParams, TepBatchResult]
+
+func (c *cufinderClient) BatchEnrichProfiles(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[EppParams, EppBatchResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Cufinder_ServiceDesc.Streams[2], Cufinder_BatchEnrichProfiles_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[EppParams, EppBatchResult]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cufinder_BatchEnrichProfilesClient = grpc.BidiStreamingClient[EppParams, EppBatchResult]
+
+func (c *cufinderClient) SearchCompanyPages(ctx context.Context, in *CsePagesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CseResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Cufinder_ServiceDesc.Streams[3], Cufinder_SearchCompanyPages_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CsePagesRequest, CseResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cufinder_SearchCompanyPagesClient = grpc.ServerStreamingClient[CseResponse]
+
+func (c *cufinderClient) SearchPeoplePages(ctx context.Context, in *PsePagesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PseResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Cufinder_ServiceDesc.Streams[4], Cufinder_SearchPeoplePages_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[PsePagesRequest, PseResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cufinder_SearchPeoplePagesClient = grpc.ServerStreamingClient[PseResponse]
+
+func (c *cufinderClient) SearchLocalBusinessPages(ctx context.Context, in *LbsPagesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LbsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Cufinder_ServiceDesc.Streams[5], Cufinder_SearchLocalBusinessPages_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[LbsPagesRequest, LbsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cufinder_SearchLocalBusinessPagesClient = grpc.ServerStreamingClient[LbsResponse]
+
+// CufinderServer is the server API for Cufinder service.
+// All implementations must embed UnimplementedCufinderServer
+// for forward compatibility.
+//
+// Cufinder exposes every CUFinder API operation
+type CufinderServer interface {
+	// CUF - Get company domain from company name
+	Cuf(context.Context, *CufParams) (*CufResponse, error)
+	// LCUF - Get LinkedIn URL from company name
+	Lcuf(context.Context, *LcufParams) (*LcufResponse, error)
+	// DTC - Get company name from domain
+	Dtc(context.Context, *DtcParams) (*DtcResponse, error)
+	// DTE - Get company emails from domain
+	Dte(context.Context, *DteParams) (*DteResponse, error)
+	// NTP - Get company phones from company name
+	Ntp(context.Context, *NtpParams) (*NtpResponse, error)
+	// EPP - Enrich LinkedIn profile
+	Epp(context.Context, *EppParams) (*EppResponse, error)
+	// REL - Reverse email lookup
+	Rel(context.Context, *RelParams) (*RelResponse, error)
+	// FWE - Get email from profile
+	Fwe(context.Context, *FweParams) (*FweResponse, error)
+	// TEP - Enrich person information
+	Tep(context.Context, *TepParams) (*TepResponse, error)
+	// FCL - Get company lookalikes
+	Fcl(context.Context, *FclParams) (*FclResponse, error)
+	// ELF - Get company fundraising information
+	Elf(context.Context, *ElfParams) (*ElfResponse, error)
+	// CAR - Get company revenue
+	Car(context.Context, *CarParams) (*CarResponse, error)
+	// FCC - Get company subsidiaries
+	Fcc(context.Context, *FccParams) (*FccResponse, error)
+	// FTS - Get company tech stack
+	Fts(context.Context, *FtsParams) (*FtsResponse, error)
+	// ENC - Enrich company information
+	Enc(context.Context, *EncParams) (*EncResponse, error)
+	// CEC - Get company employee countries
+	Cec(context.Context, *CecParams) (*CecResponse, error)
+	// CLO - Get company locations
+	Clo(context.Context, *CloParams) (*CloResponse, error)
+	// CSE - Search companies
+	Cse(context.Context, *CseParams) (*CseResponse, error)
+	// PSE - Search people
+	Pse(context.Context, *PseParams) (*PseResponse, error)
+	// LBS - Search local businesses
+	Lbs(context.Context, *LbsParams) (*LbsResponse, error)
+	// BCD - B2B Customers Finder
+	Bcd(context.Context, *BcdParams) (*BcdResponse, error)
+	// CCP - Company Career Page Finder
+	Ccp(context.Context, *CcpParams) (*CcpResponse, error)
+	// ISC - Company Saas Checker
+	Isc(context.Context, *IscParams) (*IscResponse, error)
+	// CBC - Company B2B or B2C Checker
+	Cbc(context.Context, *CbcParams) (*CbcResponse, error)
+	// CSC - Company Mission Statement
+	Csc(context.Context, *CscParams) (*CscResponse, error)
+	// CSN - Company Snapshot
+	Csn(context.Context, *CsnParams) (*CsnResponse, error)
+	// NAO - Phone Number Normalizer
+	Nao(context.Context, *NaoParams) (*NaoResponse, error)
+	// NAA - Address Normalizer
+	Naa(context.Context, *NaaParams) (*NaaResponse, error)
+	// BatchEnrichCompanies runs ENC for every request on the stream. Results carry the
+	// index of their request and may arrive out of order.
+	BatchEnrichCompanies(grpc.BidiStreamingServer[EncParams, EncBatchResult]) error
+	// BatchEnrichPeople runs TEP for every request on the stream. Results carry the
+	// index of their request and may arrive out of order.
+	BatchEnrichPeople(grpc.BidiStreamingServer[TepParams, TepBatchResult]) error
+	// BatchEnrichProfiles runs EPP for every request on the stream. Results carry the
+	// index of their request and may arrive out of order.
+	BatchEnrichProfiles(grpc.BidiStreamingServer[EppParams, EppBatchResult]) error
+	// SearchCompanyPages streams CSE result pages, starting at params.page, until a
+	// page is empty or max_pages pages were sent.
+	SearchCompanyPages(*CsePagesRequest, grpc.ServerStreamingServer[CseResponse]) error
+	// SearchPeoplePages streams PSE result pages, starting at params.page, until a
+	// page is empty or max_pages pages were sent.
+	SearchPeoplePages(*PsePagesRequest, grpc.ServerStreamingServer[PseResponse]) error
+	// SearchLocalBusinessPages streams LBS result pages, starting at params.page, until a
+	// page is empty or max_pages pages were sent.
+	SearchLocalBusinessPages(*LbsPagesRequest, grpc.ServerStreamingServer[LbsResponse]) error
+	mustEmbedUnimplementedCufinderServer()
+}
+
+// UnimplementedCufinderServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCufinderServer struct{}
+
+func (UnimplementedCufinderServer) Cuf(context.Context, *CufParams) (*CufResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Cuf not implemented")
+}
+func (UnimplementedCufinderServer) Lcuf(context.Context, *LcufParams) (*LcufResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Lcuf not implemented")
+}
+func (UnimplementedCufinderServer) Dtc(context.Context, *DtcParams) (*DtcResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Dtc not implemented")
+}
+func (UnimplementedCufinderServer) Dte(context.Context, *DteParams) (*DteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Dte not implemented")
+}
+func (UnimplementedCufinderServer) Ntp(context.Context, *NtpParams) (*NtpResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Ntp not implemented")
+}
+func (UnimplementedCufinderServer) Epp(context.Context, *EppParams) (*EppResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Epp not implemented")
+}
+func (UnimplementedCufinderServer) Rel(context.Context, *RelParams) (*RelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Rel not implemented")
+}
+func (UnimplementedCufinderServer) Fwe(context.Context, *FweParams) (*FweResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Fwe not implemented")
+}
+func (UnimplementedCufinderServer) Tep(context.Context, *TepParams) (*TepResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Tep not implemented")
+}
+func (UnimplementedCufinderServer) Fcl(context.Context, *FclParams) (*FclResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Fcl not implemented")
+}
+func (UnimplementedCufinderServer) Elf(context.Context, *ElfParams) (*ElfResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Elf not implemented")
+}
+func (UnimplementedCufinderServer) Car(context.Context, *CarParams) (*CarResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Car not implemented")
+}
+func (UnimplementedCufinderServer) Fcc(context.Context, *FccParams) (*FccResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Fcc not implemented")
+}
+func (UnimplementedCufinderServer) Fts(context.Context, *FtsParams) (*FtsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Fts not implemented")
+}
+func (UnimplementedCufinderServer) Enc(context.Context, *EncParams) (*EncResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Enc not implemented")
+}
+func (UnimplementedCufinderServer) Cec(context.Context, *CecParams) (*CecResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Cec not implemented")
+}
+func (UnimplementedCufinderServer) Clo(context.Context, *CloParams) (*CloResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Clo not implemented")
+}
+func (UnimplementedCufinderServer) Cse(context.Context, *CseParams) (*CseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Cse not implemented")
+}
+func (UnimplementedCufinderServer) Pse(context.Context, *PseParams) (*PseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Pse not implemented")
+}
+func (UnimplementedCufinderServer) Lbs(context.Context, *LbsParams) (*LbsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Lbs not implemented")
+}
+func (UnimplementedCufinderServer) Bcd(context.Context, *BcdParams) (*BcdResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Bcd not implemented")
+}
+func (UnimplementedCufinderServer) Ccp(context.Context, *CcpParams) (*CcpResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Ccp not implemented")
+}
+func (UnimplementedCufinderServer) Isc(context.Context, *IscParams) (*IscResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Isc not implemented")
+}
+func (UnimplementedCufinderServer) Cbc(context.Context, *CbcParams) (*CbcResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Cbc not implemented")
+}
+func (UnimplementedCufinderServer) Csc(context.Context, *CscParams) (*CscResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Csc not implemented")
+}
+func (UnimplementedCufinderServer) Csn(context.Context, *CsnParams) (*CsnResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Csn not implemented")
+}
+func (UnimplementedCufinderServer) Nao(context.Context, *NaoParams) (*NaoResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Nao not implemented")
+}
+func (UnimplementedCufinderServer) Naa(context.Context, *NaaParams) (*NaaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Naa not implemented")
+}
+func (UnimplementedCufinderServer) BatchEnrichCompanies(grpc.BidiStreamingServer[EncParams, EncBatchResult]) error {
+	return status.Error(codes.Unimplemented, "method BatchEnrichCompanies not implemented")
+}
+func (UnimplementedCufinderServer) BatchEnrichPeople(grpc.BidiStreamingServer[TepParams, TepBatchResult]) error {
+	return status.Error(codes.Unimplemented, "method BatchEnrichPeople not implemented")
+}
+func (UnimplementedCufinderServer) BatchEnrichProfiles(grpc.BidiStreamingServer[EppParams, EppBatchResult]) error {
+	return status.Error(codes.Unimplemented, "method BatchEnrichProfiles not implemented")
+}
+func (UnimplementedCufinderServer) SearchCompanyPages(*CsePagesRequest, grpc.ServerStreamingServer[CseResponse]) error {
+	return status.Error(codes.Unimplemented, "method SearchCompanyPages not implemented")
+}
+func (UnimplementedCufinderServer) SearchPeoplePages(*PsePagesRequest, grpc.ServerStreamingServer[PseResponse]) error {
+	return status.Error(codes.Unimplemented, "method SearchPeoplePages not implemented")
+}
+func (UnimplementedCufinderServer) SearchLocalBusinessPages(*LbsPagesRequest, grpc.ServerStreamingServer[LbsResponse]) error {
+	return status.Error(codes.Unimplemented, "method SearchLocalBusinessPages not implemented")
+}
+func (UnimplementedCufinderServer) mustEmbedUnimplementedCufinderServer() {}
+func (UnimplementedCufinderServer) testEmbeddedByValue()                  {}
+
+// UnsafeCufinderServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CufinderServer will
+// result in compilation errors.
+type UnsafeCufinderServer interface {
+	mustEmbedUnimplementedCufinderServer()
+}
+
+func RegisterCufinderServer(s grpc.ServiceRegistrar, srv CufinderServer) {
+	// If the following call panics, it indicates UnimplementedCufinderServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Cufinder_ServiceDesc, srv)
+}
+
+func _Cufinder_Cuf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CufParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Cuf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Cuf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Cuf(ctx, req.(*CufParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Lcuf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LcufParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Lcuf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Lcuf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Lcuf(ctx, req.(*LcufParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Dtc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DtcParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Dtc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Dtc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Dtc(ctx, req.(*DtcParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Dte_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DteParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Dte(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Dte_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Dte(ctx, req.(*DteParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Ntp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NtpParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Ntp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Ntp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Ntp(ctx, req.(*NtpParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Epp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EppParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Epp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Epp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Epp(ctx, req.(*EppParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Rel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Rel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Rel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Rel(ctx, req.(*RelParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Fwe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FweParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Fwe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Fwe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Fwe(ctx, req.(*FweParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Tep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TepParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Tep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Tep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Tep(ctx, req.(*TepParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Fcl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FclParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Fcl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Fcl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Fcl(ctx, req.(*FclParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Elf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElfParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Elf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Elf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Elf(ctx, req.(*ElfParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Car_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CarParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Car(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Car_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Car(ctx, req.(*CarParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Fcc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FccParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Fcc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Fcc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Fcc(ctx, req.(*FccParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Fts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FtsParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Fts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Fts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Fts(ctx, req.(*FtsParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Enc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Enc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Enc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Enc(ctx, req.(*EncParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Cec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CecParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Cec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Cec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Cec(ctx, req.(*CecParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Clo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Clo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Clo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Clo(ctx, req.(*CloParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Cse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CseParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Cse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Cse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Cse(ctx, req.(*CseParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Pse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PseParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Pse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Pse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Pse(ctx, req.(*PseParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Lbs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LbsParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Lbs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Lbs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Lbs(ctx, req.(*LbsParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Bcd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BcdParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Bcd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Bcd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Bcd(ctx, req.(*BcdParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Ccp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CcpParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Ccp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Ccp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Ccp(ctx, req.(*CcpParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Isc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IscParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Isc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Isc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Isc(ctx, req.(*IscParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Cbc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CbcParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Cbc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Cbc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Cbc(ctx, req.(*CbcParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Csc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CscParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Csc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Csc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Csc(ctx, req.(*CscParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Csn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CsnParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Csn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Csn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Csn(ctx, req.(*CsnParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Nao_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NaoParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Nao(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Nao_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Nao(ctx, req.(*NaoParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_Naa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NaaParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CufinderServer).Naa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cufinder_Naa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CufinderServer).Naa(ctx, req.(*NaaParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cufinder_BatchEnrichCompanies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CufinderServer).BatchEnrichCompanies(&grpc.GenericServerStream[EncParams, EncBatchResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cufinder_BatchEnrichCompaniesServer = grpc.BidiStreamingServer[EncParams, EncBatchResult]
+
+func _Cufinder_BatchEnrichPeople_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CufinderServer).BatchEnrichPeople(&grpc.GenericServerStream[TepParams, TepBatchResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cufinder_BatchEnrichPeopleServer = grpc.BidiStreamingServer[TepParams, TepBatchResult]
+
+func _Cufinder_BatchEnrichProfiles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CufinderServer).BatchEnrichProfiles(&grpc.GenericServerStream[EppParams, EppBatchResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cufinder_BatchEnrichProfilesServer = grpc.BidiStreamingServer[EppParams, EppBatchResult]
+
+func _Cufinder_SearchCompanyPages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CsePagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CufinderServer).SearchCompanyPages(m, &grpc.GenericServerStream[CsePagesRequest, CseResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cufinder_SearchCompanyPagesServer = grpc.ServerStreamingServer[CseResponse]
+
+func _Cufinder_SearchPeoplePages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PsePagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CufinderServer).SearchPeoplePages(m, &grpc.GenericServerStream[PsePagesRequest, PseResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cufinder_SearchPeoplePagesServer = grpc.ServerStreamingServer[PseResponse]
+
+func _Cufinder_SearchLocalBusinessPages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LbsPagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CufinderServer).SearchLocalBusinessPages(m, &grpc.GenericServerStream[LbsPagesRequest, LbsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cufinder_SearchLocalBusinessPagesServer = grpc.ServerStreamingServer[LbsResponse]
+
+// Cufinder_ServiceDesc is the grpc.ServiceDesc for Cufinder service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Cufinder_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cufinder.v1.Cufinder",
+	HandlerType: (*CufinderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Cuf",
+			Handler:    _Cufinder_Cuf_Handler,
+		},
+		{
+			MethodName: "Lcuf",
+			Handler:    _Cufinder_Lcuf_Handler,
+		},
+		{
+			MethodName: "Dtc",
+			Handler:    _Cufinder_Dtc_Handler,
+		},
+		{
+			MethodName: "Dte",
+			Handler:    _Cufinder_Dte_Handler,
+		},
+		{
+			MethodName: "Ntp",
+			Handler:    _Cufinder_Ntp_Handler,
+		},
+		{
+			MethodName: "Epp",
+			Handler:    _Cufinder_Epp_Handler,
+		},
+		{
+			MethodName: "Rel",
+			Handler:    _Cufinder_Rel_Handler,
+		},
+		{
+			MethodName: "Fwe",
+			Handler:    _Cufinder_Fwe_Handler,
+		},
+		{
+			MethodName: "Tep",
+			Handler:    _Cufinder_Tep_Handler,
+		},
+		{
+			MethodName: "Fcl",
+			Handler:    _Cufinder_Fcl_Handler,
+		},
+		{
+			MethodName: "Elf",
+			Handler:    _Cufinder_Elf_Handler,
+		},
+		{
+			MethodName: "Car",
+			Handler:    _Cufinder_Car_Handler,
+		},
+		{
+			MethodName: "Fcc",
+			Handler:    _Cufinder_Fcc_Handler,
+		},
+		{
+			MethodName: "Fts",
+			Handler:    _Cufinder_Fts_Handler,
+		},
+		{
+			MethodName: "Enc",
+			Handler:    _Cufinder_Enc_Handler,
+		},
+		{
+			MethodName: "Cec",
+			Handler:    _Cufinder_Cec_Handler,
+		},
+		{
+			MethodName: "Clo",
+			Handler:    _Cufinder_Clo_Handler,
+		},
+		{
+			MethodName: "Cse",
+			Handler:    _Cufinder_Cse_Handler,
+		},
+		{
+			MethodName: "Pse",
+			Handler:    _Cufinder_Pse_Handler,
+		},
+		{
+			MethodName: "Lbs",
+			Handler:    _Cufinder_Lbs_Handler,
+		},
+		{
+			MethodName: "Bcd",
+			Handler:    _Cufinder_Bcd_Handler,
+		},
+		{
+			MethodName: "Ccp",
+			Handler:    _Cufinder_Ccp_Handler,
+		},
+		{
+			MethodName: "Isc",
+			Handler:    _Cufinder_Isc_Handler,
+		},
+		{
+			MethodName: "Cbc",
+			Handler:    _Cufinder_Cbc_Handler,
+		},
+		{
+			MethodName: "Csc",
+			Handler:    _Cufinder_Csc_Handler,
+		},
+		{
+			MethodName: "Csn",
+			Handler:    _Cufinder_Csn_Handler,
+		},
+		{
+			MethodName: "Nao",
+			Handler:    _Cufinder_Nao_Handler,
+		},
+		{
+			MethodName: "Naa",
+			Handler:    _Cufinder_Naa_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BatchEnrichCompanies",
+			Handler:       _Cufinder_BatchEnrichCompanies_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "BatchEnrichPeople",
+			Handler:       _Cufinder_BatchEnrichPeople_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "BatchEnrichProfiles",
+			Handler:       _Cufinder_BatchEnrichProfiles_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SearchCompanyPages",
+			Handler:       _Cufinder_SearchCompanyPages_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SearchPeoplePages",
+			Handler:       _Cufinder_SearchPeoplePages_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SearchLocalBusinessPages",
+			Handler:       _Cufinder_SearchLocalBusinessPages_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cufinder/v1/cufinder.proto",
+}
@@ -0,0 +1,21 @@
+//go:build ignore
+
+// gen writes cufinder/v1/cufinder.proto and the rpc server methods from
+// the SDK types
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/cufinder/cufinder-go/proto"
+)
+
+func main() {
+	if err := os.WriteFile("cufinder/v1/cufinder.proto", proto.Generate(), 0o644); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("rpc/methods.go", proto.GenerateServer(), 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,263 @@
+// Package proto generates the Protocol Buffers definition of the CUFinder
+// API from the SDK types, for gRPC services built on the SDK.
+//
+// The definition and the Go stubs generated from it are checked in at
+// cufinder/v1, and refreshed with go generate whenever types.go changes;
+// the stubs need buf, protoc-gen-go and protoc-gen-go-grpc on the PATH:
+//
+//	go generate ./proto
+//
+// Every operation in cufinder.Operations becomes a unary RPC taking its
+// Params message and returning its Response message. ENC, TEP and EPP also
+// have bidirectional batch RPCs, and CSE, PSE and LBS have server-streaming
+// RPCs that return one response per result page. The rpc package serves
+// them through cufinder.Operations and connects clients.
+//
+// Fields are named after the JSON keys of the REST API, so protojson
+// output uses the same keys in lowerCamelCase, or the original names with
+// UseProtoNames. It does not match the REST API byte for byte: Go ints are
+// int64 fields, which protojson encodes as JSON strings.
+//
+// Field numbers follow the declaration order of the Go fields, so new
+// fields must be added at the end of their struct to keep the wire format
+// compatible.
+package proto
+
+//go:generate go run gen.go
+//go:generate buf generate
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+// Batch lists the operations with a streaming batch RPC, by RPC name
+var Batch = []struct{ RPC, Operation string }{
+	{"BatchEnrichCompanies", "ENC"},
+	{"BatchEnrichPeople", "TEP"},
+	{"BatchEnrichProfiles", "EPP"},
+}
+
+// Pages lists the search operations with a streaming page RPC, by RPC name
+var Pages = []struct{ RPC, Operation string }{
+	{"SearchCompanyPages", "CSE"},
+	{"SearchPeoplePages", "PSE"},
+	{"SearchLocalBusinessPages", "LBS"},
+}
+
+var optionalType = reflect.TypeOf(cufinder.Optional[int]{})
+
+type generator struct {
+	b       strings.Builder
+	emitted map[reflect.Type]bool
+	queue   []reflect.Type
+}
+
+// Generate returns the .proto definition
+func Generate() []byte {
+	g := &generator{emitted: make(map[reflect.Type]bool)}
+	g.line("// Code generated by go generate ./proto. DO NOT EDIT.")
+	g.line("")
+	g.line(`syntax = "proto3";`)
+	g.line("")
+	g.line("package cufinder.v1;")
+	g.line("")
+	g.line(`import "google/protobuf/struct.proto";`)
+	g.line("")
+	g.line(`option go_package = "github.com/cufinder/cufinder-go/proto/cufinder/v1;cufinderv1";`)
+	g.line("")
+
+	g.line("// Cufinder exposes every CUFinder API operation")
+	g.line("service Cufinder {")
+	for _, op := range cufinder.Operations {
+		g.line("  // %s - %s", op.Name, op.Description)
+		g.line("  rpc %s(%s) returns (%s);", rpcName(op.Name), op.ParamsType().Name(), op.ResponseType().Name())
+	}
+	for _, batch := range Batch {
+		op := mustOperation(batch.Operation)
+		g.line("")
+		g.line("  // %s runs %s for every request on the stream. Results carry the", batch.RPC, op.Name)
+		g.line("  // index of their request and may arrive out of order.")
+		g.line("  rpc %s(stream %s) returns (stream %s);", batch.RPC, op.ParamsType().Name(), batchResult(op))
+	}
+	for _, pages := range Pages {
+		op := mustOperation(pages.Operation)
+		g.line("")
+		g.line("  // %s streams %s result pages, starting at params.page, until a", pages.RPC, op.Name)
+		g.line("  // page is empty or max_pages pages were sent.")
+		g.line("  rpc %s(%s) returns (stream %s);", pages.RPC, pagesRequest(op), op.ResponseType().Name())
+	}
+	g.line("}")
+
+	for _, op := range cufinder.Operations {
+		g.message(op.ParamsType())
+		g.message(op.ResponseType())
+	}
+	for _, batch := range Batch {
+		op := mustOperation(batch.Operation)
+		g.line("")
+		g.line("message %s {", batchResult(op))
+		g.line("  uint32 index = 1;")
+		g.line("  %s response = 2;", op.ResponseType().Name())
+		g.line("  string error = 3;")
+		g.line("}")
+	}
+	for _, pages := range Pages {
+		op := mustOperation(pages.Operation)
+		g.line("")
+		g.line("message %s {", pagesRequest(op))
+		g.line("  %s params = 1;", op.ParamsType().Name())
+		g.line("  uint32 max_pages = 2;")
+		g.line("}")
+	}
+	for len(g.queue) > 0 {
+		t := g.queue[0]
+		g.queue = g.queue[1:]
+		g.message(t)
+	}
+	return []byte(g.b.String())
+}
+
+func mustOperation(name string) cufinder.Operation {
+	op, ok := cufinder.LookupOperation(name)
+	if !ok {
+		panic("unknown operation " + name)
+	}
+	return op
+}
+
+func rpcName(code string) string {
+	return code[:1] + strings.ToLower(code[1:])
+}
+
+func batchResult(op cufinder.Operation) string {
+	return rpcName(op.Name) + "BatchResult"
+}
+
+func pagesRequest(op cufinder.Operation) string {
+	return rpcName(op.Name) + "PagesRequest"
+}
+
+func (g *generator) line(format string, args ...interface{}) {
+	fmt.Fprintf(&g.b, format, args...)
+	g.b.WriteByte('\n')
+}
+
+func (g *generator) message(t reflect.Type) {
+	if g.emitted[t] {
+		return
+	}
+	g.emitted[t] = true
+
+	g.line("")
+	g.line("message %s {", t.Name())
+	number := 0
+	g.fields(t, &number)
+	g.line("}")
+}
+
+func (g *generator) fields(t reflect.Type, number *int) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			g.fields(field.Type, number)
+			continue
+		}
+
+		tag, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if tag == "-" {
+			continue
+		}
+		name, option := tag, ""
+		if name == "" {
+			name = snakeCase(field.Name)
+			option = fmt.Sprintf(` [json_name = "%s"]`, field.Name)
+		}
+
+		*number++
+		g.line("  %s %s = %d%s;", g.fieldType(field.Type), name, *number, option)
+	}
+}
+
+// fieldType returns the type of a field with its label
+func (g *generator) fieldType(t reflect.Type) string {
+	if t.Kind() == reflect.Pointer {
+		return labelled("optional", g.scalar(t.Elem()))
+	}
+	if t.Kind() == reflect.Struct && t.PkgPath() == optionalType.PkgPath() && strings.HasPrefix(t.Name(), "Optional[") {
+		value, _ := t.MethodByName("Value")
+		return labelled("optional", g.scalar(value.Type.Out(0)))
+	}
+	if t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8 {
+		return "repeated " + g.scalar(t.Elem())
+	}
+	return g.scalar(t)
+}
+
+// labelled adds a label to scalars; message fields already have presence
+func labelled(label, typ string) string {
+	if strings.Contains(typ, ".") || unicode.IsUpper(rune(typ[0])) {
+		return typ
+	}
+	return label + " " + typ
+}
+
+func (g *generator) scalar(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "bool"
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return "int32"
+	case reflect.Int, reflect.Int64:
+		return "int64"
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return "uint32"
+	case reflect.Uint, reflect.Uint64:
+		return "uint64"
+	case reflect.Float32:
+		return "float"
+	case reflect.Float64:
+		return "double"
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return "bytes"
+		}
+	case reflect.Interface:
+		return "google.protobuf.Value"
+	case reflect.Map:
+		if t.Key().Kind() == reflect.String && t.Elem().Kind() == reflect.Interface {
+			return "google.protobuf.Struct"
+		}
+		return fmt.Sprintf("map<%s, %s>", g.scalar(t.Key()), g.scalar(t.Elem()))
+	case reflect.Struct:
+		g.queue = append(g.queue, t)
+		return t.Name()
+	}
+	panic(fmt.Sprintf("proto: unsupported type %s", t))
+}
+
+// snakeCase converts a Go field name, as in "LocationNames" or "ICPScore"
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prevLower := unicode.IsLower(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || (nextLower && unicode.IsUpper(runes[i-1])) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,55 @@
+package proto
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/cufinder/cufinder-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateUpToDate(t *testing.T) {
+	data, err := os.ReadFile("cufinder/v1/cufinder.proto")
+	require.NoError(t, err)
+	assert.Equal(t, string(Generate()), string(data), "run go generate ./proto")
+}
+
+func TestGenerateServerUpToDate(t *testing.T) {
+	data, err := os.ReadFile("rpc/methods.go")
+	require.NoError(t, err)
+	assert.Equal(t, string(GenerateServer()), string(data), "run go generate ./proto")
+}
+
+func TestGenerate(t *testing.T) {
+	def := string(Generate())
+
+	for _, op := range cufinder.Operations {
+		assert.Contains(t, def, "rpc "+rpcName(op.Name)+"("+op.ParamsType().Name()+") returns ("+op.ResponseType().Name()+");")
+		assert.Contains(t, def, "\nmessage "+op.ParamsType().Name()+" {\n")
+		assert.Contains(t, def, "\nmessage "+op.ResponseType().Name()+" {\n")
+	}
+
+	assert.Contains(t, def, "message CufParams {\n  string company_name = 1;\n  string country_code = 2;\n}\n")
+	assert.Contains(t, def, "rpc BatchEnrichCompanies(stream EncParams) returns (stream EncBatchResult);")
+	assert.Contains(t, def, "rpc SearchPeoplePages(PsePagesRequest) returns (stream PseResponse);")
+	assert.Contains(t, def, "  optional int64 followers_count_min = 5;\n")
+	assert.Contains(t, def, `  bool is_primary = 6 [json_name = "IsPrimary"];`)
+	assert.Contains(t, def, "  google.protobuf.Struct meta_data = 3;\n")
+
+	// Fields hidden from JSON are not part of the wire format
+	assert.NotContains(t, def, "email_class")
+	assert.Equal(t, 1, strings.Count(def, "\nmessage PeopleExperience {\n"))
+}
+
+func TestSnakeCase(t *testing.T) {
+	for in, want := range map[string]string{
+		"Company":       "company",
+		"LocationNames": "location_names",
+		"ICPScore":      "icp_score",
+		"IsPrimary":     "is_primary",
+	} {
+		assert.Equal(t, want, snakeCase(in))
+	}
+}
@@ -0,0 +1,29 @@
+package rpc
+
+import (
+	"google.golang.org/grpc"
+
+	cufinderv1 "github.com/cufinder/cufinder-go/proto/cufinder/v1"
+)
+
+// Client is a connection to a CUFinder gRPC server
+type Client struct {
+	cufinderv1.CufinderClient
+	conn *grpc.ClientConn
+}
+
+// NewClient creates a client for the server at target, as in
+// "dns:///cufinder.internal:8443". It does not connect until the first
+// call; transport credentials must be given in opts.
+func NewClient(target string, opts ...grpc.DialOption) (*Client, error) {
+	conn, err := grpc.NewClient(target, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{CufinderClient: cufinderv1.NewCufinderClient(conn), conn: conn}, nil
+}
+
+// Close closes the connection
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
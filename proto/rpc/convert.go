@@ -0,0 +1,126 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+// messageMap converts a message to the JSON object of the matching SDK
+// type. Unlike protojson it keeps 64-bit integers as numbers, which the SDK
+// types decode.
+func messageMap(m protoreflect.Message) map[string]interface{} {
+	out := make(map[string]interface{})
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		out[jsonKey(fd)] = fieldValue(fd, v)
+		return true
+	})
+	return out
+}
+
+// jsonKey returns the JSON key of a field: its name, which is the JSON
+// tag of the SDK field, or the json_name option given for untagged fields.
+// protoc fills in a camel-case JSON name for every other field, so only a
+// name differing from it was set in the definition.
+func jsonKey(fd protoreflect.FieldDescriptor) string {
+	name := string(fd.Name())
+	if fd.JSONName() != camelCase(name) {
+		return fd.JSONName()
+	}
+	return name
+}
+
+// camelCase returns the default JSON name of a field
+func camelCase(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range name {
+		switch {
+		case r == '_':
+			upper = true
+		case upper && 'a' <= r && r <= 'z':
+			b.WriteRune(r - 'a' + 'A')
+			upper = false
+		default:
+			b.WriteRune(r)
+			upper = false
+		}
+	}
+	return b.String()
+}
+
+func fieldValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch {
+	case fd.IsList():
+		list := v.List()
+		items := make([]interface{}, list.Len())
+		for i := range items {
+			items[i] = singular(fd, list.Get(i))
+		}
+		return items
+	case fd.IsMap():
+		entries := make(map[string]interface{})
+		v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			entries[k.String()] = singular(fd.MapValue(), v)
+			return true
+		})
+		return entries
+	}
+	return singular(fd, v)
+}
+
+func singular(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		switch m := v.Message().Interface().(type) {
+		case *structpb.Struct:
+			return m.AsMap()
+		case *structpb.Value:
+			return m.AsInterface()
+		}
+		return messageMap(v.Message())
+	case protoreflect.EnumKind:
+		return int32(v.Enum())
+	}
+	return v.Interface()
+}
+
+// toParams converts a Params message to new parameters of op
+func toParams(op cufinder.Operation, m proto.Message) (interface{}, error) {
+	return decodeParams(op, messageMap(m.ProtoReflect()))
+}
+
+func decodeParams(op cufinder.Operation, fields map[string]interface{}) (interface{}, error) {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	params := op.Params()
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(params); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", op.ParamsType().Name(), err)
+	}
+	return params, nil
+}
+
+// fromResponse converts an SDK response to its Response message. protojson
+// accepts 64-bit integers as JSON numbers, so the SDK's JSON is read as is.
+func fromResponse(response interface{}, out proto.Message) error {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to convert %T: %w", response, err)
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+// Code generated by go generate ./proto. DO NOT EDIT.
+
+package rpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	cufinderv1 "github.com/cufinder/cufinder-go/proto/cufinder/v1"
+)
+
+// Cuf calls CUF - Get company domain from company name
+func (s *Server) Cuf(ctx context.Context, in *cufinderv1.CufParams) (*cufinderv1.CufResponse, error) {
+	return unary[*cufinderv1.CufResponse](ctx, s, "Cuf", in)
+}
+
+// Lcuf calls LCUF - Get LinkedIn URL from company name
+func (s *Server) Lcuf(ctx context.Context, in *cufinderv1.LcufParams) (*cufinderv1.LcufResponse, error) {
+	return unary[*cufinderv1.LcufResponse](ctx, s, "Lcuf", in)
+}
+
+// Dtc calls DTC - Get company name from domain
+func (s *Server) Dtc(ctx context.Context, in *cufinderv1.DtcParams) (*cufinderv1.DtcResponse, error) {
+	return unary[*cufinderv1.DtcResponse](ctx, s, "Dtc", in)
+}
+
+// Dte calls DTE - Get company emails from domain
+func (s *Server) Dte(ctx context.Context, in *cufinderv1.DteParams) (*cufinderv1.DteResponse, error) {
+	return unary[*cufinderv1.DteResponse](ctx, s, "Dte", in)
+}
+
+// Ntp calls NTP - Get company phones from company name
+func (s *Server) Ntp(ctx context.Context, in *cufinderv1.NtpParams) (*cufinderv1.NtpResponse, error) {
+	return unary[*cufinderv1.NtpResponse](ctx, s, "Ntp", in)
+}
+
+// Epp calls EPP - Enrich LinkedIn profile
+func (s *Server) Epp(ctx context.Context, in *cufinderv1.EppParams) (*cufinderv1.EppResponse, error) {
+	return unary[*cufinderv1.EppResponse](ctx, s, "Epp", in)
+}
+
+// Rel calls REL - Reverse email lookup
+func (s *Server) Rel(ctx context.Context, in *cufinderv1.RelParams) (*cufinderv1.RelResponse, error) {
+	return unary[*cufinderv1.RelResponse](ctx, s, "Rel", in)
+}
+
+// Fwe calls FWE - Get email from profile
+func (s *Server) Fwe(ctx context.Context, in *cufinderv1.FweParams) (*cufinderv1.FweResponse, error) {
+	return unary[*cufinderv1.FweResponse](ctx, s, "Fwe", in)
+}
+
+// Tep calls TEP - Enrich person information
+func (s *Server) Tep(ctx context.Context, in *cufinderv1.TepParams) (*cufinderv1.TepResponse, error) {
+	return unary[*cufinderv1.TepResponse](ctx, s, "Tep", in)
+}
+
+// Fcl calls FCL - Get company lookalikes
+func (s *Server) Fcl(ctx context.Context, in *cufinderv1.FclParams) (*cufinderv1.FclResponse, error) {
+	return unary[*cufinderv1.FclResponse](ctx, s, "Fcl", in)
+}
+
+// Elf calls ELF - Get company fundraising information
+func (s *Server) Elf(ctx context.Context, in *cufinderv1.ElfParams) (*cufinderv1.ElfResponse, error) {
+	return unary[*cufinderv1.ElfResponse](ctx, s, "Elf", in)
+}
+
+// Car calls CAR - Get company revenue
+func (s *Server) Car(ctx context.Context, in *cufinderv1.CarParams) (*cufinderv1.CarResponse, error) {
+	return unary[*cufinderv1.CarResponse](ctx, s, "Car", in)
+}
+
+// Fcc calls FCC - Get company subsidiaries
+func (s *Server) Fcc(ctx context.Context, in *cufinderv1.FccParams) (*cufinderv1.FccResponse, error) {
+	return unary[*cufinderv1.FccResponse](ctx, s, "Fcc", in)
+}
+
+// Fts calls FTS - Get company tech stack
+func (s *Server) Fts(ctx context.Context, in *cufinderv1.FtsParams) (*cufinderv1.FtsResponse, error) {
+	return unary[*cufinderv1.FtsResponse](ctx, s, "Fts", in)
+}
+
+// Enc calls ENC - Enrich company information
+func (s *Server) Enc(ctx context.Context, in *cufinderv1.EncParams) (*cufinderv1.EncResponse, error) {
+	return unary[*cufinderv1.EncResponse](ctx, s, "Enc", in)
+}
+
+// Cec calls CEC - Get company employee countries
+func (s *Server) Cec(ctx context.Context, in *cufinderv1.CecParams) (*cufinderv1.CecResponse, error) {
+	return unary[*cufinderv1.CecResponse](ctx, s, "Cec", in)
+}
+
+// Clo calls CLO - Get company locations
+func (s *Server) Clo(ctx context.Context, in *cufinderv1.CloParams) (*cufinderv1.CloResponse, error) {
+	return unary[*cufinderv1.CloResponse](ctx, s, "Clo", in)
+}
+
+// Cse calls CSE - Search companies
+func (s *Server) Cse(ctx context.Context, in *cufinderv1.CseParams) (*cufinderv1.CseResponse, error) {
+	return unary[*cufinderv1.CseResponse](ctx, s, "Cse", in)
+}
+
+// Pse calls PSE - Search people
+func (s *Server) Pse(ctx context.Context, in *cufinderv1.PseParams) (*cufinderv1.PseResponse, error) {
+	return unary[*cufinderv1.PseResponse](ctx, s, "Pse", in)
+}
+
+// Lbs calls LBS - Search local businesses
+func (s *Server) Lbs(ctx context.Context, in *cufinderv1.LbsParams) (*cufinderv1.LbsResponse, error) {
+	return unary[*cufinderv1.LbsResponse](ctx, s, "Lbs", in)
+}
+
+// Bcd calls BCD - B2B Customers Finder
+func (s *Server) Bcd(ctx context.Context, in *cufinderv1.BcdParams) (*cufinderv1.BcdResponse, error) {
+	return unary[*cufinderv1.BcdResponse](ctx, s, "Bcd", in)
+}
+
+// Ccp calls CCP - Company Career Page Finder
+func (s *Server) Ccp(ctx context.Context, in *cufinderv1.CcpParams) (*cufinderv1.CcpResponse, error) {
+	return unary[*cufinderv1.CcpResponse](ctx, s, "Ccp", in)
+}
+
+// Isc calls ISC - Company Saas Checker
+func (s *Server) Isc(ctx context.Context, in *cufinderv1.IscParams) (*cufinderv1.IscResponse, error) {
+	return unary[*cufinderv1.IscResponse](ctx, s, "Isc", in)
+}
+
+// Cbc calls CBC - Company B2B or B2C Checker
+func (s *Server) Cbc(ctx context.Context, in *cufinderv1.CbcParams) (*cufinderv1.CbcResponse, error) {
+	return unary[*cufinderv1.CbcResponse](ctx, s, "Cbc", in)
+}
+
+// Csc calls CSC - Company Mission Statement
+func (s *Server) Csc(ctx context.Context, in *cufinderv1.CscParams) (*cufinderv1.CscResponse, error) {
+	return unary[*cufinderv1.CscResponse](ctx, s, "Csc", in)
+}
+
+// Csn calls CSN - Company Snapshot
+func (s *Server) Csn(ctx context.Context, in *cufinderv1.CsnParams) (*cufinderv1.CsnResponse, error) {
+	return unary[*cufinderv1.CsnResponse](ctx, s, "Csn", in)
+}
+
+// Nao calls NAO - Phone Number Normalizer
+func (s *Server) Nao(ctx context.Context, in *cufinderv1.NaoParams) (*cufinderv1.NaoResponse, error) {
+	return unary[*cufinderv1.NaoResponse](ctx, s, "Nao", in)
+}
+
+// Naa calls NAA - Address Normalizer
+func (s *Server) Naa(ctx context.Context, in *cufinderv1.NaaParams) (*cufinderv1.NaaResponse, error) {
+	return unary[*cufinderv1.NaaResponse](ctx, s, "Naa", in)
+}
+
+// BatchEnrichCompanies calls ENC for every request on the stream
+func (s *Server) BatchEnrichCompanies(stream grpc.BidiStreamingServer[cufinderv1.EncParams, cufinderv1.EncBatchResult]) error {
+	return s.batch(stream, "BatchEnrichCompanies")
+}
+
+// BatchEnrichPeople calls TEP for every request on the stream
+func (s *Server) BatchEnrichPeople(stream grpc.BidiStreamingServer[cufinderv1.TepParams, cufinderv1.TepBatchResult]) error {
+	return s.batch(stream, "BatchEnrichPeople")
+}
+
+// BatchEnrichProfiles calls EPP for every request on the stream
+func (s *Server) BatchEnrichProfiles(stream grpc.BidiStreamingServer[cufinderv1.EppParams, cufinderv1.EppBatchResult]) error {
+	return s.batch(stream, "BatchEnrichProfiles")
+}
+
+// SearchCompanyPages calls CSE once per result page
+func (s *Server) SearchCompanyPages(req *cufinderv1.CsePagesRequest, stream grpc.ServerStreamingServer[cufinderv1.CseResponse]) error {
+	return s.pages(stream, "SearchCompanyPages", req)
+}
+
+// SearchPeoplePages calls PSE once per result page
+func (s *Server) SearchPeoplePages(req *cufinderv1.PsePagesRequest, stream grpc.ServerStreamingServer[cufinderv1.PseResponse]) error {
+	return s.pages(stream, "SearchPeoplePages", req)
+}
+
+// SearchLocalBusinessPages calls LBS once per result page
+func (s *Server) SearchLocalBusinessPages(req *cufinderv1.LbsPagesRequest, stream grpc.ServerStreamingServer[cufinderv1.LbsResponse]) error {
+	return s.pages(stream, "SearchLocalBusinessPages", req)
+}
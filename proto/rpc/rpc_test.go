@@ -0,0 +1,237 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cufinder/cufinder-go"
+	cufinderv1 "github.com/cufinder/cufinder-go/proto/cufinder/v1"
+)
+
+// newTestClient serves a fake API through a gRPC server on an in-memory
+// listener and returns a client for it
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	api := httptest.NewServer(handler)
+	t.Cleanup(api.Close)
+	client := cufinder.NewClient(cufinder.ClientConfig{APIKey: "api-key", BaseURL: api.URL})
+
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	NewServer(cufinder.NewService(client)).Register(grpcServer)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	c, err := NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func writeJSON(w http.ResponseWriter, body map[string]interface{}) {
+	body["credit_count"] = 1
+	json.NewEncoder(w).Encode(body)
+}
+
+func TestUnary(t *testing.T) {
+	var form atomic.Value
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		form.Store(r.PostForm)
+		switch r.URL.Path {
+		case "/cuf":
+			writeJSON(w, map[string]interface{}{"domain": "techcorp.com", "confidence_level": 95, "query": map[string]interface{}{"company_name": "TechCorp"}})
+		case "/cse":
+			writeJSON(w, map[string]interface{}{"companies": []map[string]interface{}{{"name": "TechCorp", "employees": map[string]interface{}{"range": "51-200"}}}})
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	ctx := context.Background()
+
+	t.Run("Call", func(t *testing.T) {
+		resp, err := c.Cuf(ctx, &cufinderv1.CufParams{CompanyName: "TechCorp", CountryCode: "US"})
+		require.NoError(t, err)
+		assert.Equal(t, "techcorp.com", resp.Domain)
+		assert.Equal(t, int64(95), resp.ConfidenceLevel)
+		assert.Equal(t, int64(1), resp.CreditCount)
+		assert.Equal(t, "TechCorp", resp.Query.GetStructValue().AsMap()["company_name"])
+		assert.Equal(t, "US", form.Load().(url.Values)["country_code"][0])
+	})
+
+	t.Run("Optional Fields", func(t *testing.T) {
+		resp, err := c.Cse(ctx, &cufinderv1.CseParams{Name: "tech", FollowersCountMin: proto.Int64(0)})
+		require.NoError(t, err)
+		require.Len(t, resp.Companies, 1)
+		assert.Equal(t, "TechCorp", resp.Companies[0].Name)
+		sent := form.Load().(url.Values)
+		assert.Equal(t, []string{"0"}, sent["followers_count_min"], "a set zero is sent")
+		assert.NotContains(t, sent, "followers_count_max")
+	})
+
+	t.Run("Validation Error", func(t *testing.T) {
+		_, err := c.Cuf(ctx, &cufinderv1.CufParams{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), "company_name")
+	})
+
+	t.Run("API Error", func(t *testing.T) {
+		_, err := c.Dtc(ctx, &cufinderv1.DtcParams{CompanyWebsite: "techcorp.com"})
+		assert.Equal(t, codes.Unknown, status.Code(err))
+	})
+}
+
+func TestErrorCodes(t *testing.T) {
+	// The fake API answers with the status named by the domain, as in
+	// "402.example.com"
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		code, err := strconv.Atoi(strings.Split(r.PostForm.Get("company_website"), ".")[0])
+		require.NoError(t, err)
+		w.WriteHeader(code)
+	})
+
+	for httpStatus, code := range map[int]codes.Code{
+		http.StatusBadRequest:          codes.InvalidArgument,
+		http.StatusUnauthorized:        codes.Unauthenticated,
+		http.StatusPaymentRequired:     codes.FailedPrecondition,
+		http.StatusForbidden:           codes.PermissionDenied,
+		http.StatusNotFound:            codes.NotFound,
+		http.StatusTooManyRequests:     codes.ResourceExhausted,
+		http.StatusInternalServerError: codes.Unknown,
+		http.StatusServiceUnavailable:  codes.Unavailable,
+	} {
+		_, err := c.Dtc(context.Background(), &cufinderv1.DtcParams{CompanyWebsite: strconv.Itoa(httpStatus) + ".example.com"})
+		assert.Equal(t, code, status.Code(err), httpStatus)
+	}
+
+	// Transport failures are worth retrying
+	api := httptest.NewServer(http.NotFoundHandler())
+	api.Close()
+	client := cufinder.NewClient(cufinder.ClientConfig{APIKey: "api-key", BaseURL: api.URL, MaxRetries: 1})
+	_, err := NewServer(cufinder.NewService(client)).Dtc(context.Background(), &cufinderv1.DtcParams{CompanyWebsite: "techcorp.com"})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestServiceMethods(t *testing.T) {
+	grpcServer := grpc.NewServer()
+	NewServer(cufinder.NewService(cufinder.NewClient(cufinder.ClientConfig{APIKey: "api-key"}))).Register(grpcServer)
+
+	info := grpcServer.GetServiceInfo()["cufinder.v1.Cufinder"]
+	assert.Len(t, info.Methods, len(cufinder.Operations)+6)
+	assert.Equal(t, "cufinder/v1/cufinder.proto", info.Metadata)
+}
+
+func TestBatch(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		query := r.PostForm.Get("query")
+		if query == "broken.com" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, map[string]interface{}{"company": map[string]interface{}{"name": "Acme", "domain": query}})
+	})
+
+	stream, err := c.BatchEnrichCompanies(context.Background())
+	require.NoError(t, err)
+	queries := []string{"acme.com", "broken.com", "", "acme.org"}
+	for _, q := range queries {
+		require.NoError(t, stream.Send(&cufinderv1.EncParams{Query: q}))
+	}
+	require.NoError(t, stream.CloseSend())
+
+	var results []*cufinderv1.EncBatchResult
+	for {
+		result, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		results = append(results, result)
+	}
+	require.Len(t, results, len(queries))
+	sort.Slice(results, func(i, j int) bool { return results[i].Index < results[j].Index })
+
+	assert.Equal(t, "acme.com", results[0].Response.Company.Domain)
+	assert.Contains(t, results[1].Error, "500")
+	assert.Nil(t, results[1].Response)
+	assert.Contains(t, results[2].Error, "query")
+	assert.Equal(t, "acme.org", results[3].Response.Company.Domain)
+	assert.Empty(t, results[3].Error)
+}
+
+func TestPages(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		calls.Add(1)
+		if r.PostForm.Get("name") == "broken" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		page, _ := strconv.Atoi(r.PostForm.Get("page"))
+		var companies []map[string]interface{}
+		if page <= 3 {
+			companies = append(companies, map[string]interface{}{"name": "Company " + strconv.Itoa(page)})
+		}
+		writeJSON(w, map[string]interface{}{"companies": companies})
+	})
+
+	pages := func(t *testing.T, req *cufinderv1.CsePagesRequest) []string {
+		calls.Store(0)
+		stream, err := c.SearchCompanyPages(context.Background(), req)
+		require.NoError(t, err)
+		var names []string
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				return names
+			}
+			require.NoError(t, err)
+			for _, company := range resp.Companies {
+				names = append(names, company.Name)
+			}
+		}
+	}
+
+	t.Run("Until Empty", func(t *testing.T) {
+		names := pages(t, &cufinderv1.CsePagesRequest{Params: &cufinderv1.CseParams{Name: "tech"}})
+		assert.Equal(t, []string{"Company 1", "Company 2", "Company 3"}, names)
+		assert.Equal(t, int32(4), calls.Load())
+	})
+
+	t.Run("Max Pages", func(t *testing.T) {
+		names := pages(t, &cufinderv1.CsePagesRequest{Params: &cufinderv1.CseParams{Name: "tech", Page: 2}, MaxPages: 1})
+		assert.Equal(t, []string{"Company 2"}, names)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("API Error", func(t *testing.T) {
+		stream, err := c.SearchCompanyPages(context.Background(), &cufinderv1.CsePagesRequest{Params: &cufinderv1.CseParams{Name: "broken"}})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.Unknown, status.Code(err))
+	})
+}
@@ -0,0 +1,307 @@
+// Package rpc serves the CUFinder API over gRPC with the service defined in
+// proto/cufinder/v1, and connects clients to it.
+//
+// Every RPC is routed through cufinder.Operations: unary RPCs call their
+// operation once, batch RPCs call it for every request on the stream, and
+// page RPCs call it once per result page. Messages are converted to and
+// from the SDK types by their JSON field names, so the server follows the
+// SDK as operations are added:
+//
+//	grpcServer := grpc.NewServer()
+//	rpc.NewServer(cufinder.NewService(client)).Register(grpcServer)
+//	err := grpcServer.Serve(listener)
+//
+// Local validation errors are returned with codes.InvalidArgument. API
+// errors are mapped by their HTTP status, as in codes.Unauthenticated for
+// 401 and codes.FailedPrecondition for 402 (no credits left); only
+// transport failures and 502, 503 and 504 responses, which are worth
+// retrying, return codes.Unavailable.
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/cufinder/cufinder-go"
+	cfproto "github.com/cufinder/cufinder-go/proto"
+	cufinderv1 "github.com/cufinder/cufinder-go/proto/cufinder/v1"
+)
+
+// DefaultBatchConcurrency is the number of requests of a batch stream
+// served at once
+const DefaultBatchConcurrency = 4
+
+// Server implements cufinderv1.CufinderServer. Its methods are generated
+// from cufinder.Operations into methods.go by go generate ./proto.
+type Server struct {
+	cufinderv1.UnimplementedCufinderServer
+
+	service *cufinder.Service
+	// methods maps RPC names to their operation and descriptor
+	methods map[string]method
+	// BatchConcurrency is the number of requests of a batch stream served
+	// at once. Defaults to DefaultBatchConcurrency.
+	BatchConcurrency int
+}
+
+type method struct {
+	op   cufinder.Operation
+	desc protoreflect.MethodDescriptor
+}
+
+var _ cufinderv1.CufinderServer = (*Server)(nil)
+
+// NewServer creates a server calling the API through service
+func NewServer(service *cufinder.Service) *Server {
+	s := &Server{service: service, methods: make(map[string]method), BatchConcurrency: DefaultBatchConcurrency}
+
+	methods := cufinderv1.File_cufinder_v1_cufinder_proto.Services().ByName("Cufinder").Methods()
+	for i := 0; i < methods.Len(); i++ {
+		m := methods.Get(i)
+		name := string(m.Name())
+		var op cufinder.Operation
+		switch {
+		case m.IsStreamingClient():
+			op = streamOperation(cfproto.Batch, name)
+		case m.IsStreamingServer():
+			op = streamOperation(cfproto.Pages, name)
+		default:
+			var ok bool
+			if op, ok = cufinder.LookupOperation(name); !ok {
+				panic("rpc: no operation for " + name)
+			}
+		}
+		s.methods[name] = method{op: op, desc: m}
+	}
+	return s
+}
+
+// Register registers the service with a gRPC server
+func (s *Server) Register(r grpc.ServiceRegistrar) {
+	cufinderv1.RegisterCufinderServer(r, s)
+}
+
+func streamOperation(rpcs []struct{ RPC, Operation string }, name string) cufinder.Operation {
+	for _, rpc := range rpcs {
+		if rpc.RPC == name {
+			if op, ok := cufinder.LookupOperation(rpc.Operation); ok {
+				return op
+			}
+		}
+	}
+	panic("rpc: no operation for " + name)
+}
+
+// newMessage creates a message of a generated type
+func newMessage(md protoreflect.MessageDescriptor) proto.Message {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName())
+	if err != nil {
+		panic(err)
+	}
+	return mt.New().Interface()
+}
+
+// unary serves a unary RPC
+func unary[Out proto.Message](ctx context.Context, s *Server, rpc string, in proto.Message) (Out, error) {
+	var zero Out
+	m := s.methods[rpc]
+	out, err := s.call(ctx, m.op, m.desc.Output(), in)
+	if err != nil {
+		return zero, err
+	}
+	return out.(Out), nil
+}
+
+// call runs op for a Params message and returns its Response message
+func (s *Server) call(ctx context.Context, op cufinder.Operation, output protoreflect.MessageDescriptor, in proto.Message) (proto.Message, error) {
+	params, err := toParams(op, in)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	_, out, err := s.callParams(ctx, op, output, params)
+	return out, err
+}
+
+// callParams runs op and returns both the SDK response and its message
+func (s *Server) callParams(ctx context.Context, op cufinder.Operation, output protoreflect.MessageDescriptor, params interface{}) (interface{}, proto.Message, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, status.FromContextError(err).Err()
+	}
+	response, err := op.Call(s.service, params)
+	if err != nil {
+		return nil, nil, callError(err)
+	}
+	out := newMessage(output)
+	if err := fromResponse(response, out); err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	return response, out, nil
+}
+
+// callError converts an SDK error to a gRPC status
+func callError(err error) error {
+	var validation *cufinder.ValidationError
+	var filtered *cufinder.EmailFilterError
+	if errors.As(err, &validation) || errors.As(err, &filtered) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	var transport *url.Error
+	if errors.As(err, &transport) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	if m := apiStatus.FindStringSubmatch(err.Error()); m != nil {
+		code, _ := strconv.Atoi(m[1])
+		return status.Error(httpCode(code), err.Error())
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
+
+// apiStatus matches the HTTP status in the error of a failed API call
+var apiStatus = regexp.MustCompile(`API error: status (\d+)`)
+
+// httpCode maps an API response status to a gRPC code
+func httpCode(status int) codes.Code {
+	switch status {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusPaymentRequired:
+		return codes.FailedPrecondition
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return codes.Unavailable
+	}
+	return codes.Unknown
+}
+
+// batch serves a batch stream. Failed requests are reported in the error
+// field of their result; the stream fails only if it cannot be read or
+// written.
+func (s *Server) batch(stream grpc.ServerStream, rpc string) error {
+	op, m := s.methods[rpc].op, s.methods[rpc].desc
+	concurrency := s.BatchConcurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+	resultFields := m.Output().Fields()
+	responseMessage := resultFields.ByName("response").Message()
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		sendErr error
+		sem     = make(chan struct{}, concurrency)
+	)
+	send := func(result proto.Message) {
+		mu.Lock()
+		defer mu.Unlock()
+		if sendErr == nil {
+			sendErr = stream.SendMsg(result)
+		}
+	}
+
+	var recvErr error
+	for index := uint32(0); ; index++ {
+		in := newMessage(m.Input())
+		if err := stream.RecvMsg(in); err != nil {
+			if !errors.Is(err, io.EOF) {
+				recvErr = err
+			}
+			break
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(index uint32, in proto.Message) {
+			defer func() { <-sem; wg.Done() }()
+
+			result := newMessage(m.Output())
+			r := result.ProtoReflect()
+			r.Set(resultFields.ByName("index"), protoreflect.ValueOfUint32(index))
+			out, err := s.call(stream.Context(), op, responseMessage, in)
+			if err != nil {
+				r.Set(resultFields.ByName("error"), protoreflect.ValueOfString(status.Convert(err).Message()))
+			} else {
+				r.Set(resultFields.ByName("response"), protoreflect.ValueOfMessage(out.ProtoReflect()))
+			}
+			send(result)
+		}(index, in)
+	}
+	wg.Wait()
+
+	if recvErr != nil {
+		return recvErr
+	}
+	return sendErr
+}
+
+// pages serves a page stream, sending result pages from params.page on
+// until a page is empty or max_pages pages were sent
+func (s *Server) pages(stream grpc.ServerStream, rpc string, req proto.Message) error {
+	op, m := s.methods[rpc].op, s.methods[rpc].desc
+	fields := req.ProtoReflect().Descriptor().Fields()
+	paramsField := fields.ByName("params")
+	maxPages := req.ProtoReflect().Get(fields.ByName("max_pages")).Uint()
+
+	values := messageMap(req.ProtoReflect().Get(paramsField).Message())
+	page := int64(1)
+	if p, ok := values["page"].(int64); ok && p > 0 {
+		page = p
+	}
+
+	for sent := uint64(0); maxPages == 0 || sent < maxPages; sent++ {
+		values["page"] = page
+		params, err := decodeParams(op, values)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		response, out, err := s.callParams(stream.Context(), op, m.Output(), params)
+		if err != nil {
+			return err
+		}
+		if resultCount(response) == 0 {
+			return nil
+		}
+		if err := stream.SendMsg(out); err != nil {
+			return err
+		}
+		page++
+	}
+	return nil
+}
+
+// resultCount returns the length of the first result list of a search
+// response
+func resultCount(response interface{}) int {
+	v := reflect.ValueOf(response)
+	for v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	for i := 0; i < v.NumField(); i++ {
+		if f := v.Field(i); f.Kind() == reflect.Slice && !v.Type().Field(i).Anonymous {
+			return f.Len()
+		}
+	}
+	panic(fmt.Sprintf("rpc: %T has no result list", response))
+}
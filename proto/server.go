@@ -0,0 +1,57 @@
+package proto
+
+import (
+	"go/format"
+	"strings"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+// GenerateServer returns the Go methods with which rpc.Server implements
+// the generated CufinderServer interface, one per RPC. They are checked in
+// at rpc/methods.go and refreshed together with the definition.
+func GenerateServer() []byte {
+	g := &generator{}
+	g.line("// Code generated by go generate ./proto. DO NOT EDIT.")
+	g.line("")
+	g.line("package rpc")
+	g.line("")
+	g.line("import (")
+	g.line(`	"context"`)
+	g.line("")
+	g.line(`	"google.golang.org/grpc"`)
+	g.line("")
+	g.line(`	cufinderv1 "github.com/cufinder/cufinder-go/proto/cufinder/v1"`)
+	g.line(")")
+
+	for _, op := range cufinder.Operations {
+		name := rpcName(op.Name)
+		g.line("")
+		g.line("// %s calls %s - %s", name, op.Name, op.Description)
+		g.line("func (s *Server) %s(ctx context.Context, in *cufinderv1.%s) (*cufinderv1.%s, error) {", name, op.ParamsType().Name(), op.ResponseType().Name())
+		g.line("	return unary[*cufinderv1.%s](ctx, s, %q, in)", op.ResponseType().Name(), name)
+		g.line("}")
+	}
+	for _, batch := range Batch {
+		op := mustOperation(batch.Operation)
+		g.line("")
+		g.line("// %s calls %s for every request on the stream", batch.RPC, op.Name)
+		g.line("func (s *Server) %s(stream grpc.BidiStreamingServer[cufinderv1.%s, cufinderv1.%s]) error {", batch.RPC, op.ParamsType().Name(), batchResult(op))
+		g.line("	return s.batch(stream, %q)", batch.RPC)
+		g.line("}")
+	}
+	for _, pages := range Pages {
+		op := mustOperation(pages.Operation)
+		g.line("")
+		g.line("// %s calls %s once per result page", pages.RPC, op.Name)
+		g.line("func (s *Server) %s(req *cufinderv1.%s, stream grpc.ServerStreamingServer[cufinderv1.%s]) error {", pages.RPC, pagesRequest(op), op.ResponseType().Name())
+		g.line("	return s.pages(stream, %q, req)", pages.RPC)
+		g.line("}")
+	}
+
+	src, err := format.Source([]byte(g.b.String()))
+	if err != nil {
+		panic("proto: generated server does not parse: " + err.Error() + "\n" + strings.TrimSpace(g.b.String()))
+	}
+	return src
+}
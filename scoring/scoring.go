@@ -0,0 +1,310 @@
+// Package scoring ranks leads with weighted rules over enriched company and
+// person data.
+//
+// A Model is a list of rules, usually loaded from YAML or JSON:
+//
+//	rules:
+//	  - name: Target industry
+//	    type: industry
+//	    weight: 30
+//	    values: [software development, financial services]
+//	  - name: Mid-market
+//	    type: employees
+//	    weight: 20
+//	    min: 50
+//	    max: 1000
+//	  - name: Uses a competitor
+//	    type: technology
+//	    weight: -10
+//	    values: [Salesforce]
+//
+// Score evaluates every rule and returns the total with a per-rule breakdown.
+// Rules whose data is missing from the Input score nothing and are reported
+// as unknown rather than as a mismatch.
+package scoring
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+// RuleType selects what a rule looks at
+type RuleType string
+
+const (
+	// RuleIndustry matches the company industry against Values
+	RuleIndustry RuleType = "industry"
+	// RuleEmployees matches the company employee count against Min and Max
+	RuleEmployees RuleType = "employees"
+	// RuleCountry matches the company country against Values
+	RuleCountry RuleType = "country"
+	// RuleRevenue matches the CAR revenue band against Values
+	RuleRevenue RuleType = "revenue"
+	// RuleTechnology matches when any of Values is in the FTS tech stack
+	RuleTechnology RuleType = "technology"
+	// RuleSaas matches companies ISC reports as SaaS
+	RuleSaas RuleType = "saas"
+	// RuleB2B matches companies CBC reports as selling to businesses
+	RuleB2B RuleType = "b2b"
+	// RuleKeyword matches when any of Values appears in the company
+	// description or the CSN ICP and value proposition
+	RuleKeyword RuleType = "keyword"
+	// RuleTargetIndustry matches when the CSN target industries include any
+	// of Values
+	RuleTargetIndustry RuleType = "target_industry"
+	// RuleSeniority matches the person's seniority tier against Values
+	RuleSeniority RuleType = "seniority"
+)
+
+// RuleTypes lists every RuleType
+var RuleTypes = []RuleType{
+	RuleIndustry, RuleEmployees, RuleCountry, RuleRevenue, RuleTechnology,
+	RuleSaas, RuleB2B, RuleKeyword, RuleTargetIndustry, RuleSeniority,
+}
+
+// Rule is a weighted condition. A matching rule adds its weight to the
+// score; negative weights penalize.
+type Rule struct {
+	Name   string   `json:"name" yaml:"name"`
+	Type   RuleType `json:"type" yaml:"type"`
+	Weight float64  `json:"weight" yaml:"weight"`
+	Values []string `json:"values,omitempty" yaml:"values,omitempty"`
+	Min    *int     `json:"min,omitempty" yaml:"min,omitempty"`
+	Max    *int     `json:"max,omitempty" yaml:"max,omitempty"`
+}
+
+// Model is a set of scoring rules
+type Model struct {
+	Rules []Rule `json:"rules" yaml:"rules"`
+}
+
+// Parse reads a model from YAML or JSON and validates it
+func Parse(data []byte) (*Model, error) {
+	var m Model
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse scoring model: %w", err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// LoadFile reads a model from a YAML or JSON file
+func LoadFile(path string) (*Model, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scoring model: %w", err)
+	}
+	return Parse(data)
+}
+
+// Validate checks that every rule is complete
+func (m *Model) Validate() error {
+	for i, r := range m.Rules {
+		name := r.Name
+		if name == "" {
+			name = fmt.Sprintf("rule %d", i+1)
+		}
+
+		known := false
+		for _, t := range RuleTypes {
+			if r.Type == t {
+				known = true
+			}
+		}
+		switch {
+		case !known:
+			return fmt.Errorf("%s: unknown rule type %q", name, r.Type)
+		case r.Type == RuleEmployees && r.Min == nil && r.Max == nil:
+			return fmt.Errorf("%s: employees rule needs min or max", name)
+		case r.Type == RuleEmployees && r.Min != nil && r.Max != nil && *r.Min > *r.Max:
+			return fmt.Errorf("%s: min must not be greater than max", name)
+		case r.Type != RuleEmployees && r.Type != RuleSaas && r.Type != RuleB2B && len(r.Values) == 0:
+			return fmt.Errorf("%s: %s rule needs values", name, r.Type)
+		}
+	}
+	return nil
+}
+
+// Input is the enriched data for one lead. Any field may be nil when that
+// data was not fetched.
+type Input struct {
+	Company   *cufinder.EncCompany
+	Snapshot  *cufinder.CsnSnapshotInfo
+	TechStack *cufinder.FtsResponse
+	Revenue   *cufinder.CarResponse
+	Saas      *cufinder.IscResponse
+	Business  *cufinder.CbcResponse
+	// Job is the contact's current job, for seniority rules
+	Job *cufinder.PeopleCurrentJob
+}
+
+// RuleResult is the outcome of one rule
+type RuleResult struct {
+	Rule    string   `json:"rule"`
+	Type    RuleType `json:"type"`
+	Weight  float64  `json:"weight"`
+	Known   bool     `json:"known"`
+	Matched bool     `json:"matched"`
+	Points  float64  `json:"points"`
+	Detail  string   `json:"detail"`
+}
+
+// Result is a lead's score with its breakdown
+type Result struct {
+	Score float64 `json:"score"`
+	// MaxScore is the sum of the positive weights
+	MaxScore float64      `json:"max_score"`
+	Rules    []RuleResult `json:"rules"`
+}
+
+// Percent returns the score as a percentage of MaxScore, clamped to 0-100
+func (r Result) Percent() float64 {
+	if r.MaxScore <= 0 {
+		return 0
+	}
+	p := 100 * r.Score / r.MaxScore
+	if p < 0 {
+		return 0
+	}
+	if p > 100 {
+		return 100
+	}
+	return p
+}
+
+// Score evaluates every rule against the input
+func (m *Model) Score(in Input) Result {
+	var result Result
+	for _, rule := range m.Rules {
+		if rule.Weight > 0 {
+			result.MaxScore += rule.Weight
+		}
+
+		r := RuleResult{Rule: rule.Name, Type: rule.Type, Weight: rule.Weight}
+		r.Known, r.Matched, r.Detail = evaluate(rule, in)
+		if r.Matched {
+			r.Points = rule.Weight
+			result.Score += rule.Weight
+		}
+		result.Rules = append(result.Rules, r)
+	}
+	return result
+}
+
+// evaluate returns whether the data for a rule is known, whether it matched
+// and a description of the value considered
+func evaluate(rule Rule, in Input) (bool, bool, string) {
+	switch rule.Type {
+	case RuleIndustry:
+		if in.Company == nil || in.Company.Industry == "" {
+			return unknown("industry")
+		}
+		return matchOne(in.Company.Industry, rule.Values)
+	case RuleCountry:
+		if in.Company == nil || in.Company.Country == "" {
+			return unknown("country")
+		}
+		return matchOne(in.Company.Country, rule.Values)
+	case RuleRevenue:
+		if in.Revenue == nil || in.Revenue.Revenue == "" {
+			return unknown("revenue")
+		}
+		return matchOne(in.Revenue.Revenue, rule.Values)
+	case RuleEmployees:
+		if in.Company == nil || in.Company.EmployeeCount == 0 {
+			return unknown("employee count")
+		}
+		n := in.Company.EmployeeCount
+		matched := (rule.Min == nil || n >= *rule.Min) && (rule.Max == nil || n <= *rule.Max)
+		return true, matched, fmt.Sprintf("%d employees", n)
+	case RuleTechnology:
+		if in.TechStack == nil {
+			return unknown("tech stack")
+		}
+		for _, tech := range in.TechStack.Technologies {
+			if contains(rule.Values, tech) {
+				return true, true, "uses " + tech
+			}
+		}
+		return true, false, fmt.Sprintf("none of %s", strings.Join(rule.Values, ", "))
+	case RuleSaas:
+		if in.Saas == nil || in.Saas.IsSaas == "" {
+			return unknown("SaaS status")
+		}
+		return true, truthy(in.Saas.IsSaas), "is_saas: " + in.Saas.IsSaas
+	case RuleB2B:
+		if in.Business == nil || in.Business.BusinessType == "" {
+			return unknown("business type")
+		}
+		return true, strings.Contains(strings.ToLower(in.Business.BusinessType), "b2b"), in.Business.BusinessType
+	case RuleKeyword:
+		var texts []string
+		if in.Company != nil {
+			texts = append(texts, in.Company.Description)
+		}
+		if in.Snapshot != nil {
+			texts = append(texts, in.Snapshot.ICP, in.Snapshot.ValueProposition)
+		}
+		text := strings.ToLower(strings.Join(texts, " "))
+		if strings.TrimSpace(text) == "" {
+			return unknown("description")
+		}
+		for _, keyword := range rule.Values {
+			if strings.Contains(text, strings.ToLower(keyword)) {
+				return true, true, "mentions " + keyword
+			}
+		}
+		return true, false, fmt.Sprintf("mentions none of %s", strings.Join(rule.Values, ", "))
+	case RuleTargetIndustry:
+		if in.Snapshot == nil || len(in.Snapshot.TargetIndustries) == 0 {
+			return unknown("target industries")
+		}
+		for _, industry := range in.Snapshot.TargetIndustries {
+			if contains(rule.Values, industry) {
+				return true, true, "targets " + industry
+			}
+		}
+		return true, false, "targets " + strings.Join(in.Snapshot.TargetIndustries, ", ")
+	case RuleSeniority:
+		if in.Job == nil || (in.Job.Level == "" && in.Job.Title == "") {
+			return unknown("job")
+		}
+		tier := cufinder.ClassifySeniority(*in.Job)
+		return true, contains(rule.Values, string(tier)), string(tier)
+	}
+	return unknown(string(rule.Type))
+}
+
+func unknown(what string) (bool, bool, string) {
+	return false, false, "unknown " + what
+}
+
+func matchOne(value string, values []string) (bool, bool, string) {
+	return true, contains(values, value), value
+}
+
+// contains compares case-insensitively, ignoring surrounding space
+func contains(values []string, value string) bool {
+	value = strings.ToLower(strings.TrimSpace(value))
+	for _, v := range values {
+		if strings.ToLower(strings.TrimSpace(v)) == value {
+			return true
+		}
+	}
+	return false
+}
+
+func truthy(s string) bool {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "yes", "true", "1", "saas":
+		return true
+	}
+	return false
+}
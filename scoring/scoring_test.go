@@ -0,0 +1,122 @@
+package scoring
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+const testModel = `
+rules:
+  - name: Target industry
+    type: industry
+    weight: 30
+    values: [Software Development, Financial Services]
+  - name: Mid-market
+    type: employees
+    weight: 20
+    min: 50
+    max: 1000
+  - name: Uses Kubernetes
+    type: technology
+    weight: 15
+    values: [kubernetes]
+  - name: Uses a competitor
+    type: technology
+    weight: -10
+    values: [Salesforce]
+  - name: SaaS
+    type: saas
+    weight: 10
+  - name: B2B
+    type: b2b
+    weight: 10
+  - name: Decision maker
+    type: seniority
+    weight: 15
+    values: [executive, director]
+`
+
+func TestScore(t *testing.T) {
+	model, err := Parse([]byte(testModel))
+	require.NoError(t, err)
+
+	result := model.Score(Input{
+		Company:   &cufinder.EncCompany{Industry: "software development", EmployeeCount: 240},
+		TechStack: &cufinder.FtsResponse{Technologies: []string{"React", "Kubernetes", "Salesforce"}},
+		Saas:      &cufinder.IscResponse{IsSaas: "Yes"},
+		Job:       &cufinder.PeopleCurrentJob{Title: "VP Engineering"},
+	})
+
+	assert.Equal(t, 80.0, result.Score)
+	assert.Equal(t, 100.0, result.MaxScore)
+	assert.Equal(t, 80.0, result.Percent())
+
+	require.Len(t, result.Rules, 7)
+	assert.Equal(t, RuleResult{
+		Rule: "Uses Kubernetes", Type: RuleTechnology, Weight: 15,
+		Known: true, Matched: true, Points: 15, Detail: "uses Kubernetes",
+	}, result.Rules[2])
+	assert.Equal(t, -10.0, result.Rules[3].Points)
+	assert.Equal(t, RuleResult{
+		Rule: "B2B", Type: RuleB2B, Weight: 10, Detail: "unknown business type",
+	}, result.Rules[5])
+	assert.Equal(t, "executive", result.Rules[6].Detail)
+}
+
+func TestScoreDecodedENC(t *testing.T) {
+	model, err := Parse([]byte(testModel))
+	require.NoError(t, err)
+
+	var enc cufinder.EncResponse
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"credit_count": 1,
+		"company": {"name": "TechCorp", "industry": "Software Development", "size": "51-200", "employee_count": 120}
+	}`), &enc))
+
+	result := model.Score(Input{Company: &enc.Company})
+	assert.Equal(t, RuleResult{
+		Rule: "Target industry", Type: RuleIndustry, Weight: 30,
+		Known: true, Matched: true, Points: 30, Detail: "Software Development",
+	}, result.Rules[0])
+	assert.True(t, result.Rules[1].Matched)
+}
+
+func TestParseJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "model.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"rules": [
+		{"name": "Revenue", "type": "revenue", "weight": 5, "values": ["$10M-$50M"]},
+		{"name": "Targets healthcare", "type": "target_industry", "weight": 5, "values": ["healthcare"]}
+	]}`), 0o600))
+
+	model, err := LoadFile(path)
+	require.NoError(t, err)
+
+	result := model.Score(Input{
+		Revenue:  &cufinder.CarResponse{Revenue: "$10M-$50M"},
+		Snapshot: &cufinder.CsnSnapshotInfo{TargetIndustries: []string{"Healthcare", "Insurance"}},
+	})
+	assert.Equal(t, 10.0, result.Score)
+}
+
+func TestValidate(t *testing.T) {
+	tests := map[string]string{
+		"type: unknown":   `unknown rule type "unknown"`,
+		"type: employees": "employees rule needs min or max",
+		"type: employees\n    min: 5\n    max: 1": "min must not be greater than max",
+		"type: technology":                        "technology rule needs values",
+	}
+	for rule, message := range tests {
+		_, err := Parse([]byte("rules:\n  - name: r\n    " + rule))
+		assert.EqualError(t, err, "r: "+message)
+	}
+
+	_, err := Parse([]byte("rules:\n  - type: saas\n"))
+	assert.NoError(t, err)
+}
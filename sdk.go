@@ -1,6 +1,7 @@
 package cufinder
 
 import (
+	"context"
 	"time"
 )
 
@@ -239,7 +240,50 @@ func (s *SDK) NAA(address string) (*NaaResponse, error) {
 	})
 }
 
+// Workflows
+
+// BuildCorporateTree - Recursively map a company's subsidiaries with FCC
+func (s *SDK) BuildCorporateTree(ctx context.Context, root string, depth int) (*CorporateTree, error) {
+	return s.service.BuildCorporateTree(ctx, root, depth)
+}
+
+// BuildCorporateTreeWithConfig - Map subsidiaries with a credit cap or CUF country code
+func (s *SDK) BuildCorporateTreeWithConfig(ctx context.Context, config CorporateTreeConfig) (*CorporateTree, error) {
+	return s.service.BuildCorporateTreeWithConfig(ctx, config)
+}
+
+// ExpandLookalikes - Rank companies reachable from seeds through FCL
+func (s *SDK) ExpandLookalikes(ctx context.Context, seeds []string, hops int) (*LookalikeResult, error) {
+	return s.service.ExpandLookalikes(ctx, seeds, hops)
+}
+
+// ExpandLookalikesWithConfig - Lookalike expansion with a credit cap or profile
+func (s *SDK) ExpandLookalikesWithConfig(ctx context.Context, config LookalikeConfig) (*LookalikeResult, error) {
+	return s.service.ExpandLookalikesWithConfig(ctx, config)
+}
+
+// DiscoverBuyingCommittee - Find an account's decision makers with PSE
+func (s *SDK) DiscoverBuyingCommittee(ctx context.Context, companyDomain string, roles []JobTitleRole) (*BuyingCommittee, error) {
+	return s.service.DiscoverBuyingCommittee(ctx, companyDomain, roles)
+}
+
+// DiscoverBuyingCommitteeWithConfig - Buying committee discovery with levels, caps and email lookups
+func (s *SDK) DiscoverBuyingCommitteeWithConfig(ctx context.Context, config BuyingCommitteeConfig) (*BuyingCommittee, error) {
+	return s.service.DiscoverBuyingCommitteeWithConfig(ctx, config)
+}
+
+// CoalesceStats returns how many calls were sent and how many shared an
+// identical in-flight request
+func (s *SDK) CoalesceStats() CoalesceStats {
+	return s.service.CoalesceStats()
+}
+
 // GetClient returns the underlying HTTP client for advanced usage
 func (s *SDK) GetClient() *Client {
 	return s.client
 }
+
+// GetService returns the underlying service
+func (s *SDK) GetService() *Service {
+	return s.service
+}
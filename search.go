@@ -0,0 +1,508 @@
+package cufinder
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// EmployeeSize is a company head-count band accepted by CSE and PSE
+type EmployeeSize string
+
+const (
+	EmployeeSize1To10       EmployeeSize = "1-10"
+	EmployeeSize11To50      EmployeeSize = "11-50"
+	EmployeeSize51To200     EmployeeSize = "51-200"
+	EmployeeSize201To500    EmployeeSize = "201-500"
+	EmployeeSize501To1000   EmployeeSize = "501-1000"
+	EmployeeSize1001To5000  EmployeeSize = "1001-5000"
+	EmployeeSize5001To10000 EmployeeSize = "5001-10000"
+	EmployeeSize10001Plus   EmployeeSize = "10001+"
+)
+
+// EmployeeSizes lists every accepted EmployeeSize, smallest first
+var EmployeeSizes = []EmployeeSize{
+	EmployeeSize1To10, EmployeeSize11To50, EmployeeSize51To200, EmployeeSize201To500,
+	EmployeeSize501To1000, EmployeeSize1001To5000, EmployeeSize5001To10000, EmployeeSize10001Plus,
+}
+
+// Valid reports whether the size is one of EmployeeSizes
+func (e EmployeeSize) Valid() bool {
+	return containsEnum(EmployeeSizes, e)
+}
+
+// JobTitleLevel is the seniority of a person's current job
+type JobTitleLevel string
+
+const (
+	LevelCXO      JobTitleLevel = "cxo"
+	LevelOwner    JobTitleLevel = "owner"
+	LevelPartner  JobTitleLevel = "partner"
+	LevelVP       JobTitleLevel = "vp"
+	LevelDirector JobTitleLevel = "director"
+	LevelManager  JobTitleLevel = "manager"
+	LevelSenior   JobTitleLevel = "senior"
+	LevelEntry    JobTitleLevel = "entry"
+	LevelTraining JobTitleLevel = "training"
+	LevelUnpaid   JobTitleLevel = "unpaid"
+)
+
+// JobTitleLevels lists every accepted JobTitleLevel, most senior first
+var JobTitleLevels = []JobTitleLevel{
+	LevelCXO, LevelOwner, LevelPartner, LevelVP, LevelDirector,
+	LevelManager, LevelSenior, LevelEntry, LevelTraining, LevelUnpaid,
+}
+
+// Valid reports whether the level is one of JobTitleLevels
+func (l JobTitleLevel) Valid() bool {
+	return containsEnum(JobTitleLevels, l)
+}
+
+// JobTitleRole is the department of a person's current job
+type JobTitleRole string
+
+const (
+	RoleCustomerService JobTitleRole = "customer_service"
+	RoleDesign          JobTitleRole = "design"
+	RoleEducation       JobTitleRole = "education"
+	RoleEngineering     JobTitleRole = "engineering"
+	RoleFinance         JobTitleRole = "finance"
+	RoleHealth          JobTitleRole = "health"
+	RoleHumanResources  JobTitleRole = "human_resources"
+	RoleLegal           JobTitleRole = "legal"
+	RoleMarketing       JobTitleRole = "marketing"
+	RoleMedia           JobTitleRole = "media"
+	RoleOperations      JobTitleRole = "operations"
+	RolePublicRelations JobTitleRole = "public_relations"
+	RoleRealEstate      JobTitleRole = "real_estate"
+	RoleSales           JobTitleRole = "sales"
+	RoleTrades          JobTitleRole = "trades"
+)
+
+// JobTitleRoles lists every accepted JobTitleRole
+var JobTitleRoles = []JobTitleRole{
+	RoleCustomerService, RoleDesign, RoleEducation, RoleEngineering, RoleFinance,
+	RoleHealth, RoleHumanResources, RoleLegal, RoleMarketing, RoleMedia,
+	RoleOperations, RolePublicRelations, RoleRealEstate, RoleSales, RoleTrades,
+}
+
+// Valid reports whether the role is one of JobTitleRoles
+func (r JobTitleRole) Valid() bool {
+	return containsEnum(JobTitleRoles, r)
+}
+
+// Industry is a company industry as used by CSE, PSE and LBS filters. The
+// accepted values follow the LinkedIn industry taxonomy in lower case.
+type Industry string
+
+const (
+	IndustryAccounting                 Industry = "accounting"
+	IndustryBanking                    Industry = "banking"
+	IndustryBiotechnology              Industry = "biotechnology"
+	IndustryComputerSoftware           Industry = "computer software"
+	IndustryComputerAndNetworkSecurity Industry = "computer & network security"
+	IndustryConstruction               Industry = "construction"
+	IndustryConsumerGoods              Industry = "consumer goods"
+	IndustryEducationManagement        Industry = "education management"
+	IndustryFinancialServices          Industry = "financial services"
+	IndustryHospitalAndHealthCare      Industry = "hospital & health care"
+	IndustryInformationTechnology      Industry = "information technology and services"
+	IndustryInsurance                  Industry = "insurance"
+	IndustryInternet                   Industry = "internet"
+	IndustryManagementConsulting       Industry = "management consulting"
+	IndustryMarketingAndAdvertising    Industry = "marketing and advertising"
+	IndustryRealEstate                 Industry = "real estate"
+	IndustryRetail                     Industry = "retail"
+	IndustryStaffingAndRecruiting      Industry = "staffing and recruiting"
+	IndustryTelecommunications         Industry = "telecommunications"
+)
+
+// Industries lists every accepted Industry
+var Industries = func() []Industry {
+	names := []string{
+		"accounting", "airlines/aviation", "alternative dispute resolution", "alternative medicine",
+		"animation", "apparel & fashion", "architecture & planning", "arts and crafts", "automotive",
+		"aviation & aerospace", "banking", "biotechnology", "broadcast media", "building materials",
+		"business supplies and equipment", "capital markets", "chemicals", "civic & social organization",
+		"civil engineering", "commercial real estate", "computer & network security", "computer games",
+		"computer hardware", "computer networking", "computer software", "construction",
+		"consumer electronics", "consumer goods", "consumer services", "cosmetics", "dairy",
+		"defense & space", "design", "e-learning", "education management",
+		"electrical/electronic manufacturing", "entertainment", "environmental services",
+		"events services", "executive office", "facilities services", "farming", "financial services",
+		"fine art", "fishery", "food & beverages", "food production", "fund-raising", "furniture",
+		"gambling & casinos", "glass, ceramics & concrete", "government administration",
+		"government relations", "graphic design", "health, wellness and fitness", "higher education",
+		"hospital & health care", "hospitality", "human resources", "import and export",
+		"individual & family services", "industrial automation", "information services",
+		"information technology and services", "insurance", "international affairs",
+		"international trade and development", "internet", "investment banking",
+		"investment management", "judiciary", "law enforcement", "law practice", "legal services",
+		"legislative office", "leisure, travel & tourism", "libraries", "logistics and supply chain",
+		"luxury goods & jewelry", "machinery", "management consulting", "maritime", "market research",
+		"marketing and advertising", "mechanical or industrial engineering", "media production",
+		"medical devices", "medical practice", "mental health care", "military", "mining & metals",
+		"motion pictures and film", "museums and institutions", "music", "nanotechnology",
+		"newspapers", "non-profit organization management", "oil & energy", "online media",
+		"outsourcing/offshoring", "package/freight delivery", "packaging and containers",
+		"paper & forest products", "performing arts", "pharmaceuticals", "philanthropy",
+		"photography", "plastics", "political organization", "primary/secondary education",
+		"printing", "professional training & coaching", "program development", "public policy",
+		"public relations and communications", "public safety", "publishing", "railroad manufacture",
+		"ranching", "real estate", "recreational facilities and services", "religious institutions",
+		"renewables & environment", "research", "restaurants", "retail", "security and investigations",
+		"semiconductors", "shipbuilding", "sporting goods", "sports", "staffing and recruiting",
+		"supermarkets", "telecommunications", "textiles", "think tanks", "tobacco",
+		"translation and localization", "transportation/trucking/railroad", "utilities",
+		"venture capital & private equity", "veterinary", "warehousing", "wholesale", "wine and spirits",
+		"wireless", "writing and editing",
+	}
+	industries := make([]Industry, len(names))
+	for i, name := range names {
+		industries[i] = Industry(name)
+	}
+	return industries
+}()
+
+// Valid reports whether the industry is one of Industries
+func (i Industry) Valid() bool {
+	return containsEnum(Industries, i)
+}
+
+// containsEnum reports whether value is in values
+func containsEnum[T ~string](values []T, value T) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// enumField checks and lower-cases an optional enum filter value
+func enumField[T ~string](v *validator, field string, value *string, valid func(T) bool) {
+	if *value == "" {
+		return
+	}
+	normalized := strings.ToLower(strings.TrimSpace(*value))
+	if !valid(T(normalized)) {
+		v.add(field, *value, "is not an accepted value")
+		return
+	}
+	*value = normalized
+}
+
+// rangeField checks that an optional min/max pair is non-negative and ordered
+func rangeField(v *validator, minField, maxField string, min, max Optional[int]) {
+	lo, hasMin := min.Get()
+	hi, hasMax := max.Get()
+	if hasMin && lo < 0 {
+		v.add(minField, fmt.Sprint(lo), "must not be negative")
+	}
+	if hasMax && hi < 0 {
+		v.add(maxField, fmt.Sprint(hi), "must not be negative")
+	}
+	if hasMin && hasMax && lo > hi {
+		v.add(minField, fmt.Sprint(lo), fmt.Sprintf("must not be greater than %s (%d)", maxField, hi))
+	}
+}
+
+// setRange sets the positive bounds of a min/max pair, leaving zero bounds open
+func setRange(minField, maxField *Optional[int], min, max int) {
+	*minField, *maxField = Optional[int]{}, Optional[int]{}
+	if min != 0 {
+		*minField = Some(min)
+	}
+	if max != 0 {
+		*maxField = Some(max)
+	}
+}
+
+// validateSearch checks enum filters and numeric ranges of CSE and PSE params
+func validateSearch(v *validator, params interface{}) {
+	switch p := params.(type) {
+	case *CseParams:
+		enumField(v, "industry", &p.Industry, Industry.Valid)
+		enumField(v, "employee_size", &p.EmployeeSize, EmployeeSize.Valid)
+		rangeField(v, "followers_count_min", "followers_count_max", p.FollowersCountMin, p.FollowersCountMax)
+		rangeField(v, "founded_after_year", "founded_before_year", p.FoundedAfterYear, p.FoundedBeforeYear)
+		rangeField(v, "funding_amount_min", "funding_amount_max", p.FundingAmountMin, p.FundingAmountMax)
+		rangeField(v, "annual_revenue_min", "annual_revenue_max", p.AnnualRevenueMin, p.AnnualRevenueMax)
+	case *PseParams:
+		enumField(v, "job_title_role", &p.JobTitleRole, JobTitleRole.Valid)
+		enumField(v, "job_title_level", &p.JobTitleLevel, JobTitleLevel.Valid)
+		enumField(v, "company_industry", &p.CompanyIndustry, Industry.Valid)
+		enumField(v, "company_employee_size", &p.CompanyEmployeeSize, EmployeeSize.Valid)
+		rangeField(v, "company_annual_revenue_min", "company_annual_revenue_max", p.CompanyAnnualRevenueMin, p.CompanyAnnualRevenueMax)
+	}
+}
+
+// CompanySearch builds CSE parameters fluently
+type CompanySearch struct {
+	params CseParams
+}
+
+// NewCompanySearch starts an empty company search
+func NewCompanySearch() *CompanySearch {
+	return &CompanySearch{}
+}
+
+// Name filters by company name
+func (b *CompanySearch) Name(name string) *CompanySearch {
+	b.params.Name = name
+	return b
+}
+
+// Country filters by headquarters country
+func (b *CompanySearch) Country(country string) *CompanySearch {
+	b.params.Country = country
+	return b
+}
+
+// State filters by headquarters state
+func (b *CompanySearch) State(state string) *CompanySearch {
+	b.params.State = state
+	return b
+}
+
+// City filters by headquarters city
+func (b *CompanySearch) City(city string) *CompanySearch {
+	b.params.City = city
+	return b
+}
+
+// Industry filters by industry
+func (b *CompanySearch) Industry(industry Industry) *CompanySearch {
+	b.params.Industry = string(industry)
+	return b
+}
+
+// EmployeeSize filters by head-count band
+func (b *CompanySearch) EmployeeSize(size EmployeeSize) *CompanySearch {
+	b.params.EmployeeSize = string(size)
+	return b
+}
+
+// FollowersCount filters by LinkedIn follower count; zero leaves a bound open
+func (b *CompanySearch) FollowersCount(min, max int) *CompanySearch {
+	setRange(&b.params.FollowersCountMin, &b.params.FollowersCountMax, min, max)
+	return b
+}
+
+// FoundedAfter filters for companies founded after year
+func (b *CompanySearch) FoundedAfter(year int) *CompanySearch {
+	b.params.FoundedAfterYear = Some(year)
+	return b
+}
+
+// FoundedBefore filters for companies founded before year
+func (b *CompanySearch) FoundedBefore(year int) *CompanySearch {
+	b.params.FoundedBeforeYear = Some(year)
+	return b
+}
+
+// FundingAmount filters by total funding; zero leaves a bound open
+func (b *CompanySearch) FundingAmount(min, max int) *CompanySearch {
+	setRange(&b.params.FundingAmountMin, &b.params.FundingAmountMax, min, max)
+	return b
+}
+
+// AnnualRevenue filters by annual revenue; zero leaves a bound open
+func (b *CompanySearch) AnnualRevenue(min, max int) *CompanySearch {
+	setRange(&b.params.AnnualRevenueMin, &b.params.AnnualRevenueMax, min, max)
+	return b
+}
+
+// ProductsServices filters by offered products or services
+func (b *CompanySearch) ProductsServices(products ...string) *CompanySearch {
+	b.params.ProductsServices = append(b.params.ProductsServices, products...)
+	return b
+}
+
+// School restricts results to schools
+func (b *CompanySearch) School(isSchool bool) *CompanySearch {
+	b.params.IsSchool = Some(isSchool)
+	return b
+}
+
+// Page selects the result page
+func (b *CompanySearch) Page(page int) *CompanySearch {
+	b.params.Page = page
+	return b
+}
+
+// Build validates the search and returns the CSE parameters
+func (b *CompanySearch) Build() (CseParams, error) {
+	params := b.params
+	params.ProductsServices = append([]string(nil), b.params.ProductsServices...)
+
+	v := &validator{}
+	validateSearch(v, &params)
+	if err := v.err("CSE"); err != nil {
+		return CseParams{}, err
+	}
+	return params, nil
+}
+
+// MarshalJSON saves the search as its CSE parameters
+func (b *CompanySearch) MarshalJSON() ([]byte, error) {
+	return JSONEncoder{}.Encode(b.params)
+}
+
+// UnmarshalJSON restores a saved search, rejecting invalid filters
+func (b *CompanySearch) UnmarshalJSON(data []byte) error {
+	var params CseParams
+	if err := json.Unmarshal(data, &params); err != nil {
+		return err
+	}
+
+	v := &validator{}
+	validateSearch(v, &params)
+	if err := v.err("CSE"); err != nil {
+		return err
+	}
+
+	b.params = params
+	return nil
+}
+
+// PeopleSearch builds PSE parameters fluently
+type PeopleSearch struct {
+	params PseParams
+}
+
+// NewPeopleSearch starts an empty people search
+func NewPeopleSearch() *PeopleSearch {
+	return &PeopleSearch{}
+}
+
+// FullName filters by the person's name
+func (b *PeopleSearch) FullName(name string) *PeopleSearch {
+	b.params.FullName = name
+	return b
+}
+
+// Country filters by the person's country
+func (b *PeopleSearch) Country(country string) *PeopleSearch {
+	b.params.Country = country
+	return b
+}
+
+// State filters by the person's state
+func (b *PeopleSearch) State(state string) *PeopleSearch {
+	b.params.State = state
+	return b
+}
+
+// City filters by the person's city
+func (b *PeopleSearch) City(city string) *PeopleSearch {
+	b.params.City = city
+	return b
+}
+
+// Role filters by job department
+func (b *PeopleSearch) Role(role JobTitleRole) *PeopleSearch {
+	b.params.JobTitleRole = string(role)
+	return b
+}
+
+// Level filters by job seniority
+func (b *PeopleSearch) Level(level JobTitleLevel) *PeopleSearch {
+	b.params.JobTitleLevel = string(level)
+	return b
+}
+
+// CompanyName filters by current employer name
+func (b *PeopleSearch) CompanyName(name string) *PeopleSearch {
+	b.params.CompanyName = name
+	return b
+}
+
+// CompanyLinkedInURL filters by current employer LinkedIn page
+func (b *PeopleSearch) CompanyLinkedInURL(url string) *PeopleSearch {
+	b.params.CompanyLinkedInURL = url
+	return b
+}
+
+// CompanyCountry filters by current employer country
+func (b *PeopleSearch) CompanyCountry(country string) *PeopleSearch {
+	b.params.CompanyCountry = country
+	return b
+}
+
+// CompanyState filters by current employer state
+func (b *PeopleSearch) CompanyState(state string) *PeopleSearch {
+	b.params.CompanyState = state
+	return b
+}
+
+// CompanyCity filters by current employer city
+func (b *PeopleSearch) CompanyCity(city string) *PeopleSearch {
+	b.params.CompanyCity = city
+	return b
+}
+
+// Industry filters by current employer industry
+func (b *PeopleSearch) Industry(industry Industry) *PeopleSearch {
+	b.params.CompanyIndustry = string(industry)
+	return b
+}
+
+// CompanyEmployeeSize filters by current employer head-count band
+func (b *PeopleSearch) CompanyEmployeeSize(size EmployeeSize) *PeopleSearch {
+	b.params.CompanyEmployeeSize = string(size)
+	return b
+}
+
+// CompanyProductsServices filters by products or services of the employer
+func (b *PeopleSearch) CompanyProductsServices(products ...string) *PeopleSearch {
+	b.params.CompanyProductsServices = append(b.params.CompanyProductsServices, products...)
+	return b
+}
+
+// CompanyAnnualRevenue filters by employer revenue; zero leaves a bound open
+func (b *PeopleSearch) CompanyAnnualRevenue(min, max int) *PeopleSearch {
+	setRange(&b.params.CompanyAnnualRevenueMin, &b.params.CompanyAnnualRevenueMax, min, max)
+	return b
+}
+
+// Page selects the result page
+func (b *PeopleSearch) Page(page int) *PeopleSearch {
+	b.params.Page = page
+	return b
+}
+
+// Build validates the search and returns the PSE parameters
+func (b *PeopleSearch) Build() (PseParams, error) {
+	params := b.params
+	params.CompanyProductsServices = append([]string(nil), b.params.CompanyProductsServices...)
+
+	v := &validator{}
+	validateSearch(v, &params)
+	if err := v.err("PSE"); err != nil {
+		return PseParams{}, err
+	}
+	return params, nil
+}
+
+// MarshalJSON saves the search as its PSE parameters
+func (b *PeopleSearch) MarshalJSON() ([]byte, error) {
+	return JSONEncoder{}.Encode(b.params)
+}
+
+// UnmarshalJSON restores a saved search, rejecting invalid filters
+func (b *PeopleSearch) UnmarshalJSON(data []byte) error {
+	var params PseParams
+	if err := json.Unmarshal(data, &params); err != nil {
+		return err
+	}
+
+	v := &validator{}
+	validateSearch(v, &params)
+	if err := v.err("PSE"); err != nil {
+		return err
+	}
+
+	b.params = params
+	return nil
+}
@@ -0,0 +1,81 @@
+package cufinder
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchBuilders(t *testing.T) {
+	t.Run("People Search", func(t *testing.T) {
+		params, err := NewPeopleSearch().
+			Country("US").
+			Level(LevelCXO).
+			Role(RoleEngineering).
+			Industry(IndustryComputerSoftware).
+			CompanyEmployeeSize(EmployeeSize51To200).
+			CompanyAnnualRevenue(1000000, 5000000).
+			Build()
+		require.NoError(t, err)
+		assert.Equal(t, "US", params.Country)
+		assert.Equal(t, "cxo", params.JobTitleLevel)
+		assert.Equal(t, "engineering", params.JobTitleRole)
+		assert.Equal(t, "computer software", params.CompanyIndustry)
+		assert.Equal(t, "51-200", params.CompanyEmployeeSize)
+	})
+
+	t.Run("Company Search Ranges", func(t *testing.T) {
+		_, err := NewCompanySearch().
+			FoundedAfter(2020).
+			FoundedBefore(2010).
+			AnnualRevenue(500, 100).
+			Build()
+
+		var verr *ValidationError
+		require.True(t, errors.As(err, &verr))
+		assert.Equal(t, "CSE", verr.Service)
+		require.Len(t, verr.Fields, 2)
+		assert.Equal(t, "founded_after_year", verr.Fields[0].Field)
+		assert.Equal(t, "annual_revenue_min", verr.Fields[1].Field)
+
+		_, err = NewCompanySearch().FoundedAfter(2010).Build()
+		assert.NoError(t, err)
+	})
+
+	t.Run("Rejects Unknown Enum Values", func(t *testing.T) {
+		_, err := NewPeopleSearch().Level(JobTitleLevel("chief")).Build()
+		assert.Error(t, err)
+
+		_, err = NewCompanySearch().EmployeeSize(EmployeeSize("50-200")).Build()
+		assert.Error(t, err)
+
+		_, err = NewCompanySearch().Industry(Industry("Computer Software")).Build()
+		assert.NoError(t, err)
+	})
+
+	t.Run("Saved Search Round Trip", func(t *testing.T) {
+		search := NewCompanySearch().
+			Country("US").
+			Industry(IndustryInternet).
+			ProductsServices("crm", "analytics").
+			Page(2)
+
+		data, err := json.Marshal(search)
+		require.NoError(t, err)
+
+		restored := NewCompanySearch()
+		require.NoError(t, json.Unmarshal(data, restored))
+
+		want, err := search.Build()
+		require.NoError(t, err)
+		got, err := restored.Build()
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+
+		err = json.Unmarshal([]byte(`{"job_title_level":"boss"}`), NewPeopleSearch())
+		assert.Error(t, err)
+	})
+}
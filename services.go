@@ -7,7 +7,8 @@ import (
 
 // Service represents a base service
 type Service struct {
-	client *Client
+	client  *Client
+	flights flightGroup
 }
 
 // NewService creates a new service instance
@@ -17,14 +18,11 @@ func NewService(client *Client) *Service {
 
 // CUF Service - Company URL Finder
 func (s *Service) GetDomain(params CufParams) (*CufResponse, error) {
-	if params.CompanyName == "" {
-		return nil, fmt.Errorf("company_name is required")
-	}
-	if params.CountryCode == "" {
-		return nil, fmt.Errorf("country_code is required")
+	if err := s.validate("CUF", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/cuf", params)
+	response, err := s.post("/cuf", params)
 	if err != nil {
 		return nil, fmt.Errorf("CUF service error: %w", err)
 	}
@@ -39,11 +37,11 @@ func (s *Service) GetDomain(params CufParams) (*CufResponse, error) {
 
 // LCUF Service - LinkedIn Company URL Finder
 func (s *Service) GetLinkedInURL(params LcufParams) (*LcufResponse, error) {
-	if params.CompanyName == "" {
-		return nil, fmt.Errorf("company_name is required")
+	if err := s.validate("LCUF", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/lcuf", params)
+	response, err := s.post("/lcuf", params)
 	if err != nil {
 		return nil, fmt.Errorf("LCUF service error: %w", err)
 	}
@@ -52,17 +50,18 @@ func (s *Service) GetLinkedInURL(params LcufParams) (*LcufResponse, error) {
 	if err := mapToStruct(response, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
+	s.normalize(&result)
 
 	return &result, nil
 }
 
 // DTC Service - Domain to Company
 func (s *Service) GetCompanyName(params DtcParams) (*DtcResponse, error) {
-	if params.CompanyWebsite == "" {
-		return nil, fmt.Errorf("company_website is required")
+	if err := s.validate("DTC", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/dtc", params)
+	response, err := s.post("/dtc", params)
 	if err != nil {
 		return nil, fmt.Errorf("DTC service error: %w", err)
 	}
@@ -77,11 +76,11 @@ func (s *Service) GetCompanyName(params DtcParams) (*DtcResponse, error) {
 
 // DTE Service - Domain to Emails
 func (s *Service) GetEmails(params DteParams) (*DteResponse, error) {
-	if params.CompanyWebsite == "" {
-		return nil, fmt.Errorf("company_website is required")
+	if err := s.validate("DTE", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/dte", params)
+	response, err := s.post("/dte", params)
 	if err != nil {
 		return nil, fmt.Errorf("DTE service error: %w", err)
 	}
@@ -96,11 +95,11 @@ func (s *Service) GetEmails(params DteParams) (*DteResponse, error) {
 
 // NTP Service - Name to Phones
 func (s *Service) GetPhones(params NtpParams) (*NtpResponse, error) {
-	if params.CompanyName == "" {
-		return nil, fmt.Errorf("company_name is required")
+	if err := s.validate("NTP", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/ntp", params)
+	response, err := s.post("/ntp", params)
 	if err != nil {
 		return nil, fmt.Errorf("NTP service error: %w", err)
 	}
@@ -109,17 +108,23 @@ func (s *Service) GetPhones(params NtpParams) (*NtpResponse, error) {
 	if err := mapToStruct(response, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
+	s.normalize(&result)
 
 	return &result, nil
 }
 
 // REL Service - Reverse Email Lookup
 func (s *Service) ReverseEmailLookup(params RelParams) (*RelResponse, error) {
-	if params.Email == "" {
-		return nil, fmt.Errorf("email is required")
+	if err := s.validate("REL", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/rel", params)
+	class, err := s.client.emailFilter.check(params.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.post("/rel", params)
 	if err != nil {
 		return nil, fmt.Errorf("REL service error: %w", err)
 	}
@@ -128,17 +133,19 @@ func (s *Service) ReverseEmailLookup(params RelParams) (*RelResponse, error) {
 	if err := mapToStruct(response, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
+	s.normalize(&result)
+	result.EmailClass = class
 
 	return &result, nil
 }
 
 // FCL Service - Find Company Lookalikes
 func (s *Service) GetLookalikes(params FclParams) (*FclResponse, error) {
-	if params.Query == "" {
-		return nil, fmt.Errorf("query is required")
+	if err := s.validate("FCL", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/fcl", params)
+	response, err := s.post("/fcl", params)
 	if err != nil {
 		return nil, fmt.Errorf("FCL service error: %w", err)
 	}
@@ -147,17 +154,18 @@ func (s *Service) GetLookalikes(params FclParams) (*FclResponse, error) {
 	if err := mapToStruct(response, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
+	s.normalize(&result)
 
 	return &result, nil
 }
 
 // ELF Service - Enrich LinkedIn Fundraising
 func (s *Service) GetFundraising(params ElfParams) (*ElfResponse, error) {
-	if params.Query == "" {
-		return nil, fmt.Errorf("query is required")
+	if err := s.validate("ELF", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/elf", params)
+	response, err := s.post("/elf", params)
 	if err != nil {
 		return nil, fmt.Errorf("ELF service error: %w", err)
 	}
@@ -172,11 +180,11 @@ func (s *Service) GetFundraising(params ElfParams) (*ElfResponse, error) {
 
 // CAR Service - Company Annual Revenue
 func (s *Service) GetRevenue(params CarParams) (*CarResponse, error) {
-	if params.Query == "" {
-		return nil, fmt.Errorf("query is required")
+	if err := s.validate("CAR", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/car", params)
+	response, err := s.post("/car", params)
 	if err != nil {
 		return nil, fmt.Errorf("CAR service error: %w", err)
 	}
@@ -191,11 +199,11 @@ func (s *Service) GetRevenue(params CarParams) (*CarResponse, error) {
 
 // FCC Service - Find Company Children
 func (s *Service) GetSubsidiaries(params FccParams) (*FccResponse, error) {
-	if params.Query == "" {
-		return nil, fmt.Errorf("query is required")
+	if err := s.validate("FCC", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/fcc", params)
+	response, err := s.post("/fcc", params)
 	if err != nil {
 		return nil, fmt.Errorf("FCC service error: %w", err)
 	}
@@ -210,11 +218,11 @@ func (s *Service) GetSubsidiaries(params FccParams) (*FccResponse, error) {
 
 // FTS Service - Find Tech Stack
 func (s *Service) GetTechStack(params FtsParams) (*FtsResponse, error) {
-	if params.Query == "" {
-		return nil, fmt.Errorf("query is required")
+	if err := s.validate("FTS", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/fts", params)
+	response, err := s.post("/fts", params)
 	if err != nil {
 		return nil, fmt.Errorf("FTS service error: %w", err)
 	}
@@ -229,11 +237,11 @@ func (s *Service) GetTechStack(params FtsParams) (*FtsResponse, error) {
 
 // EPP Service - Enrich Profile
 func (s *Service) EnrichProfile(params EppParams) (*EppResponse, error) {
-	if params.LinkedInURL == "" {
-		return nil, fmt.Errorf("linkedin_url is required")
+	if err := s.validate("EPP", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/epp", params)
+	response, err := s.post("/epp", params)
 	if err != nil {
 		return nil, fmt.Errorf("EPP service error: %w", err)
 	}
@@ -242,17 +250,18 @@ func (s *Service) EnrichProfile(params EppParams) (*EppResponse, error) {
 	if err := mapToStruct(response, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
+	s.normalize(&result)
 
 	return &result, nil
 }
 
 // FWE Service - Find Work Email
 func (s *Service) GetEmailFromProfile(params FweParams) (*FweResponse, error) {
-	if params.LinkedInURL == "" {
-		return nil, fmt.Errorf("linkedin_url is required")
+	if err := s.validate("FWE", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/fwe", params)
+	response, err := s.post("/fwe", params)
 	if err != nil {
 		return nil, fmt.Errorf("FWE service error: %w", err)
 	}
@@ -267,14 +276,11 @@ func (s *Service) GetEmailFromProfile(params FweParams) (*FweResponse, error) {
 
 // TEP Service - Person Enrichment
 func (s *Service) EnrichPerson(params TepParams) (*TepResponse, error) {
-	if params.FullName == "" {
-		return nil, fmt.Errorf("full_name is required")
-	}
-	if params.Company == "" {
-		return nil, fmt.Errorf("company is required")
+	if err := s.validate("TEP", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/tep", params)
+	response, err := s.post("/tep", params)
 	if err != nil {
 		return nil, fmt.Errorf("TEP service error: %w", err)
 	}
@@ -283,17 +289,18 @@ func (s *Service) EnrichPerson(params TepParams) (*TepResponse, error) {
 	if err := mapToStruct(response, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
+	s.normalize(&result)
 
 	return &result, nil
 }
 
 // ENC Service - Company Enrichment
 func (s *Service) EnrichCompany(params EncParams) (*EncResponse, error) {
-	if params.Query == "" {
-		return nil, fmt.Errorf("query is required")
+	if err := s.validate("ENC", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/enc", params)
+	response, err := s.post("/enc", params)
 	if err != nil {
 		return nil, fmt.Errorf("ENC service error: %w", err)
 	}
@@ -302,17 +309,18 @@ func (s *Service) EnrichCompany(params EncParams) (*EncResponse, error) {
 	if err := mapToStruct(response, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
+	s.normalize(&result)
 
 	return &result, nil
 }
 
 // CEC Service - Company Employee Countries
 func (s *Service) GetEmployeeCountries(params CecParams) (*CecResponse, error) {
-	if params.Query == "" {
-		return nil, fmt.Errorf("query is required")
+	if err := s.validate("CEC", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/cec", params)
+	response, err := s.post("/cec", params)
 	if err != nil {
 		return nil, fmt.Errorf("CEC service error: %w", err)
 	}
@@ -327,11 +335,11 @@ func (s *Service) GetEmployeeCountries(params CecParams) (*CecResponse, error) {
 
 // CLO Service - Company Locations
 func (s *Service) GetLocations(params CloParams) (*CloResponse, error) {
-	if params.Query == "" {
-		return nil, fmt.Errorf("query is required")
+	if err := s.validate("CLO", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/clo", params)
+	response, err := s.post("/clo", params)
 	if err != nil {
 		return nil, fmt.Errorf("CLO service error: %w", err)
 	}
@@ -346,7 +354,11 @@ func (s *Service) GetLocations(params CloParams) (*CloResponse, error) {
 
 // CSE Service - Company Search
 func (s *Service) SearchCompanies(params CseParams) (*CseResponse, error) {
-	response, err := s.client.Post("/cse", params)
+	if err := s.validate("CSE", &params); err != nil {
+		return nil, err
+	}
+
+	response, err := s.post("/cse", params)
 	if err != nil {
 		return nil, fmt.Errorf("CSE service error: %w", err)
 	}
@@ -355,13 +367,18 @@ func (s *Service) SearchCompanies(params CseParams) (*CseResponse, error) {
 	if err := mapToStruct(response, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
+	s.normalize(&result)
 
 	return &result, nil
 }
 
 // PSE Service - Person Search
 func (s *Service) SearchPeople(params PseParams) (*PseResponse, error) {
-	response, err := s.client.Post("/pse", params)
+	if err := s.validate("PSE", &params); err != nil {
+		return nil, err
+	}
+
+	response, err := s.post("/pse", params)
 	if err != nil {
 		return nil, fmt.Errorf("PSE service error: %w", err)
 	}
@@ -370,13 +387,18 @@ func (s *Service) SearchPeople(params PseParams) (*PseResponse, error) {
 	if err := mapToStruct(response, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
+	s.normalize(&result)
 
 	return &result, nil
 }
 
 // LBS Service - Local Business Search
 func (s *Service) SearchLocalBusinesses(params LbsParams) (*LbsResponse, error) {
-	response, err := s.client.Post("/lbs", params)
+	if err := s.validate("LBS", &params); err != nil {
+		return nil, err
+	}
+
+	response, err := s.post("/lbs", params)
 	if err != nil {
 		return nil, fmt.Errorf("LBS service error: %w", err)
 	}
@@ -385,17 +407,18 @@ func (s *Service) SearchLocalBusinesses(params LbsParams) (*LbsResponse, error)
 	if err := mapToStruct(response, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
+	s.normalize(&result)
 
 	return &result, nil
 }
 
 // BCD Service - B2B Customers Finder
 func (s *Service) ExtractB2BCustomers(params BcdParams) (*BcdResponse, error) {
-	if params.Url == "" {
-		return nil, fmt.Errorf("url is required")
+	if err := s.validate("BCD", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/bcd", params)
+	response, err := s.post("/bcd", params)
 	if err != nil {
 		return nil, fmt.Errorf("BCD service error: %w", err)
 	}
@@ -410,11 +433,11 @@ func (s *Service) ExtractB2BCustomers(params BcdParams) (*BcdResponse, error) {
 
 // CCP Service - Company Career Page Finder
 func (s *Service) FindCareersPage(params CcpParams) (*CcpResponse, error) {
-	if params.Url == "" {
-		return nil, fmt.Errorf("url is required")
+	if err := s.validate("CCP", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/ccp", params)
+	response, err := s.post("/ccp", params)
 	if err != nil {
 		return nil, fmt.Errorf("CCP service error: %w", err)
 	}
@@ -429,11 +452,11 @@ func (s *Service) FindCareersPage(params CcpParams) (*CcpResponse, error) {
 
 // ISC Service - Company Saas Checker
 func (s *Service) IsSaas(params IscParams) (*IscResponse, error) {
-	if params.Url == "" {
-		return nil, fmt.Errorf("url is required")
+	if err := s.validate("ISC", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/isc", params)
+	response, err := s.post("/isc", params)
 	if err != nil {
 		return nil, fmt.Errorf("ISC service error: %w", err)
 	}
@@ -448,11 +471,11 @@ func (s *Service) IsSaas(params IscParams) (*IscResponse, error) {
 
 // CBC Service - Company B2B or B2C Checker
 func (s *Service) GetCompanyBusinessType(params CbcParams) (*CbcResponse, error) {
-	if params.Url == "" {
-		return nil, fmt.Errorf("url is required")
+	if err := s.validate("CBC", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/cbc", params)
+	response, err := s.post("/cbc", params)
 	if err != nil {
 		return nil, fmt.Errorf("CBC service error: %w", err)
 	}
@@ -467,11 +490,11 @@ func (s *Service) GetCompanyBusinessType(params CbcParams) (*CbcResponse, error)
 
 // CSC Service - Company Mission Statement
 func (s *Service) GetCompanyMissionStatement(params CscParams) (*CscResponse, error) {
-	if params.Url == "" {
-		return nil, fmt.Errorf("url is required")
+	if err := s.validate("CSC", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/csc", params)
+	response, err := s.post("/csc", params)
 	if err != nil {
 		return nil, fmt.Errorf("CSC service error: %w", err)
 	}
@@ -486,11 +509,11 @@ func (s *Service) GetCompanyMissionStatement(params CscParams) (*CscResponse, er
 
 // CSN Service - Company Snapshot
 func (s *Service) GetCompanySnapshot(params CsnParams) (*CsnResponse, error) {
-	if params.Url == "" {
-		return nil, fmt.Errorf("url is required")
+	if err := s.validate("CSN", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/csn", params)
+	response, err := s.post("/csn", params)
 	if err != nil {
 		return nil, fmt.Errorf("CSN service error: %w", err)
 	}
@@ -505,11 +528,21 @@ func (s *Service) GetCompanySnapshot(params CsnParams) (*CsnResponse, error) {
 
 // NAO Service - Phone Number Normalizer
 func (s *Service) NormalizePhone(params NaoParams) (*NaoResponse, error) {
-	if params.Phone == "" {
-		return nil, fmt.Errorf("phone is required")
+	if err := s.validate("NAO", &params); err != nil {
+		return nil, err
+	}
+
+	if number, ok := s.client.phones.parse(params.Phone); ok {
+		if s.client.phones.offline {
+			return &NaoResponse{Phone: number.String(), Number: number, Offline: true}, nil
+		}
+		// String keeps the extension that E164 leaves out
+		if !s.client.disableValidation {
+			params.Phone = number.String()
+		}
 	}
 
-	response, err := s.client.Post("/nao", params)
+	response, err := s.post("/nao", params)
 	if err != nil {
 		return nil, fmt.Errorf("NAO service error: %w", err)
 	}
@@ -518,17 +551,18 @@ func (s *Service) NormalizePhone(params NaoParams) (*NaoResponse, error) {
 	if err := mapToStruct(response, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
+	result.Number, _ = s.client.phones.parse(result.Phone)
 
 	return &result, nil
 }
 
 // NAA Service - Address Normalizer
 func (s *Service) NormalizeAddress(params NaaParams) (*NaaResponse, error) {
-	if params.Address == "" {
-		return nil, fmt.Errorf("address is required")
+	if err := s.validate("NAA", &params); err != nil {
+		return nil, err
 	}
 
-	response, err := s.client.Post("/naa", params)
+	response, err := s.post("/naa", params)
 	if err != nil {
 		return nil, fmt.Errorf("NAA service error: %w", err)
 	}
@@ -537,21 +571,90 @@ func (s *Service) NormalizeAddress(params NaaParams) (*NaaResponse, error) {
 	if err := mapToStruct(response, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
+	if s.client.structuredAddr {
+		address := ParsePostalAddress(result.Address)
+		result.PostalAddress = &address
+	}
 
 	return &result, nil
 }
 
+// validate runs local parameter validation unless it is disabled on the client
+func (s *Service) validate(service string, params interface{}) error {
+	v := &validator{}
+	v.required(params)
+	if !s.client.disableValidation {
+		v.check(params)
+	}
+	return v.err(service)
+}
+
+// post sends a request, sharing the response between concurrent identical
+// requests unless coalescing is disabled on the client. When a store is
+// configured, fresh stored responses are returned without calling the API and
+// every API response is saved; a failed save is reported to OnStoreError
+// and does not fail the call.
+func (s *Service) post(endpoint string, params interface{}) (map[string]interface{}, error) {
+	store := s.client.store
+	if store != nil && s.client.storeMaxAge > 0 {
+		response, ok, err := store.Load(endpoint, params, s.client.storeMaxAge)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load stored response: %w", err)
+		}
+		if ok {
+			return response, nil
+		}
+	}
+
+	fetch := func() (map[string]interface{}, error) {
+		response, err := s.client.Post(endpoint, params)
+		if err != nil || store == nil {
+			return response, err
+		}
+		if err := store.Save(endpoint, params, response); err != nil && s.client.onStoreError != nil {
+			s.client.onStoreError(endpoint, fmt.Errorf("failed to save response: %w", err))
+		}
+		return response, nil
+	}
+
+	if s.client.disableCoalescing {
+		return fetch()
+	}
+
+	key, err := coalesceKey(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.flights.do(key, fetch)
+}
+
+// CoalesceStats returns how many calls were sent and how many were coalesced
+func (s *Service) CoalesceStats() CoalesceStats {
+	return s.flights.stats()
+}
+
+// DecodeResponse converts a raw API response, such as one loaded from a
+// ResponseStore, into one of the response types
+func DecodeResponse(response map[string]interface{}, result interface{}) error {
+	return mapToStruct(response, result)
+}
+
 // Helper function to convert map to struct
 func mapToStruct(data map[string]interface{}, result interface{}) error {
 	// Check if the response has a "data" wrapper (like Python SDK)
 	if dataWrapper, exists := data["data"]; exists {
 		// Extract the actual data from the wrapper
 		if dataMap, ok := dataWrapper.(map[string]interface{}); ok {
+			// Copy so that shared responses are never modified
+			unwrapped := make(map[string]interface{}, len(dataMap)+1)
+			for k, v := range dataMap {
+				unwrapped[k] = v
+			}
 			// Add meta_data if it exists in the outer response
 			if metaData, metaExists := data["meta_data"]; metaExists {
-				dataMap["meta_data"] = metaData
+				unwrapped["meta_data"] = metaData
 			}
-			data = dataMap
+			data = unwrapped
 		}
 	}
 
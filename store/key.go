@@ -0,0 +1,117 @@
+package store
+
+import (
+	"strings"
+
+	"github.com/cufinder/cufinder-go"
+	"github.com/cufinder/cufinder-go/linkedin"
+)
+
+// Kind describes what a stored entity key identifies
+type Kind string
+
+const (
+	KindDomain   Kind = "domain"
+	KindLinkedIn Kind = "linkedin"
+	KindEmail    Kind = "email"
+	KindName     Kind = "name"
+	KindPerson   Kind = "person"
+	KindRequest  Kind = "request"
+)
+
+// Key returns the normalized entity a request is about. Requests that do not
+// describe a single entity, such as searches, are keyed by their parameters.
+func Key(params interface{}) (Kind, string) {
+	switch p := params.(type) {
+	case cufinder.DtcParams:
+		return websiteKey(p.CompanyWebsite)
+	case cufinder.DteParams:
+		return websiteKey(p.CompanyWebsite)
+	case cufinder.BcdParams:
+		return websiteKey(p.Url)
+	case cufinder.CcpParams:
+		return websiteKey(p.Url)
+	case cufinder.IscParams:
+		return websiteKey(p.Url)
+	case cufinder.CbcParams:
+		return websiteKey(p.Url)
+	case cufinder.CscParams:
+		return websiteKey(p.Url)
+	case cufinder.CsnParams:
+		return websiteKey(p.Url)
+	case cufinder.FclParams:
+		return queryKey(p.Query)
+	case cufinder.ElfParams:
+		return queryKey(p.Query)
+	case cufinder.CarParams:
+		return queryKey(p.Query)
+	case cufinder.FccParams:
+		return queryKey(p.Query)
+	case cufinder.FtsParams:
+		return queryKey(p.Query)
+	case cufinder.EncParams:
+		return queryKey(p.Query)
+	case cufinder.CecParams:
+		return queryKey(p.Query)
+	case cufinder.CloParams:
+		return queryKey(p.Query)
+	case cufinder.LcufParams:
+		return KindName, NormalizeName(p.CompanyName)
+	case cufinder.NtpParams:
+		return KindName, NormalizeName(p.CompanyName)
+	case cufinder.EppParams:
+		return KindLinkedIn, NormalizeLinkedIn(p.LinkedInURL)
+	case cufinder.FweParams:
+		return KindLinkedIn, NormalizeLinkedIn(p.LinkedInURL)
+	case cufinder.RelParams:
+		return KindEmail, strings.ToLower(strings.TrimSpace(p.Email))
+	case cufinder.TepParams:
+		return KindPerson, NormalizeName(p.FullName) + " @ " + NormalizeName(p.Company)
+	}
+
+	return requestKey(params)
+}
+
+// websiteKey keys a website parameter by its canonical domain
+func websiteKey(website string) (Kind, string) {
+	if domain, ok := cufinder.CanonicalDomain(website); ok {
+		return KindDomain, domain
+	}
+	return KindName, NormalizeName(website)
+}
+
+// queryKey keys a free-form company query by domain, LinkedIn URL or name
+func queryKey(query string) (Kind, string) {
+	if strings.Contains(strings.ToLower(query), "linkedin.com/") {
+		return KindLinkedIn, NormalizeLinkedIn(query)
+	}
+	if strings.Contains(query, ".") && !strings.Contains(strings.TrimSpace(query), " ") {
+		if domain, ok := cufinder.CanonicalDomain(query); ok {
+			return KindDomain, domain
+		}
+	}
+	return KindName, NormalizeName(query)
+}
+
+// requestKey keys any other request by its canonical form encoding
+func requestKey(params interface{}) (Kind, string) {
+	values, err := cufinder.FormEncoder{RepeatSlices: true}.Values(params)
+	if err != nil {
+		return KindRequest, ""
+	}
+	return KindRequest, values.Encode()
+}
+
+// NormalizeName lower-cases a name and collapses its whitespace
+func NormalizeName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+// NormalizeLinkedIn reduces a LinkedIn URL to "linkedin.com/<type>/<slug>".
+// Values that are not LinkedIn profile or company URLs are lower-cased.
+func NormalizeLinkedIn(raw string) string {
+	if u, err := linkedin.Parse(raw); err == nil {
+		return u.Key()
+	}
+	return strings.ToLower(strings.TrimSpace(raw))
+}
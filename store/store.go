@@ -0,0 +1,245 @@
+// Package store persists CUFinder API responses in SQLite.
+//
+// Every response is kept with the time it was fetched, so the store serves
+// both as a cache for the SDK (see cufinder.ClientConfig.Store) and as a
+// history of how an entity's data changed over time. Responses are keyed by
+// the entity they describe: a normalized domain, LinkedIn URL, email address
+// or name, so differently formatted inputs map to the same record.
+//
+// The store works with any database/sql SQLite driver. Import one, open the
+// database and pass it to New:
+//
+//	import _ "modernc.org/sqlite"
+//
+//	db, err := sql.Open("sqlite", "cufinder.db")
+//	st, err := store.New(db)
+//	sdk := cufinder.NewSDKWithConfig(cufinder.ClientConfig{
+//		APIKey:      "your-api-key-here",
+//		Store:       st,
+//		StoreMaxAge: 30 * 24 * time.Hour,
+//	})
+package store
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+// ErrNotFound is returned when no response is stored for an entity
+var ErrNotFound = errors.New("store: not found")
+
+const schema = `
+CREATE TABLE IF NOT EXISTS responses (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	endpoint   TEXT    NOT NULL,
+	kind       TEXT    NOT NULL,
+	entity     TEXT    NOT NULL,
+	response   TEXT    NOT NULL,
+	fetched_at INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS responses_entity ON responses (endpoint, entity, fetched_at);
+CREATE INDEX IF NOT EXISTS responses_kind ON responses (kind, entity);
+`
+
+// Record is a single stored API response
+type Record struct {
+	ID        int64
+	Endpoint  string
+	Kind      Kind
+	Entity    string
+	Response  map[string]interface{}
+	FetchedAt time.Time
+}
+
+// Decode converts the stored response into one of the cufinder response types
+func (r *Record) Decode(result interface{}) error {
+	return cufinder.DecodeResponse(r.Response, result)
+}
+
+// Store is a SQLite-backed cufinder.ResponseStore
+type Store struct {
+	db  *sql.DB
+	now func() time.Time
+}
+
+// New prepares the schema in db and returns a store using it
+func New(db *sql.DB) (*Store, error) {
+	if _, err := db.Exec(schema); err != nil {
+		return nil, fmt.Errorf("failed to create schema: %w", err)
+	}
+	return &Store{db: db, now: time.Now}, nil
+}
+
+// Close closes the underlying database
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Load implements cufinder.ResponseStore
+func (s *Store) Load(endpoint string, params interface{}, maxAge time.Duration) (map[string]interface{}, bool, error) {
+	_, entity := Key(params)
+
+	record, err := s.Latest(endpoint, entity)
+	if errors.Is(err, ErrNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	if s.now().Sub(record.FetchedAt) > maxAge {
+		return nil, false, nil
+	}
+	return record.Response, true, nil
+}
+
+// Save implements cufinder.ResponseStore
+func (s *Store) Save(endpoint string, params interface{}, response map[string]interface{}) error {
+	kind, entity := Key(params)
+	return s.Put(endpoint, kind, entity, response, s.now())
+}
+
+// Put stores a response for an entity as fetched at the given time
+func (s *Store) Put(endpoint string, kind Kind, entity string, response map[string]interface{}, fetchedAt time.Time) error {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	_, err = s.db.Exec(
+		`INSERT INTO responses (endpoint, kind, entity, response, fetched_at) VALUES (?, ?, ?, ?, ?)`,
+		endpoint, string(kind), entity, string(data), fetchedAt.UnixNano(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert response: %w", err)
+	}
+	return nil
+}
+
+// Latest returns the most recent response for an entity from an endpoint
+func (s *Store) Latest(endpoint, entity string) (*Record, error) {
+	records, err := s.query(
+		`SELECT id, endpoint, kind, entity, response, fetched_at FROM responses
+		 WHERE endpoint = ? AND entity = ? ORDER BY fetched_at DESC, id DESC LIMIT 1`,
+		endpoint, entity,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, ErrNotFound
+	}
+	return &records[0], nil
+}
+
+// History returns every response for an entity from an endpoint, newest first
+func (s *Store) History(endpoint, entity string) ([]Record, error) {
+	return s.query(
+		`SELECT id, endpoint, kind, entity, response, fetched_at FROM responses
+		 WHERE endpoint = ? AND entity = ? ORDER BY fetched_at DESC, id DESC`,
+		endpoint, entity,
+	)
+}
+
+// Entity returns the latest response from every endpoint for an entity
+func (s *Store) Entity(entity string) ([]Record, error) {
+	return s.query(
+		`SELECT r.id, r.endpoint, r.kind, r.entity, r.response, r.fetched_at FROM responses r
+		 WHERE r.entity = ? AND r.id = (
+			SELECT id FROM responses WHERE endpoint = r.endpoint AND entity = r.entity
+			ORDER BY fetched_at DESC, id DESC LIMIT 1)
+		 ORDER BY r.endpoint`,
+		entity,
+	)
+}
+
+// Entities lists the distinct entities of a kind that have stored responses
+func (s *Store) Entities(kind Kind) ([]string, error) {
+	rows, err := s.db.Query(`SELECT DISTINCT entity FROM responses WHERE kind = ? ORDER BY entity`, string(kind))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query entities: %w", err)
+	}
+	defer rows.Close()
+
+	var entities []string
+	for rows.Next() {
+		var entity string
+		if err := rows.Scan(&entity); err != nil {
+			return nil, fmt.Errorf("failed to scan entity: %w", err)
+		}
+		entities = append(entities, entity)
+	}
+	return entities, rows.Err()
+}
+
+// Stale lists the entities whose latest response from an endpoint was
+// fetched longer than maxAge ago
+func (s *Store) Stale(endpoint string, maxAge time.Duration) ([]string, error) {
+	cutoff := s.now().Add(-maxAge).UnixNano()
+	rows, err := s.db.Query(
+		`SELECT entity FROM responses WHERE endpoint = ?
+		 GROUP BY entity HAVING MAX(fetched_at) < ? ORDER BY entity`,
+		endpoint, cutoff,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stale entities: %w", err)
+	}
+	defer rows.Close()
+
+	var entities []string
+	for rows.Next() {
+		var entity string
+		if err := rows.Scan(&entity); err != nil {
+			return nil, fmt.Errorf("failed to scan entity: %w", err)
+		}
+		entities = append(entities, entity)
+	}
+	return entities, rows.Err()
+}
+
+// query runs a SELECT returning full records
+func (s *Store) query(query string, args ...interface{}) ([]Record, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query responses: %w", err)
+	}
+	defer rows.Close()
+
+	var records []Record
+	for rows.Next() {
+		var (
+			record    Record
+			kind      string
+			data      string
+			fetchedAt int64
+		)
+		if err := rows.Scan(&record.ID, &record.Endpoint, &kind, &record.Entity, &data, &fetchedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan response: %w", err)
+		}
+		if err := json.Unmarshal([]byte(data), &record.Response); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+		record.Kind = Kind(kind)
+		record.FetchedAt = time.Unix(0, fetchedAt)
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+// Get decodes the latest response for an entity into result and returns
+// when it was fetched
+func (s *Store) Get(endpoint, entity string, result interface{}) (time.Time, error) {
+	record, err := s.Latest(endpoint, entity)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if err := record.Decode(result); err != nil {
+		return time.Time{}, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return record.FetchedAt, nil
+}
@@ -0,0 +1,128 @@
+package store
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+
+	"github.com/cufinder/cufinder-go"
+)
+
+func newTestStore(t *testing.T) *Store {
+	db, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+	db.SetMaxOpenConns(1)
+
+	st, err := New(db)
+	require.NoError(t, err)
+	t.Cleanup(func() { st.Close() })
+	return st
+}
+
+func TestStore(t *testing.T) {
+	var hits int
+	description := "Payments"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"company": map[string]interface{}{"name": "TechCorp", "description": description},
+			},
+		})
+	}))
+	defer server.Close()
+
+	st := newTestStore(t)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	st.now = func() time.Time { return now }
+
+	sdk := cufinder.NewSDKWithConfig(cufinder.ClientConfig{
+		APIKey:      "test-api-key",
+		BaseURL:     server.URL,
+		Store:       st,
+		StoreMaxAge: 24 * time.Hour,
+	})
+
+	t.Run("Serves Fresh Responses From Store", func(t *testing.T) {
+		first, err := sdk.ENC("https://www.techcorp.com/")
+		require.NoError(t, err)
+		second, err := sdk.ENC("TechCorp.com")
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, hits)
+		assert.Equal(t, first, second)
+		assert.Equal(t, "TechCorp", second.Company.Name)
+	})
+
+	t.Run("Refetches Stale Responses", func(t *testing.T) {
+		now = now.Add(48 * time.Hour)
+		description = "Billing"
+
+		result, err := sdk.ENC("techcorp.com")
+		require.NoError(t, err)
+		assert.Equal(t, 2, hits)
+		assert.Equal(t, "Billing", result.Company.Description)
+	})
+
+	t.Run("Query Helpers", func(t *testing.T) {
+		history, err := st.History("/enc", "techcorp.com")
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.True(t, history[0].FetchedAt.After(history[1].FetchedAt))
+
+		var previous cufinder.EncResponse
+		require.NoError(t, history[1].Decode(&previous))
+		assert.Equal(t, "Payments", previous.Company.Description)
+
+		var latest cufinder.EncResponse
+		fetchedAt, err := st.Get("/enc", "techcorp.com", &latest)
+		require.NoError(t, err)
+		assert.Equal(t, now, fetchedAt.UTC())
+		assert.Equal(t, "Billing", latest.Company.Description)
+
+		domains, err := st.Entities(KindDomain)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"techcorp.com"}, domains)
+
+		records, err := st.Entity("techcorp.com")
+		require.NoError(t, err)
+		assert.Len(t, records, 1)
+
+		stale, err := st.Stale("/enc", time.Hour)
+		require.NoError(t, err)
+		assert.Empty(t, stale)
+
+		_, err = st.Latest("/enc", "unknown.com")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		params interface{}
+		kind   Kind
+		entity string
+	}{
+		{cufinder.DtcParams{CompanyWebsite: "https://www.TechCorp.com/about"}, KindDomain, "techcorp.com"},
+		{cufinder.EncParams{Query: "TechCorp  Inc"}, KindName, "techcorp inc"},
+		{cufinder.EncParams{Query: "linkedin.com/company/TechCorp/"}, KindLinkedIn, "linkedin.com/company/techcorp"},
+		{cufinder.EppParams{LinkedInURL: "https://www.linkedin.com/in/John-Doe/?trk=x"}, KindLinkedIn, "linkedin.com/in/john-doe"},
+		{cufinder.RelParams{Email: "John.Doe@TechCorp.com"}, KindEmail, "john.doe@techcorp.com"},
+		{cufinder.TepParams{FullName: "John Doe", Company: "TechCorp"}, KindPerson, "john doe @ techcorp"},
+		{cufinder.CufParams{CompanyName: "TechCorp", CountryCode: "US"}, KindRequest, "company_name=TechCorp&country_code=US"},
+	}
+
+	for _, tt := range tests {
+		kind, entity := Key(tt.params)
+		assert.Equal(t, tt.kind, kind)
+		assert.Equal(t, tt.entity, entity)
+	}
+}
@@ -1,5 +1,10 @@
 package cufinder
 
+import (
+	"github.com/cufinder/cufinder-go/emailclass"
+	"github.com/cufinder/cufinder-go/phone"
+)
+
 // BaseResponse represents the base response structure
 type BaseResponse struct {
 	Query           interface{}            `json:"query,omitempty"`
@@ -240,6 +245,9 @@ type RelPerson struct {
 type RelResponse struct {
 	BaseResponse
 	Person RelPerson `json:"person"`
+	// EmailClass is the offline classification of the looked up address,
+	// set when ClientConfig.RELFilter is enabled
+	EmailClass *emailclass.Classification `json:"-"`
 }
 
 type FclCompany struct {
@@ -367,8 +375,8 @@ type EncCompany struct {
 	Name           string `json:"name,omitempty"`
 	Website        string `json:"website,omitempty"`
 	EmployeeCount  int    `json:"employee_count,omitempty"`
-	Industry       string `json:"size,omitempty"`
-	Size           string `json:"industry,omitempty"`
+	Size           string `json:"size,omitempty"`
+	Industry       string `json:"industry,omitempty"`
 	Description    string `json:"description,omitempty"`
 	LinkedInURL    string `json:"linkedin_url,omitempty"`
 	Type           string `json:"type,omitempty"`
@@ -463,11 +471,19 @@ type CsnResponse struct {
 type NaoResponse struct {
 	BaseResponse
 	Phone string `json:"phone"`
+	// Number is the offline parse of Phone, when it could be parsed
+	Number *phone.Number `json:"-"`
+	// Offline is set when the number was normalized locally without calling
+	// the API (see ClientConfig.OfflinePhones)
+	Offline bool `json:"-"`
 }
 
 type NaaResponse struct {
 	BaseResponse
 	Address string `json:"address"`
+	// PostalAddress is the structured form of Address, set when
+	// ClientConfig.StructuredAddresses is enabled
+	PostalAddress *PostalAddress `json:"-"`
 }
 
 // Parameter types for each service
@@ -542,43 +558,43 @@ type CloParams struct {
 }
 
 type CseParams struct {
-	Name              string   `json:"name,omitempty"`
-	Country           string   `json:"country,omitempty"`
-	State             string   `json:"state,omitempty"`
-	City              string   `json:"city,omitempty"`
-	FollowersCountMin int      `json:"followers_count_min,omitempty"`
-	FollowersCountMax int      `json:"followers_count_max,omitempty"`
-	Industry          string   `json:"industry,omitempty"`
-	EmployeeSize      string   `json:"employee_size,omitempty"`
-	FoundedAfterYear  int      `json:"founded_after_year,omitempty"`
-	FoundedBeforeYear int      `json:"founded_before_year,omitempty"`
-	FundingAmountMax  int      `json:"funding_amount_max,omitempty"`
-	FundingAmountMin  int      `json:"funding_amount_min,omitempty"`
-	ProductsServices  []string `json:"products_services,omitempty"`
-	IsSchool          bool     `json:"is_school,omitempty"`
-	AnnualRevenueMin  int      `json:"annual_revenue_min,omitempty"`
-	AnnualRevenueMax  int      `json:"annual_revenue_max,omitempty"`
-	Page              int      `json:"page,omitempty"`
+	Name              string         `json:"name,omitempty"`
+	Country           string         `json:"country,omitempty"`
+	State             string         `json:"state,omitempty"`
+	City              string         `json:"city,omitempty"`
+	FollowersCountMin Optional[int]  `json:"followers_count_min,omitzero"`
+	FollowersCountMax Optional[int]  `json:"followers_count_max,omitzero"`
+	Industry          string         `json:"industry,omitempty"`
+	EmployeeSize      string         `json:"employee_size,omitempty"`
+	FoundedAfterYear  Optional[int]  `json:"founded_after_year,omitzero"`
+	FoundedBeforeYear Optional[int]  `json:"founded_before_year,omitzero"`
+	FundingAmountMax  Optional[int]  `json:"funding_amount_max,omitzero"`
+	FundingAmountMin  Optional[int]  `json:"funding_amount_min,omitzero"`
+	ProductsServices  []string       `json:"products_services,omitempty"`
+	IsSchool          Optional[bool] `json:"is_school,omitzero"`
+	AnnualRevenueMin  Optional[int]  `json:"annual_revenue_min,omitzero"`
+	AnnualRevenueMax  Optional[int]  `json:"annual_revenue_max,omitzero"`
+	Page              int            `json:"page,omitempty"`
 }
 
 type PseParams struct {
-	FullName                string   `json:"full_name,omitempty"`
-	Country                 string   `json:"country,omitempty"`
-	State                   string   `json:"state,omitempty"`
-	City                    string   `json:"city,omitempty"`
-	JobTitleRole            string   `json:"job_title_role,omitempty"`
-	JobTitleLevel           string   `json:"job_title_level,omitempty"`
-	CompanyCountry          string   `json:"company_country,omitempty"`
-	CompanyState            string   `json:"company_state,omitempty"`
-	CompanyCity             string   `json:"company_city,omitempty"`
-	CompanyName             string   `json:"company_name,omitempty"`
-	CompanyLinkedInURL      string   `json:"company_linkedin_url,omitempty"`
-	CompanyIndustry         string   `json:"company_industry,omitempty"`
-	CompanyEmployeeSize     string   `json:"company_employee_size,omitempty"`
-	CompanyProductsServices []string `json:"company_products_services,omitempty"`
-	CompanyAnnualRevenueMin int      `json:"company_annual_revenue_min,omitempty"`
-	CompanyAnnualRevenueMax int      `json:"company_annual_revenue_max,omitempty"`
-	Page                    int      `json:"page,omitempty"`
+	FullName                string        `json:"full_name,omitempty"`
+	Country                 string        `json:"country,omitempty"`
+	State                   string        `json:"state,omitempty"`
+	City                    string        `json:"city,omitempty"`
+	JobTitleRole            string        `json:"job_title_role,omitempty"`
+	JobTitleLevel           string        `json:"job_title_level,omitempty"`
+	CompanyCountry          string        `json:"company_country,omitempty"`
+	CompanyState            string        `json:"company_state,omitempty"`
+	CompanyCity             string        `json:"company_city,omitempty"`
+	CompanyName             string        `json:"company_name,omitempty"`
+	CompanyLinkedInURL      string        `json:"company_linkedin_url,omitempty"`
+	CompanyIndustry         string        `json:"company_industry,omitempty"`
+	CompanyEmployeeSize     string        `json:"company_employee_size,omitempty"`
+	CompanyProductsServices []string      `json:"company_products_services,omitempty"`
+	CompanyAnnualRevenueMin Optional[int] `json:"company_annual_revenue_min,omitzero"`
+	CompanyAnnualRevenueMax Optional[int] `json:"company_annual_revenue_max,omitzero"`
+	Page                    int           `json:"page,omitempty"`
 }
 
 type LbsParams struct {
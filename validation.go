@@ -0,0 +1,252 @@
+package cufinder
+
+import (
+	"fmt"
+	"net/mail"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/cufinder/cufinder-go/canon"
+	"github.com/cufinder/cufinder-go/linkedin"
+)
+
+// FieldError describes a single invalid request field
+type FieldError struct {
+	Field   string
+	Value   string
+	Message string
+}
+
+// ValidationError is returned when request parameters fail local validation.
+// It lists every field problem so callers can fix them in one pass.
+type ValidationError struct {
+	Service string
+	Fields  []FieldError
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	problems := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		if f.Value == "" {
+			problems = append(problems, f.Field+" "+f.Message)
+			continue
+		}
+		problems = append(problems, fmt.Sprintf("%s: %s (got %q)", f.Field, f.Message, f.Value))
+	}
+	return fmt.Sprintf("%s validation error: %s", e.Service, strings.Join(problems, "; "))
+}
+
+// validator collects field errors for a single request
+type validator struct {
+	fields []FieldError
+}
+
+// add records a problem with a field. Only the first problem of each field
+// is kept, so a missing field is not also reported as malformed.
+func (v *validator) add(field, value, message string) {
+	for _, f := range v.fields {
+		if f.Field == field {
+			return
+		}
+	}
+	v.fields = append(v.fields, FieldError{Field: field, Value: value, Message: message})
+}
+
+// required reports every required parameter left empty. Required
+// parameters are those whose json tag has no omitempty or omitzero option.
+func (v *validator) required(params interface{}) {
+	for _, field := range missingFields(params) {
+		v.add(field, "", "is required")
+	}
+}
+
+func (v *validator) err(service string) error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Service: service, Fields: v.fields}
+}
+
+// countryCode checks and upper-cases an ISO 3166-1 alpha-2 code
+func (v *validator) countryCode(field string, value *string) {
+	code := strings.ToUpper(strings.TrimSpace(*value))
+	if !IsCountryCode(code) {
+		v.add(field, *value, "must be an ISO 3166-1 alpha-2 country code")
+		return
+	}
+	*value = code
+}
+
+// email checks the syntax of an email address and lower-cases its domain
+func (v *validator) email(field string, value *string) {
+	normalized, ok := NormalizeEmail(*value)
+	if !ok {
+		v.add(field, *value, "must be a valid email address")
+		return
+	}
+	*value = normalized
+}
+
+// domain canonicalizes a website or URL into a bare host name
+func (v *validator) domain(field string, value *string) {
+	domain, ok := CanonicalDomain(*value)
+	if !ok {
+		v.add(field, *value, "must be a domain or website URL")
+		return
+	}
+	*value = domain
+}
+
+// linkedInProfile checks that the value is a LinkedIn person profile URL and
+// replaces it with the canonical URL
+func (v *validator) linkedInProfile(field string, value *string) {
+	u, err := linkedin.ParseProfile(*value)
+	if err != nil {
+		v.add(field, *value, "must be a LinkedIn profile URL (linkedin.com/in/...)")
+		return
+	}
+	*value = u.String()
+}
+
+// linkedInCompany checks that the value is a LinkedIn company page URL and
+// replaces it with the canonical URL
+func (v *validator) linkedInCompany(field string, value *string) {
+	u, err := linkedin.ParseCompany(*value)
+	if err != nil {
+		v.add(field, *value, "must be a LinkedIn company URL (linkedin.com/company/...)")
+		return
+	}
+	*value = u.String()
+}
+
+// check validates and canonicalizes params in place. params must be a
+// pointer to one of the *Params types; unknown types are accepted as-is.
+func (v *validator) check(params interface{}) {
+	switch p := params.(type) {
+	case *CufParams:
+		v.countryCode("country_code", &p.CountryCode)
+	case *DtcParams:
+		v.domain("company_website", &p.CompanyWebsite)
+	case *DteParams:
+		v.domain("company_website", &p.CompanyWebsite)
+	case *RelParams:
+		v.email("email", &p.Email)
+	case *EppParams:
+		v.linkedInProfile("linkedin_url", &p.LinkedInURL)
+	case *FweParams:
+		v.linkedInProfile("linkedin_url", &p.LinkedInURL)
+	case *PseParams:
+		if p.CompanyLinkedInURL != "" {
+			v.linkedInCompany("company_linkedin_url", &p.CompanyLinkedInURL)
+		}
+	case *BcdParams:
+		v.domain("url", &p.Url)
+	case *CcpParams:
+		v.domain("url", &p.Url)
+	case *IscParams:
+		v.domain("url", &p.Url)
+	case *CbcParams:
+		v.domain("url", &p.Url)
+	case *CscParams:
+		v.domain("url", &p.Url)
+	case *CsnParams:
+		v.domain("url", &p.Url)
+	}
+	validateSearch(v, params)
+}
+
+// requiredFields lists the JSON names of the parameters of a *Params type
+// without omitempty or omitzero
+func requiredFields(t reflect.Type) []string {
+	var required []string
+	for i := 0; i < t.NumField(); i++ {
+		name, opts, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" && !strings.Contains(opts, "omitempty") && !strings.Contains(opts, "omitzero") {
+			required = append(required, name)
+		}
+	}
+	return required
+}
+
+// missingFields lists the required parameters left empty in params, a
+// pointer to a *Params type
+func missingFields(params interface{}) []string {
+	v := reflect.ValueOf(params)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	var missing []string
+	for _, name := range requiredFields(v.Type()) {
+		for i := 0; i < v.NumField(); i++ {
+			tag, _, _ := strings.Cut(v.Type().Field(i).Tag.Get("json"), ",")
+			if tag == name && v.Field(i).IsZero() {
+				missing = append(missing, name)
+			}
+		}
+	}
+	return missing
+}
+
+var hostnameLabel = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// canonicalOptions strip "www." and keep other subdomains. Only ICANN
+// suffixes are rejected, so hosted sites such as "acme.github.io" are accepted.
+var canonicalOptions = canon.Options{ICANNOnly: true}
+
+// CanonicalDomain reduces a website or URL to its bare lower-case host name,
+// stripping the scheme, credentials, port, path, query and a leading "www.".
+// Internationalized names are returned in punycode and public suffixes such
+// as "co.uk" are rejected. See the canon package for other policies.
+func CanonicalDomain(raw string) (string, bool) {
+	domain, err := canonicalOptions.Domain(raw)
+	return domain, err == nil
+}
+
+// isHostname reports whether host is a dotted DNS name with a letter TLD
+func isHostname(host string) bool {
+	if len(host) == 0 || len(host) > 253 {
+		return false
+	}
+
+	labels := strings.Split(host, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if !hostnameLabel.MatchString(label) {
+			return false
+		}
+	}
+
+	tld := labels[len(labels)-1]
+	return strings.Trim(tld, "0123456789") != ""
+}
+
+// NormalizeEmail checks the syntax of a bare email address and returns it
+// trimmed with a lower-case domain.
+func NormalizeEmail(raw string) (string, bool) {
+	s := strings.TrimSpace(raw)
+	addr, err := mail.ParseAddress(s)
+	if err != nil || addr.Address != s || addr.Name != "" {
+		return "", false
+	}
+
+	at := strings.LastIndex(s, "@")
+	local, domain := s[:at], strings.ToLower(s[at+1:])
+	if local == "" || !isHostname(domain) {
+		return "", false
+	}
+
+	return local + "@" + domain, true
+}
+
+// IsCountryCode reports whether code is an assigned ISO 3166-1 alpha-2 code
+func IsCountryCode(code string) bool {
+	_, ok := countryNames[code]
+	return ok
+}
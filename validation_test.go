@@ -0,0 +1,157 @@
+package cufinder
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidation(t *testing.T) {
+	var calls int
+	var lastForm map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		require.NoError(t, r.ParseForm())
+		lastForm = r.PostForm
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"credit_count": 1})
+	}))
+	defer server.Close()
+
+	sdk := NewSDKWithConfig(ClientConfig{
+		APIKey:  "test-api-key",
+		BaseURL: server.URL,
+		Timeout: 5 * time.Second,
+	})
+
+	t.Run("Canonicalizes Domains", func(t *testing.T) {
+		_, err := sdk.DTC("HTTPS://www.TechCorp.com:443/about?ref=x")
+		require.NoError(t, err)
+		assert.Equal(t, "techcorp.com", lastForm["company_website"][0])
+
+		_, err = sdk.DTE("techcorp.com/contact")
+		require.NoError(t, err)
+		assert.Equal(t, "techcorp.com", lastForm["company_website"][0])
+
+		_, err = sdk.DTE("https://www.Bücher.de/")
+		require.NoError(t, err)
+		assert.Equal(t, "xn--bcher-kva.de", lastForm["company_website"][0])
+	})
+
+	t.Run("Passes Local Business Categories", func(t *testing.T) {
+		_, err := sdk.LBS(LbsParams{Name: "Joe's", Industry: "restaurant"})
+		require.NoError(t, err)
+		assert.Equal(t, "restaurant", lastForm["industry"][0])
+	})
+
+	t.Run("Normalizes Country Codes", func(t *testing.T) {
+		_, err := sdk.CUF("TechCorp", "us")
+		require.NoError(t, err)
+		assert.Equal(t, "US", lastForm["country_code"][0])
+	})
+
+	t.Run("Rejects Invalid Input Without Calling API", func(t *testing.T) {
+		before := calls
+
+		_, err := sdk.REL("not-an-email")
+		var verr *ValidationError
+		require.True(t, errors.As(err, &verr))
+		assert.Equal(t, "REL", verr.Service)
+		assert.Equal(t, "email", verr.Fields[0].Field)
+
+		_, err = sdk.EPP("https://example.com/in/john-doe")
+		require.True(t, errors.As(err, &verr))
+		assert.Equal(t, "linkedin_url", verr.Fields[0].Field)
+
+		_, err = sdk.FWE("https://linkedin.com/company/techcorp")
+		assert.Error(t, err)
+
+		_, err = sdk.CUF("TechCorp", "USA")
+		assert.Contains(t, err.Error(), "country_code")
+
+		_, err = sdk.DTC("localhost")
+		assert.Error(t, err)
+
+		_, err = sdk.DTC("https://co.uk")
+		assert.Error(t, err)
+
+		assert.Equal(t, before, calls)
+	})
+
+	t.Run("Accepts LinkedIn Variants", func(t *testing.T) {
+		for _, u := range []string{
+			"linkedin.com/in/john-doe",
+			"https://www.linkedin.com/in/john-doe/",
+			"https://uk.linkedin.com/pub/john-doe/1/2/3",
+		} {
+			_, err := sdk.EPP(u)
+			assert.NoError(t, err, u)
+		}
+		assert.Equal(t, "https://www.linkedin.com/pub/john-doe/1/2/3", lastForm["linkedin_url"][0])
+
+		_, err := sdk.FWE("uk.linkedin.com/in/John-Doe?trk=x")
+		require.NoError(t, err)
+		assert.Equal(t, "https://www.linkedin.com/in/john-doe", lastForm["linkedin_url"][0])
+	})
+
+	t.Run("Reports Missing Fields", func(t *testing.T) {
+		before := calls
+
+		_, err := sdk.TEP("", "")
+		var verr *ValidationError
+		require.True(t, errors.As(err, &verr))
+		assert.Equal(t, "TEP", verr.Service)
+		assert.Equal(t, []FieldError{
+			{Field: "full_name", Message: "is required"},
+			{Field: "company", Message: "is required"},
+		}, verr.Fields)
+		assert.Contains(t, err.Error(), "full_name is required")
+
+		// A missing field is not also reported as malformed
+		_, err = sdk.CUF("TechCorp", "")
+		require.True(t, errors.As(err, &verr))
+		assert.Equal(t, []FieldError{{Field: "country_code", Message: "is required"}}, verr.Fields)
+
+		raw := NewSDKWithConfig(ClientConfig{APIKey: "test-api-key", BaseURL: server.URL, DisableValidation: true})
+		_, err = raw.REL("")
+		require.True(t, errors.As(err, &verr))
+		assert.Equal(t, "email", verr.Fields[0].Field)
+
+		assert.Equal(t, before, calls)
+	})
+
+	t.Run("Opt Out", func(t *testing.T) {
+		raw := NewSDKWithConfig(ClientConfig{
+			APIKey:            "test-api-key",
+			BaseURL:           server.URL,
+			DisableValidation: true,
+		})
+		_, err := raw.DTC("https://www.techcorp.com/about")
+		require.NoError(t, err)
+		assert.Equal(t, "https://www.techcorp.com/about", lastForm["company_website"][0])
+	})
+}
+
+func TestNormalizeEmail(t *testing.T) {
+	email, ok := NormalizeEmail(" John.Doe@TechCorp.COM ")
+	assert.True(t, ok)
+	assert.Equal(t, "John.Doe@techcorp.com", email)
+
+	for _, bad := range []string{"", "john", "john@", "@techcorp.com", "John <john@techcorp.com>", "john@localhost"} {
+		_, ok := NormalizeEmail(bad)
+		assert.False(t, ok, bad)
+	}
+}
+
+func TestIsCountryCode(t *testing.T) {
+	assert.Len(t, countryNames, 249)
+	assert.True(t, IsCountryCode("DE"))
+	assert.False(t, IsCountryCode("UK"))
+	assert.False(t, IsCountryCode("de"))
+}
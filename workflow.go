@@ -0,0 +1,41 @@
+package cufinder
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrCreditLimit is returned by multi-call workflows when their credit cap
+// is reached before the work is complete. The partial result is still
+// returned alongside it.
+var ErrCreditLimit = errors.New("credit limit reached")
+
+// creditBudget tracks the credits spent by a multi-call workflow
+type creditBudget struct {
+	max   int
+	used  int
+	calls int
+}
+
+// allow reports whether another call fits in the budget. A zero max means
+// no limit.
+func (b *creditBudget) allow() bool {
+	return b.max <= 0 || b.used < b.max
+}
+
+// spend records a call, counting its credits with CallCredits
+func (b *creditBudget) spend(response interface{}, err error) {
+	b.calls++
+	b.used += CallCredits(response, err)
+}
+
+// proceed checks for cancellation and the credit cap before the next call
+func (b *creditBudget) proceed(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if !b.allow() {
+		return ErrCreditLimit
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package cufinder
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeEndpoint returns the data for a request, or nil for a 404
+type fakeEndpoint func(form url.Values) map[string]interface{}
+
+// newWorkflowSDK serves the given endpoints and records every request
+func newWorkflowSDK(t *testing.T, endpoints map[string]fakeEndpoint) (*SDK, *[]string) {
+	var (
+		mu    sync.Mutex
+		calls []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		mu.Lock()
+		calls = append(calls, r.URL.Path+"?"+r.PostForm.Encode())
+		mu.Unlock()
+
+		endpoint, ok := endpoints[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		data := endpoint(r.PostForm)
+		if data == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+	t.Cleanup(server.Close)
+
+	sdk := NewSDKWithConfig(ClientConfig{APIKey: "test-api-key", BaseURL: server.URL})
+	return sdk, &calls
+}
+
+func TestCreditBudget(t *testing.T) {
+	budget := creditBudget{max: 3}
+	assert.NoError(t, budget.proceed(context.Background()))
+
+	budget.spend(&EncResponse{BaseResponse: BaseResponse{CreditCount: 2}}, nil)
+	budget.spend(nil, &ValidationError{Service: "ENC"})
+	assert.NoError(t, budget.proceed(context.Background()))
+
+	budget.spend(&EncResponse{}, nil)
+	assert.ErrorIs(t, budget.proceed(context.Background()), ErrCreditLimit)
+	assert.Equal(t, 3, budget.used)
+	assert.Equal(t, 3, budget.calls)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	unlimited := creditBudget{}
+	assert.ErrorIs(t, unlimited.proceed(ctx), context.Canceled)
+
+	// Failed calls may have been billed
+	unlimited.spend(nil, errors.New("server error"))
+	assert.Equal(t, 1, unlimited.used)
+}